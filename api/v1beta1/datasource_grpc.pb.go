@@ -24,6 +24,9 @@ const _ = grpc.SupportPackageIsVersion7
 type DataSourceServiceClient interface {
 	Write(ctx context.Context, in *WriteDataSourceRequest, opts ...grpc.CallOption) (*WriteDataSourceResponse, error)
 	WriteStream(ctx context.Context, opts ...grpc.CallOption) (DataSourceService_WriteStreamClient, error)
+	Get(ctx context.Context, in *GetDataSourceRequest, opts ...grpc.CallOption) (*GetDataSourceResponse, error)
+	ListDataSources(ctx context.Context, in *ListDataSourcesRequest, opts ...grpc.CallOption) (*ListDataSourcesResponse, error)
+	Describe(ctx context.Context, in *DescribeDataSourceRequest, opts ...grpc.CallOption) (*DescribeDataSourceResponse, error)
 }
 
 type dataSourceServiceClient struct {
@@ -77,12 +80,42 @@ func (x *dataSourceServiceWriteStreamClient) CloseAndRecv() (*WriteDataSourceRes
 	return m, nil
 }
 
+func (c *dataSourceServiceClient) Get(ctx context.Context, in *GetDataSourceRequest, opts ...grpc.CallOption) (*GetDataSourceResponse, error) {
+	out := new(GetDataSourceResponse)
+	err := c.cc.Invoke(ctx, "/tracker.v1beta1.DataSourceService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataSourceServiceClient) ListDataSources(ctx context.Context, in *ListDataSourcesRequest, opts ...grpc.CallOption) (*ListDataSourcesResponse, error) {
+	out := new(ListDataSourcesResponse)
+	err := c.cc.Invoke(ctx, "/tracker.v1beta1.DataSourceService/ListDataSources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataSourceServiceClient) Describe(ctx context.Context, in *DescribeDataSourceRequest, opts ...grpc.CallOption) (*DescribeDataSourceResponse, error) {
+	out := new(DescribeDataSourceResponse)
+	err := c.cc.Invoke(ctx, "/tracker.v1beta1.DataSourceService/Describe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataSourceServiceServer is the server API for DataSourceService service.
 // All implementations must embed UnimplementedDataSourceServiceServer
 // for forward compatibility
 type DataSourceServiceServer interface {
 	Write(context.Context, *WriteDataSourceRequest) (*WriteDataSourceResponse, error)
 	WriteStream(DataSourceService_WriteStreamServer) error
+	Get(context.Context, *GetDataSourceRequest) (*GetDataSourceResponse, error)
+	ListDataSources(context.Context, *ListDataSourcesRequest) (*ListDataSourcesResponse, error)
+	Describe(context.Context, *DescribeDataSourceRequest) (*DescribeDataSourceResponse, error)
 	mustEmbedUnimplementedDataSourceServiceServer()
 }
 
@@ -96,6 +129,15 @@ func (UnimplementedDataSourceServiceServer) Write(context.Context, *WriteDataSou
 func (UnimplementedDataSourceServiceServer) WriteStream(DataSourceService_WriteStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method WriteStream not implemented")
 }
+func (UnimplementedDataSourceServiceServer) Get(context.Context, *GetDataSourceRequest) (*GetDataSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedDataSourceServiceServer) ListDataSources(context.Context, *ListDataSourcesRequest) (*ListDataSourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDataSources not implemented")
+}
+func (UnimplementedDataSourceServiceServer) Describe(context.Context, *DescribeDataSourceRequest) (*DescribeDataSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Describe not implemented")
+}
 func (UnimplementedDataSourceServiceServer) mustEmbedUnimplementedDataSourceServiceServer() {}
 
 // UnsafeDataSourceServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -153,6 +195,60 @@ func (x *dataSourceServiceWriteStreamServer) Recv() (*WriteDataSourceRequest, er
 	return m, nil
 }
 
+func _DataSourceService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataSourceServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tracker.v1beta1.DataSourceService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataSourceServiceServer).Get(ctx, req.(*GetDataSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataSourceService_ListDataSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDataSourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataSourceServiceServer).ListDataSources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tracker.v1beta1.DataSourceService/ListDataSources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataSourceServiceServer).ListDataSources(ctx, req.(*ListDataSourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataSourceService_Describe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeDataSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataSourceServiceServer).Describe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tracker.v1beta1.DataSourceService/Describe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataSourceServiceServer).Describe(ctx, req.(*DescribeDataSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DataSourceService_ServiceDesc is the grpc.ServiceDesc for DataSourceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -164,6 +260,18 @@ var DataSourceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Write",
 			Handler:    _DataSourceService_Write_Handler,
 		},
+		{
+			MethodName: "Get",
+			Handler:    _DataSourceService_Get_Handler,
+		},
+		{
+			MethodName: "ListDataSources",
+			Handler:    _DataSourceService_ListDataSources_Handler,
+		},
+		{
+			MethodName: "Describe",
+			Handler:    _DataSourceService_Describe_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -124,15 +124,20 @@ type GetMetricsResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EventCount       uint64 `protobuf:"varint,1,opt,name=EventCount,proto3" json:"EventCount,omitempty"`
-	EventsFiltered   uint64 `protobuf:"varint,2,opt,name=EventsFiltered,proto3" json:"EventsFiltered,omitempty"`
-	NetCapCount      uint64 `protobuf:"varint,3,opt,name=NetCapCount,proto3" json:"NetCapCount,omitempty"`
-	BPFLogsCount     uint64 `protobuf:"varint,4,opt,name=BPFLogsCount,proto3" json:"BPFLogsCount,omitempty"`
-	ErrorCount       uint64 `protobuf:"varint,5,opt,name=ErrorCount,proto3" json:"ErrorCount,omitempty"`
-	LostEvCount      uint64 `protobuf:"varint,6,opt,name=LostEvCount,proto3" json:"LostEvCount,omitempty"`
-	LostWrCount      uint64 `protobuf:"varint,7,opt,name=LostWrCount,proto3" json:"LostWrCount,omitempty"`
-	LostNtCapCount   uint64 `protobuf:"varint,8,opt,name=LostNtCapCount,proto3" json:"LostNtCapCount,omitempty"`
-	LostBPFLogsCount uint64 `protobuf:"varint,9,opt,name=LostBPFLogsCount,proto3" json:"LostBPFLogsCount,omitempty"`
+	EventCount        uint64                  `protobuf:"varint,1,opt,name=EventCount,proto3" json:"EventCount,omitempty"`
+	EventsFiltered    uint64                  `protobuf:"varint,2,opt,name=EventsFiltered,proto3" json:"EventsFiltered,omitempty"`
+	NetCapCount       uint64                  `protobuf:"varint,3,opt,name=NetCapCount,proto3" json:"NetCapCount,omitempty"`
+	BPFLogsCount      uint64                  `protobuf:"varint,4,opt,name=BPFLogsCount,proto3" json:"BPFLogsCount,omitempty"`
+	ErrorCount        uint64                  `protobuf:"varint,5,opt,name=ErrorCount,proto3" json:"ErrorCount,omitempty"`
+	LostEvCount       uint64                  `protobuf:"varint,6,opt,name=LostEvCount,proto3" json:"LostEvCount,omitempty"`
+	LostWrCount       uint64                  `protobuf:"varint,7,opt,name=LostWrCount,proto3" json:"LostWrCount,omitempty"`
+	LostNtCapCount    uint64                  `protobuf:"varint,8,opt,name=LostNtCapCount,proto3" json:"LostNtCapCount,omitempty"`
+	LostBPFLogsCount  uint64                  `protobuf:"varint,9,opt,name=LostBPFLogsCount,proto3" json:"LostBPFLogsCount,omitempty"`
+	Stages            []*PipelineStageMetrics `protobuf:"bytes,10,rep,name=Stages,proto3" json:"Stages,omitempty"`
+	EventTypes        []*EventTypeMetrics     `protobuf:"bytes,11,rep,name=EventTypes,proto3" json:"EventTypes,omitempty"`
+	LostEvCountPerCPU []uint64                `protobuf:"varint,12,rep,packed,name=LostEvCountPerCPU,proto3" json:"LostEvCountPerCPU,omitempty"` // indexed by cpu
+	Signatures        []*SignatureMetrics     `protobuf:"bytes,13,rep,name=Signatures,proto3" json:"Signatures,omitempty"`
+	Streams           []*StreamMetrics        `protobuf:"bytes,14,rep,name=Streams,proto3" json:"Streams,omitempty"`
 }
 
 func (x *GetMetricsResponse) Reset() {
@@ -230,6 +235,341 @@ func (x *GetMetricsResponse) GetLostBPFLogsCount() uint64 {
 	return 0
 }
 
+func (x *GetMetricsResponse) GetStages() []*PipelineStageMetrics {
+	if x != nil {
+		return x.Stages
+	}
+	return nil
+}
+
+func (x *GetMetricsResponse) GetEventTypes() []*EventTypeMetrics {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *GetMetricsResponse) GetLostEvCountPerCPU() []uint64 {
+	if x != nil {
+		return x.LostEvCountPerCPU
+	}
+	return nil
+}
+
+func (x *GetMetricsResponse) GetSignatures() []*SignatureMetrics {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+func (x *GetMetricsResponse) GetStreams() []*StreamMetrics {
+	if x != nil {
+		return x.Streams
+	}
+	return nil
+}
+
+type PipelineStageMetrics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name          string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Received      uint64 `protobuf:"varint,2,opt,name=Received,proto3" json:"Received,omitempty"`
+	Dropped       uint64 `protobuf:"varint,3,opt,name=Dropped,proto3" json:"Dropped,omitempty"`
+	QueueLength   uint64 `protobuf:"varint,4,opt,name=QueueLength,proto3" json:"QueueLength,omitempty"`
+	QueueCapacity uint64 `protobuf:"varint,5,opt,name=QueueCapacity,proto3" json:"QueueCapacity,omitempty"`
+}
+
+func (x *PipelineStageMetrics) Reset() {
+	*x = PipelineStageMetrics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1beta1_diagnostic_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineStageMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineStageMetrics) ProtoMessage() {}
+
+func (x *PipelineStageMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1beta1_diagnostic_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineStageMetrics.ProtoReflect.Descriptor instead.
+func (*PipelineStageMetrics) Descriptor() ([]byte, []int) {
+	return file_api_v1beta1_diagnostic_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PipelineStageMetrics) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PipelineStageMetrics) GetReceived() uint64 {
+	if x != nil {
+		return x.Received
+	}
+	return 0
+}
+
+func (x *PipelineStageMetrics) GetDropped() uint64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+func (x *PipelineStageMetrics) GetQueueLength() uint64 {
+	if x != nil {
+		return x.QueueLength
+	}
+	return 0
+}
+
+func (x *PipelineStageMetrics) GetQueueCapacity() uint64 {
+	if x != nil {
+		return x.QueueCapacity
+	}
+	return 0
+}
+
+type EventTypeMetrics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Emitted  uint64 `protobuf:"varint,2,opt,name=Emitted,proto3" json:"Emitted,omitempty"`
+	Filtered uint64 `protobuf:"varint,3,opt,name=Filtered,proto3" json:"Filtered,omitempty"`
+	Lost     uint64 `protobuf:"varint,4,opt,name=Lost,proto3" json:"Lost,omitempty"`
+}
+
+func (x *EventTypeMetrics) Reset() {
+	*x = EventTypeMetrics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1beta1_diagnostic_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventTypeMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventTypeMetrics) ProtoMessage() {}
+
+func (x *EventTypeMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1beta1_diagnostic_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventTypeMetrics.ProtoReflect.Descriptor instead.
+func (*EventTypeMetrics) Descriptor() ([]byte, []int) {
+	return file_api_v1beta1_diagnostic_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EventTypeMetrics) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EventTypeMetrics) GetEmitted() uint64 {
+	if x != nil {
+		return x.Emitted
+	}
+	return 0
+}
+
+func (x *EventTypeMetrics) GetFiltered() uint64 {
+	if x != nil {
+		return x.Filtered
+	}
+	return 0
+}
+
+func (x *EventTypeMetrics) GetLost() uint64 {
+	if x != nil {
+		return x.Lost
+	}
+	return 0
+}
+
+type SignatureMetrics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Events   uint64 `protobuf:"varint,2,opt,name=Events,proto3" json:"Events,omitempty"`
+	Findings uint64 `protobuf:"varint,3,opt,name=Findings,proto3" json:"Findings,omitempty"`
+}
+
+func (x *SignatureMetrics) Reset() {
+	*x = SignatureMetrics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1beta1_diagnostic_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignatureMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignatureMetrics) ProtoMessage() {}
+
+func (x *SignatureMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1beta1_diagnostic_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignatureMetrics.ProtoReflect.Descriptor instead.
+func (*SignatureMetrics) Descriptor() ([]byte, []int) {
+	return file_api_v1beta1_diagnostic_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SignatureMetrics) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SignatureMetrics) GetEvents() uint64 {
+	if x != nil {
+		return x.Events
+	}
+	return 0
+}
+
+func (x *SignatureMetrics) GetFindings() uint64 {
+	if x != nil {
+		return x.Findings
+	}
+	return 0
+}
+
+type StreamMetrics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           uint64 `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Backpressure string `protobuf:"bytes,2,opt,name=Backpressure,proto3" json:"Backpressure,omitempty"`
+	Delivered    uint64 `protobuf:"varint,3,opt,name=Delivered,proto3" json:"Delivered,omitempty"`
+	Dropped      uint64 `protobuf:"varint,4,opt,name=Dropped,proto3" json:"Dropped,omitempty"`
+	Lag          uint64 `protobuf:"varint,5,opt,name=Lag,proto3" json:"Lag,omitempty"`
+	BufferSize   uint64 `protobuf:"varint,6,opt,name=BufferSize,proto3" json:"BufferSize,omitempty"`
+}
+
+func (x *StreamMetrics) Reset() {
+	*x = StreamMetrics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1beta1_diagnostic_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamMetrics) ProtoMessage() {}
+
+func (x *StreamMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1beta1_diagnostic_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamMetrics.ProtoReflect.Descriptor instead.
+func (*StreamMetrics) Descriptor() ([]byte, []int) {
+	return file_api_v1beta1_diagnostic_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StreamMetrics) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StreamMetrics) GetBackpressure() string {
+	if x != nil {
+		return x.Backpressure
+	}
+	return ""
+}
+
+func (x *StreamMetrics) GetDelivered() uint64 {
+	if x != nil {
+		return x.Delivered
+	}
+	return 0
+}
+
+func (x *StreamMetrics) GetDropped() uint64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+func (x *StreamMetrics) GetLag() uint64 {
+	if x != nil {
+		return x.Lag
+	}
+	return 0
+}
+
+func (x *StreamMetrics) GetBufferSize() uint64 {
+	if x != nil {
+		return x.BufferSize
+	}
+	return 0
+}
+
 type ChangeLogLevelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -241,7 +581,7 @@ type ChangeLogLevelRequest struct {
 func (x *ChangeLogLevelRequest) Reset() {
 	*x = ChangeLogLevelRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1beta1_diagnostic_proto_msgTypes[2]
+		mi := &file_api_v1beta1_diagnostic_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -254,7 +594,7 @@ func (x *ChangeLogLevelRequest) String() string {
 func (*ChangeLogLevelRequest) ProtoMessage() {}
 
 func (x *ChangeLogLevelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1beta1_diagnostic_proto_msgTypes[2]
+	mi := &file_api_v1beta1_diagnostic_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -267,7 +607,7 @@ func (x *ChangeLogLevelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangeLogLevelRequest.ProtoReflect.Descriptor instead.
 func (*ChangeLogLevelRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1beta1_diagnostic_proto_rawDescGZIP(), []int{2}
+	return file_api_v1beta1_diagnostic_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ChangeLogLevelRequest) GetLevel() LogLevel {
@@ -286,7 +626,7 @@ type ChangeLogLevelResponse struct {
 func (x *ChangeLogLevelResponse) Reset() {
 	*x = ChangeLogLevelResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1beta1_diagnostic_proto_msgTypes[3]
+		mi := &file_api_v1beta1_diagnostic_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -299,7 +639,7 @@ func (x *ChangeLogLevelResponse) String() string {
 func (*ChangeLogLevelResponse) ProtoMessage() {}
 
 func (x *ChangeLogLevelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1beta1_diagnostic_proto_msgTypes[3]
+	mi := &file_api_v1beta1_diagnostic_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -312,7 +652,7 @@ func (x *ChangeLogLevelResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangeLogLevelResponse.ProtoReflect.Descriptor instead.
 func (*ChangeLogLevelResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1beta1_diagnostic_proto_rawDescGZIP(), []int{3}
+	return file_api_v1beta1_diagnostic_proto_rawDescGZIP(), []int{7}
 }
 
 type GetStacktraceRequest struct {
@@ -324,7 +664,7 @@ type GetStacktraceRequest struct {
 func (x *GetStacktraceRequest) Reset() {
 	*x = GetStacktraceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1beta1_diagnostic_proto_msgTypes[4]
+		mi := &file_api_v1beta1_diagnostic_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -337,7 +677,7 @@ func (x *GetStacktraceRequest) String() string {
 func (*GetStacktraceRequest) ProtoMessage() {}
 
 func (x *GetStacktraceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1beta1_diagnostic_proto_msgTypes[4]
+	mi := &file_api_v1beta1_diagnostic_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -350,7 +690,7 @@ func (x *GetStacktraceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStacktraceRequest.ProtoReflect.Descriptor instead.
 func (*GetStacktraceRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1beta1_diagnostic_proto_rawDescGZIP(), []int{4}
+	return file_api_v1beta1_diagnostic_proto_rawDescGZIP(), []int{8}
 }
 
 type GetStacktraceResponse struct {
@@ -364,7 +704,7 @@ type GetStacktraceResponse struct {
 func (x *GetStacktraceResponse) Reset() {
 	*x = GetStacktraceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1beta1_diagnostic_proto_msgTypes[5]
+		mi := &file_api_v1beta1_diagnostic_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -377,7 +717,7 @@ func (x *GetStacktraceResponse) String() string {
 func (*GetStacktraceResponse) ProtoMessage() {}
 
 func (x *GetStacktraceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1beta1_diagnostic_proto_msgTypes[5]
+	mi := &file_api_v1beta1_diagnostic_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -390,7 +730,7 @@ func (x *GetStacktraceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStacktraceResponse.ProtoReflect.Descriptor instead.
 func (*GetStacktraceResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1beta1_diagnostic_proto_rawDescGZIP(), []int{5}
+	return file_api_v1beta1_diagnostic_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetStacktraceResponse) GetStacktrace() []byte {
@@ -404,70 +744,124 @@ var File_api_v1beta1_diagnostic_proto protoreflect.FileDescriptor
 
 var file_api_v1beta1_diagnostic_proto_rawDesc = []byte{
 	0x0a, 0x1c, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x64, 0x69,
-	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e,
-	0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x22, 0x13,
-	0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0xda, 0x02, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x4e, 0x65, 0x74, 0x43, 0x61, 0x70, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x4e, 0x65, 0x74, 0x43, 0x61, 0x70, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x42, 0x50, 0x46, 0x4c, 0x6f, 0x67, 0x73, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x42, 0x50, 0x46, 0x4c,
-	0x6f, 0x67, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x4c, 0x6f, 0x73, 0x74,
-	0x45, 0x76, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x4c,
-	0x6f, 0x73, 0x74, 0x45, 0x76, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x4c, 0x6f,
-	0x73, 0x74, 0x57, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0b, 0x4c, 0x6f, 0x73, 0x74, 0x57, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e,
-	0x4c, 0x6f, 0x73, 0x74, 0x4e, 0x74, 0x43, 0x61, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x4c, 0x6f, 0x73, 0x74, 0x4e, 0x74, 0x43, 0x61, 0x70, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x4c, 0x6f, 0x73, 0x74, 0x42, 0x50, 0x46, 0x4c,
-	0x6f, 0x67, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
-	0x4c, 0x6f, 0x73, 0x74, 0x42, 0x50, 0x46, 0x4c, 0x6f, 0x67, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x22, 0x47, 0x0a, 0x15, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76,
-	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x6c, 0x65, 0x76,
-	0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65,
-	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76,
-	0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x18, 0x0a, 0x16, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x74,
-	0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x37, 0x0a, 0x15, 0x47,
-	0x65, 0x74, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x74, 0x72, 0x61,
-	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x74,
-	0x72, 0x61, 0x63, 0x65, 0x2a, 0x56, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c,
-	0x12, 0x09, 0x0a, 0x05, 0x44, 0x65, 0x62, 0x75, 0x67, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49,
-	0x6e, 0x66, 0x6f, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x61, 0x72, 0x6e, 0x10, 0x02, 0x12,
-	0x09, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x50,
-	0x61, 0x6e, 0x69, 0x63, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x61, 0x6e, 0x69, 0x63, 0x10,
-	0x05, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x61, 0x74, 0x61, 0x6c, 0x10, 0x06, 0x32, 0xa7, 0x02, 0x0a,
-	0x11, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
-	0x12, 0x21, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x25, 0x2e, 0x74, 0x72, 0x61, 0x63,
-	0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x26, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x22,
+	0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x87, 0x05, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x4e, 0x65, 0x74, 0x43, 0x61, 0x70, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x4e, 0x65, 0x74, 0x43, 0x61, 0x70,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x42, 0x50, 0x46, 0x4c, 0x6f, 0x67, 0x73,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x42, 0x50, 0x46,
+	0x4c, 0x6f, 0x67, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x4c, 0x6f, 0x73,
+	0x74, 0x45, 0x76, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x4c, 0x6f, 0x73, 0x74, 0x45, 0x76, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x4c,
+	0x6f, 0x73, 0x74, 0x57, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x4c, 0x6f, 0x73, 0x74, 0x57, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a,
+	0x0e, 0x4c, 0x6f, 0x73, 0x74, 0x4e, 0x74, 0x43, 0x61, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x4c, 0x6f, 0x73, 0x74, 0x4e, 0x74, 0x43, 0x61, 0x70,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x4c, 0x6f, 0x73, 0x74, 0x42, 0x50, 0x46,
+	0x4c, 0x6f, 0x67, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x10, 0x4c, 0x6f, 0x73, 0x74, 0x42, 0x50, 0x46, 0x4c, 0x6f, 0x67, 0x73, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x3d, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x67,
+	0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x06, 0x53, 0x74, 0x61, 0x67, 0x65, 0x73,
+	0x12, 0x41, 0x0a, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x4c, 0x6f, 0x73, 0x74, 0x45, 0x76, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x50, 0x65, 0x72, 0x43, 0x50, 0x55, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x04, 0x52, 0x11,
+	0x4c, 0x6f, 0x73, 0x74, 0x45, 0x76, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x43, 0x50,
+	0x55, 0x12, 0x41, 0x0a, 0x0a, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18,
+	0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x0a, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x07, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18,
+	0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x07, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x22, 0xa8,
+	0x01, 0x0a, 0x14, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x72, 0x6f, 0x70, 0x70,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x75, 0x65, 0x43, 0x61, 0x70, 0x61,
+	0x63, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x22, 0x70, 0x0a, 0x10, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x45, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4c, 0x6f, 0x73, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x4c, 0x6f, 0x73, 0x74, 0x22, 0x56, 0x0a, 0x10, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x46, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x42, 0x61, 0x63, 0x6b, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x42, 0x61, 0x63,
+	0x6b, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x72, 0x6f, 0x70, 0x70,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x4c, 0x61, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03,
+	0x4c, 0x61, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x53,
+	0x69, 0x7a, 0x65, 0x22, 0x48, 0x0a, 0x15, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4c, 0x6f, 0x67,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x05,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x6f,
+	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x18, 0x0a,
+	0x16, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x63, 0x6b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x37, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x63,
+	0x6b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x53, 0x74,
+	0x61, 0x63, 0x6b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x2a, 0x56, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x65, 0x62, 0x75, 0x67, 0x10, 0x00, 0x12,
+	0x08, 0x0a, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x61, 0x72,
+	0x6e, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x03, 0x12, 0x0a,
+	0x0a, 0x06, 0x44, 0x50, 0x61, 0x6e, 0x69, 0x63, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x61,
+	0x6e, 0x69, 0x63, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x61, 0x74, 0x61, 0x6c, 0x10, 0x06,
+	0x32, 0xad, 0x02, 0x0a, 0x11, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x55, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x12, 0x22, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a,
+	0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x26, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
 	0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53,
-	0x74, 0x61, 0x63, 0x6b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x12, 0x24, 0x2e, 0x74, 0x72, 0x61, 0x63,
-	0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
-	0x61, 0x63, 0x6b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x25, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x2f, 0x61, 0x71, 0x75, 0x61, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
-	0x2f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5e, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x12, 0x25, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x63, 0x6b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x2f, 0x6b, 0x68,
+	0x75, 0x6c, 0x6e, 0x61, 0x73, 0x6f, 0x66, 0x74, 0x2d, 0x6c, 0x61, 0x62, 0x2f, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -483,29 +877,37 @@ func file_api_v1beta1_diagnostic_proto_rawDescGZIP() []byte {
 }
 
 var file_api_v1beta1_diagnostic_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_v1beta1_diagnostic_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_api_v1beta1_diagnostic_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_api_v1beta1_diagnostic_proto_goTypes = []interface{}{
 	(LogLevel)(0),                  // 0: tracker.v1beta1.LogLevel
 	(*GetMetricsRequest)(nil),      // 1: tracker.v1beta1.GetMetricsRequest
 	(*GetMetricsResponse)(nil),     // 2: tracker.v1beta1.GetMetricsResponse
-	(*ChangeLogLevelRequest)(nil),  // 3: tracker.v1beta1.ChangeLogLevelRequest
-	(*ChangeLogLevelResponse)(nil), // 4: tracker.v1beta1.ChangeLogLevelResponse
-	(*GetStacktraceRequest)(nil),   // 5: tracker.v1beta1.GetStacktraceRequest
-	(*GetStacktraceResponse)(nil),  // 6: tracker.v1beta1.GetStacktraceResponse
+	(*PipelineStageMetrics)(nil),   // 3: tracker.v1beta1.PipelineStageMetrics
+	(*EventTypeMetrics)(nil),       // 4: tracker.v1beta1.EventTypeMetrics
+	(*SignatureMetrics)(nil),       // 5: tracker.v1beta1.SignatureMetrics
+	(*StreamMetrics)(nil),          // 6: tracker.v1beta1.StreamMetrics
+	(*ChangeLogLevelRequest)(nil),  // 7: tracker.v1beta1.ChangeLogLevelRequest
+	(*ChangeLogLevelResponse)(nil), // 8: tracker.v1beta1.ChangeLogLevelResponse
+	(*GetStacktraceRequest)(nil),   // 9: tracker.v1beta1.GetStacktraceRequest
+	(*GetStacktraceResponse)(nil),  // 10: tracker.v1beta1.GetStacktraceResponse
 }
 var file_api_v1beta1_diagnostic_proto_depIdxs = []int32{
-	0, // 0: tracker.v1beta1.ChangeLogLevelRequest.level:type_name -> tracker.v1beta1.LogLevel
-	1, // 1: tracker.v1beta1.DiagnosticService.GetMetrics:input_type -> tracker.v1beta1.GetMetricsRequest
-	3, // 2: tracker.v1beta1.DiagnosticService.ChangeLogLevel:input_type -> tracker.v1beta1.ChangeLogLevelRequest
-	5, // 3: tracker.v1beta1.DiagnosticService.GetStacktrace:input_type -> tracker.v1beta1.GetStacktraceRequest
-	2, // 4: tracker.v1beta1.DiagnosticService.GetMetrics:output_type -> tracker.v1beta1.GetMetricsResponse
-	4, // 5: tracker.v1beta1.DiagnosticService.ChangeLogLevel:output_type -> tracker.v1beta1.ChangeLogLevelResponse
-	6, // 6: tracker.v1beta1.DiagnosticService.GetStacktrace:output_type -> tracker.v1beta1.GetStacktraceResponse
-	4, // [4:7] is the sub-list for method output_type
-	1, // [1:4] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3,  // 0: tracker.v1beta1.GetMetricsResponse.Stages:type_name -> tracker.v1beta1.PipelineStageMetrics
+	4,  // 1: tracker.v1beta1.GetMetricsResponse.EventTypes:type_name -> tracker.v1beta1.EventTypeMetrics
+	5,  // 2: tracker.v1beta1.GetMetricsResponse.Signatures:type_name -> tracker.v1beta1.SignatureMetrics
+	6,  // 3: tracker.v1beta1.GetMetricsResponse.Streams:type_name -> tracker.v1beta1.StreamMetrics
+	0,  // 4: tracker.v1beta1.ChangeLogLevelRequest.level:type_name -> tracker.v1beta1.LogLevel
+	1,  // 5: tracker.v1beta1.DiagnosticService.GetMetrics:input_type -> tracker.v1beta1.GetMetricsRequest
+	7,  // 6: tracker.v1beta1.DiagnosticService.ChangeLogLevel:input_type -> tracker.v1beta1.ChangeLogLevelRequest
+	9,  // 7: tracker.v1beta1.DiagnosticService.GetStacktrace:input_type -> tracker.v1beta1.GetStacktraceRequest
+	2,  // 8: tracker.v1beta1.DiagnosticService.GetMetrics:output_type -> tracker.v1beta1.GetMetricsResponse
+	8,  // 9: tracker.v1beta1.DiagnosticService.ChangeLogLevel:output_type -> tracker.v1beta1.ChangeLogLevelResponse
+	10, // 10: tracker.v1beta1.DiagnosticService.GetStacktrace:output_type -> tracker.v1beta1.GetStacktraceResponse
+	8,  // [8:11] is the sub-list for method output_type
+	5,  // [5:8] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_api_v1beta1_diagnostic_proto_init() }
@@ -539,7 +941,7 @@ func file_api_v1beta1_diagnostic_proto_init() {
 			}
 		}
 		file_api_v1beta1_diagnostic_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangeLogLevelRequest); i {
+			switch v := v.(*PipelineStageMetrics); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -551,7 +953,7 @@ func file_api_v1beta1_diagnostic_proto_init() {
 			}
 		}
 		file_api_v1beta1_diagnostic_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangeLogLevelResponse); i {
+			switch v := v.(*EventTypeMetrics); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -563,7 +965,7 @@ func file_api_v1beta1_diagnostic_proto_init() {
 			}
 		}
 		file_api_v1beta1_diagnostic_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStacktraceRequest); i {
+			switch v := v.(*SignatureMetrics); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -575,6 +977,54 @@ func file_api_v1beta1_diagnostic_proto_init() {
 			}
 		}
 		file_api_v1beta1_diagnostic_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamMetrics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1beta1_diagnostic_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeLogLevelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1beta1_diagnostic_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeLogLevelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1beta1_diagnostic_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStacktraceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1beta1_diagnostic_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetStacktraceResponse); i {
 			case 0:
 				return &v.state
@@ -593,7 +1043,7 @@ func file_api_v1beta1_diagnostic_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_v1beta1_diagnostic_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   6,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
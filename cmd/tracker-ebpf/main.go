@@ -102,6 +102,10 @@ func main() {
 				Value: cli.NewStringSlice("none"),
 				Usage: "dns cache options. run '--dnscache help' for more info",
 			},
+			&cli.StringSliceFlag{
+				Name:  "redact",
+				Usage: "mask sensitive data in event arguments. run '--redact help' for more info",
+			},
 			&cli.StringSliceFlag{
 				Name:  "cri",
 				Usage: "define connected container runtimes. run '--cri help' for more info.",
@@ -138,11 +142,20 @@ func main() {
 				Usage: "enable pprof endpoints",
 				Value: false,
 			},
+			&cli.BoolFlag{
+				Name:  server.APIEndpointFlag,
+				Usage: "enable the REST API endpoint",
+				Value: false,
+			},
 			&cli.BoolFlag{
 				Name:  server.PyroscopeAgentFlag,
 				Usage: "enable pyroscope agent",
 				Value: false,
 			},
+			&cli.StringSliceFlag{
+				Name:  server.ServerAuthFlag,
+				Usage: "secure and authorize the calls to the http server. run '--server-auth help' for more info.",
+			},
 			&cli.StringFlag{
 				Name:  server.HTTPListenEndpointFlag,
 				Usage: "listening address of the metrics endpoint server",
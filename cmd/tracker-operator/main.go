@@ -12,6 +12,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	policyv1beta1 "github.com/khulnasoft-lab/tracker/pkg/k8s/apis/tracker.khulnasoft.com/v1beta1"
 	"github.com/khulnasoft-lab/tracker/pkg/k8s/controller"
@@ -33,6 +34,9 @@ type Config struct {
 	TrackerNamespace     string
 	TrackerName          string
 	EnableLeaderElection bool
+	EnableWebhook        bool
+	WebhookPort          int
+	WebhookCertDir       string
 	LoggingOpts          zap.Options
 }
 
@@ -51,6 +55,10 @@ func main() {
 		HealthProbeBindAddress: config.ProbeAddr,
 		LeaderElection:         config.EnableLeaderElection,
 		LeaderElectionID:       "ecaf1259.my.domain",
+		WebhookServer: webhook.NewServer(webhook.Options{
+			Port:    config.WebhookPort,
+			CertDir: config.WebhookCertDir,
+		}),
 	})
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
@@ -71,6 +79,17 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Register the PolicyValidator with the webhook server, so invalid policies are
+	// rejected before being stored in the cluster.
+
+	if config.EnableWebhook {
+		validator := &controller.PolicyValidator{}
+		if err := validator.SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "PolicyValidator")
+			os.Exit(1)
+		}
+	}
+
 	// Create health and readyz endpoints for the controller manager to report its health.
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -101,6 +120,9 @@ func parseConfig() Config {
 	flag.StringVar(&cfg.TrackerNamespace, "tracker-namespace", "tracker-system", "The namespace where Tracker is installed.")
 	flag.StringVar(&cfg.TrackerName, "tracker-name", "tracker", "The name of the Tracker DaemonSet.")
 	flag.BoolVar(&cfg.EnableLeaderElection, "leader-elect", false, "Enable leader election for controller manager. Enabling this will ensure there is only one active controller manager.")
+	flag.BoolVar(&cfg.EnableWebhook, "enable-webhook", false, "Enable the validating webhook for Tracker policies.")
+	flag.IntVar(&cfg.WebhookPort, "webhook-port", 9443, "The port the webhook server binds to.")
+	flag.StringVar(&cfg.WebhookCertDir, "webhook-cert-dir", "", "The directory with the webhook server certificate (tls.crt and tls.key).")
 	cfg.LoggingOpts = zap.Options{
 		Development: true,
 	}
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/khulnasoft-lab/tracker/pkg/capabilities"
 	"github.com/khulnasoft-lab/tracker/pkg/errfmt"
 	"github.com/khulnasoft-lab/tracker/pkg/logger"
+	"github.com/khulnasoft-lab/tracker/pkg/protoevent"
 	"github.com/khulnasoft-lab/tracker/types/protocol"
 	"github.com/khulnasoft-lab/tracker/types/trace"
 )
@@ -23,6 +25,7 @@ type inputFormat uint8
 const (
 	invalidInputFormat inputFormat = iota
 	jsonInputFormat
+	protobufInputFormat
 )
 
 type trackerInputOptions struct {
@@ -31,8 +34,11 @@ type trackerInputOptions struct {
 }
 
 func setupTrackerInputSource(opts *trackerInputOptions) (chan protocol.Event, error) {
-	if opts.inputFormat == jsonInputFormat {
+	switch opts.inputFormat {
+	case jsonInputFormat:
 		return setupTrackerJSONInputSource(opts)
+	case protobufInputFormat:
+		return setupTrackerProtobufInputSource(opts)
 	}
 
 	return nil, errfmt.Errorf("could not set up input source")
@@ -60,6 +66,35 @@ func setupTrackerJSONInputSource(opts *trackerInputOptions) (chan protocol.Event
 	return res, nil
 }
 
+func setupTrackerProtobufInputSource(opts *trackerInputOptions) (chan protocol.Event, error) {
+	res := make(chan protocol.Event)
+	reader := protoevent.NewReader(opts.inputFile)
+	go func() {
+		for {
+			pbEvent, err := reader.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				// the stream can't be resynchronized after an invalid event
+				logger.Errorw("Invalid protobuf event", "error", err)
+				break
+			}
+			e, err := protoevent.ToTrace(pbEvent)
+			if err != nil {
+				logger.Errorw("Invalid protobuf event", "error", err)
+				continue
+			}
+			res <- e.ToProtocol()
+		}
+		if err := opts.inputFile.Close(); err != nil {
+			logger.Errorw("Closing file", "error", err)
+		}
+		close(res)
+	}()
+	return res, nil
+}
+
 func parseTrackerInputOptions(inputOptions []string) (*trackerInputOptions, error) {
 	var (
 		inputSourceOptions trackerInputOptions
@@ -134,6 +169,8 @@ func parseTrackerInputFormat(option *trackerInputOptions, formatString string) e
 	switch formatString {
 	case "JSON":
 		option.inputFormat = jsonInputFormat
+	case "PROTOBUF":
+		option.inputFormat = protobufInputFormat
 	default:
 		option.inputFormat = invalidInputFormat
 		return errfmt.Errorf("invalid tracker input format specified: %s", formatString)
@@ -149,12 +186,13 @@ tracker-rules --input-tracker <key:value>,<key:value> --input-tracker <key:value
 Specify various key value pairs for input options tracker-ebpf. The following key options are available:
 
 'file'   - Input file source. You can specify a relative or absolute path. You may also specify 'stdin' for standard input.
-'format' - Input format. The only supported format is 'json' at the moment.
+'format' - Input format. The supported formats are 'json' and 'protobuf' (length-delimited events of the tracker protobuf output).
 
 Examples:
 
 'tracker-rules --input-tracker file:./events.json --input-tracker format:json'
 'sudo tracker-ebpf -o format:json | tracker-rules --input-tracker file:stdin --input-tracker format:json'
+'tracker-rules --input-tracker file:./events.pb --input-tracker format:protobuf'
 `
 
 	fmt.Println(trackerInputHelp)
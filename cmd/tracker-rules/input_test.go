@@ -9,6 +9,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	pb "github.com/khulnasoft-lab/tracker/api/v1beta1"
+	"github.com/khulnasoft-lab/tracker/pkg/events/builtin"
+	"github.com/khulnasoft-lab/tracker/pkg/protoevent"
 	"github.com/khulnasoft-lab/tracker/types/trace"
 )
 
@@ -162,3 +165,46 @@ func TestSetupTrackerJSONInputSource(t *testing.T) {
 		})
 	}
 }
+
+func TestSetupTrackerProtobufInputSource(t *testing.T) {
+	f, err := os.CreateTemp("", "TestSetupTrackerProtobufInputSource-")
+	require.NoError(t, err)
+	defer func() {
+		_ = f.Close()
+		_ = os.RemoveAll(f.Name())
+	}()
+
+	w := protoevent.NewWriter(f)
+	require.NoError(t, w.Write(&pb.Event{Name: "openat", Policies: &pb.Policies{Matched: []string{"default"}}}))
+	require.NoError(t, w.Write(&pb.Event{Name: "execve", Data: []*pb.EventValue{
+		{Name: "pathname", Value: &pb.EventValue_Str{Str: "/bin/ls"}},
+	}}))
+	_, err = f.Seek(0, 0)
+	require.NoError(t, err)
+
+	opts := &trackerInputOptions{inputFile: f, inputFormat: protobufInputFormat}
+	eventsChan, err := setupTrackerInputSource(opts)
+	require.NoError(t, err)
+
+	readEvents := []trace.Event{}
+	for e := range eventsChan {
+		trackerEvt, ok := e.Payload.(trace.Event)
+		require.True(t, ok)
+		readEvents = append(readEvents, trackerEvt)
+	}
+
+	openat, ok := builtin.Lookup("openat")
+	require.True(t, ok)
+	execve, ok := builtin.Lookup("execve")
+	require.True(t, ok)
+
+	assert.Equal(t, []trace.Event{
+		{EventID: openat.ID, EventName: "openat", MatchedPolicies: []string{"default"}},
+		{
+			EventID:   execve.ID,
+			EventName: "execve",
+			ArgsNum:   1,
+			Args:      []trace.Argument{{ArgMeta: trace.ArgMeta{Name: "pathname", Type: "const char*"}, Value: "/bin/ls"}},
+		},
+	}, readEvents)
+}
@@ -155,6 +155,7 @@ func main() {
 				c.Bool(server.HealthzEndpointFlag),
 				c.Bool(server.PProfEndpointFlag),
 				c.Bool(server.PyroscopeAgentFlag),
+				false, // no API without the tracker services
 			)
 			if err != nil {
 				return err
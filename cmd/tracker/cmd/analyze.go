@@ -5,8 +5,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"syscall"
 
 	"github.com/spf13/cobra"
@@ -15,8 +17,10 @@ import (
 	"github.com/khulnasoft-lab/tracker/pkg/cmd/flags"
 	"github.com/khulnasoft-lab/tracker/pkg/cmd/initialize"
 	tracker "github.com/khulnasoft-lab/tracker/pkg/ebpf"
+	"github.com/khulnasoft-lab/tracker/pkg/errfmt"
 	"github.com/khulnasoft-lab/tracker/pkg/events"
 	"github.com/khulnasoft-lab/tracker/pkg/logger"
+	"github.com/khulnasoft-lab/tracker/pkg/protoevent"
 	"github.com/khulnasoft-lab/tracker/pkg/signatures/engine"
 	"github.com/khulnasoft-lab/tracker/pkg/signatures/signature"
 	"github.com/khulnasoft-lab/tracker/types/detect"
@@ -51,6 +55,13 @@ func init() {
 		"Control event rego settings",
 	)
 
+	// input-format
+	analyze.Flags().String(
+		"input-format",
+		"",
+		"Format of the input file [json|protobuf], detected by the file extension if not set",
+	)
+
 	analyze.Flags().StringArrayP(
 		"log",
 		"l",
@@ -60,7 +71,7 @@ func init() {
 }
 
 var analyze = &cobra.Command{
-	Use:     "analyze input.json",
+	Use:     "analyze input.json|input.pb",
 	Aliases: []string{},
 	Args:    cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
 	Short:   "Analyze past events with signature events [Experimental]",
@@ -70,9 +81,14 @@ Tracker can be used to collect events and store it in a file. This file can be u
 
 eg:
 tracker --events ptrace --output=json:events.json
-tracker analyze --events anti_debugging events.json`,
+tracker analyze --events anti_debugging events.json
+
+Events recorded in the protobuf format (.pb files) can be analyzed too:
+tracker --events ptrace --output=protobuf:events.pb
+tracker analyze --events anti_debugging events.pb`,
 	PreRun: func(cmd *cobra.Command, args []string) {
 		bindViperFlag(cmd, "events")
+		bindViperFlag(cmd, "input-format")
 		bindViperFlag(cmd, "log")
 		bindViperFlag(cmd, "rego")
 		bindViperFlag(cmd, "signatures-dir")
@@ -91,6 +107,11 @@ tracker analyze --events anti_debugging events.json`,
 			logger.Fatalw("Failed to get signatures-dir flag", "err", err)
 		}
 
+		inputFormat, err := getInputFormat(viper.GetString("input-format"), args[0])
+		if err != nil {
+			logger.Fatalw("Failed to get input format", "err", err)
+		}
+
 		// Rego command line flags
 
 		rego, err := flags.PrepareRego(viper.GetStringSlice("rego"))
@@ -155,7 +176,11 @@ tracker analyze --events anti_debugging events.json`,
 		go sigEngine.Start(ctx)
 
 		// producer
-		go produce(ctx, inputFile, engineInput)
+		if inputFormat == "protobuf" {
+			go produceProtobuf(ctx, inputFile, engineInput)
+		} else {
+			go produce(ctx, inputFile, engineInput)
+		}
 
 		// consumer
 		for {
@@ -211,6 +236,49 @@ func produce(ctx context.Context, inputFile *os.File, engineInput chan protocol.
 	}
 }
 
+// getInputFormat returns the format of the input file, given or detected by the file
+// extension: protobuf for .pb files, json otherwise
+func getInputFormat(format, path string) (string, error) {
+	switch format {
+	case "json", "protobuf":
+		return format, nil
+	case "":
+		if filepath.Ext(path) == ".pb" {
+			return "protobuf", nil
+		}
+		return "json", nil
+	}
+
+	return "", errfmt.Errorf("invalid input format %q, use one of json or protobuf", format)
+}
+
+func produceProtobuf(ctx context.Context, inputFile *os.File, engineInput chan protocol.Event) {
+	// ensure the engineInput channel will be closed
+	defer close(engineInput)
+
+	reader := protoevent.NewReader(inputFile)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+			pbEvent, err := reader.Read()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				logger.Fatalw("Failed to read event", "err", err)
+			}
+
+			e, err := protoevent.ToTrace(pbEvent)
+			if err != nil {
+				logger.Fatalw("Failed to convert event", "err", err)
+			}
+			engineInput <- e.ToProtocol()
+		}
+	}
+}
+
 func process(finding *detect.Finding) {
 	event, err := tracker.FindingToEvent(finding)
 	if err != nil {
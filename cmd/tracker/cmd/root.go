@@ -114,15 +114,25 @@ func initCmd() error {
 		"[file|dir]\t\t\t\tPath to a policy or directory with policies",
 	)
 
+	rootCmd.Flags().Bool(
+		"auto-reload",
+		false,
+		"\t\t\t\t\tReload policies and signatures when their files change (always reloaded on SIGHUP)",
+	)
+	err := viper.BindPFlag("auto-reload", rootCmd.Flags().Lookup("auto-reload"))
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+
 	// Output flags
 
 	rootCmd.Flags().StringArrayP(
 		"output",
 		"o",
 		[]string{"table"},
-		"[json|none|webhook...]\t\tControl how and where output is printed",
+		"[json|cef|protobuf|none|webhook|syslog|otlp|kafka...]\tControl how and where output is printed",
 	)
-	err := viper.BindPFlag("output", rootCmd.Flags().Lookup("output"))
+	err = viper.BindPFlag("output", rootCmd.Flags().Lookup("output"))
 	if err != nil {
 		return errfmt.WrapError(err)
 	}
@@ -248,6 +258,18 @@ func initCmd() error {
 		return errfmt.WrapError(err)
 	}
 
+	// Redact flags
+
+	rootCmd.Flags().StringArray(
+		"redact",
+		[]string{},
+		"[args|pattern]\t\t\tMask sensitive data in event arguments",
+	)
+	err = viper.BindPFlag("redact", rootCmd.Flags().Lookup("redact"))
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+
 	// Server flags
 
 	rootCmd.Flags().Bool(
@@ -280,6 +302,16 @@ func initCmd() error {
 		return errfmt.WrapError(err)
 	}
 
+	rootCmd.Flags().Bool(
+		server.APIEndpointFlag,
+		false,
+		"\t\t\t\t\tEnable the REST API endpoint",
+	)
+	err = viper.BindPFlag(server.APIEndpointFlag, rootCmd.Flags().Lookup(server.APIEndpointFlag))
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+
 	rootCmd.Flags().Bool(
 		server.PyroscopeAgentFlag,
 		false,
@@ -290,6 +322,16 @@ func initCmd() error {
 		return errfmt.WrapError(err)
 	}
 
+	rootCmd.Flags().StringArray(
+		server.ServerAuthFlag,
+		[]string{},
+		"[tls-cert|tls-key|tls-client-ca|token|uid|gid|cn]\tSecure and authorize the calls to the servers",
+	)
+	err = viper.BindPFlag(server.ServerAuthFlag, rootCmd.Flags().Lookup(server.ServerAuthFlag))
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+
 	rootCmd.Flags().String(
 		server.HTTPListenEndpointFlag,
 		":3366",
@@ -310,6 +352,16 @@ func initCmd() error {
 		return errfmt.WrapError(err)
 	}
 
+	rootCmd.Flags().Int(
+		server.GRPCReplayBufferFlag,
+		0, // disabled by default
+		"<size>\t\t\t\tNumber of events kept to be replayed to resumed grpc streams (default: disabled)",
+	)
+	err = viper.BindPFlag(server.GRPCReplayBufferFlag, rootCmd.Flags().Lookup(server.GRPCReplayBufferFlag))
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+
 	// Other flags
 
 	rootCmd.Flags().StringArrayP(
@@ -2,6 +2,7 @@ package cobra
 
 import (
 	"errors"
+	"os"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -21,6 +22,7 @@ import (
 	"github.com/khulnasoft-lab/tracker/pkg/signatures/engine"
 	"github.com/khulnasoft-lab/tracker/pkg/signatures/signature"
 	"github.com/khulnasoft-lab/tracker/pkg/utils/environment"
+	"github.com/khulnasoft-lab/tracker/types/detect"
 )
 
 func GetTrackerRunner(c *cobra.Command, version string) (cmd.Runner, error) {
@@ -75,6 +77,7 @@ func GetTrackerRunner(c *cobra.Command, version string) (cmd.Runner, error) {
 		PerfBufferSize:     viper.GetInt("perf-buffer-size"),
 		BlobPerfBufferSize: viper.GetInt("blob-perf-buffer-size"),
 		NoContainersEnrich: viper.GetBool("no-containers"),
+		StreamReplaySize:   viper.GetInt(server.GRPCReplayBufferFlag),
 	}
 
 	// OS release information
@@ -151,6 +154,19 @@ func GetTrackerRunner(c *cobra.Command, version string) (cmd.Runner, error) {
 
 	cfg.DNSCacheConfig = dnsCache
 
+	// Redact command line flags
+
+	redactFlags, err := GetFlagsFromViper("redact")
+	if err != nil {
+		return runner, err
+	}
+
+	redactor, err := flags.PrepareRedact(redactFlags)
+	if err != nil {
+		return runner, err
+	}
+	cfg.Redactor = redactor
+
 	// Capture command line flags - via cobra flag
 
 	captureFlags, err := c.Flags().GetStringArray("capture")
@@ -207,6 +223,7 @@ func GetTrackerRunner(c *cobra.Command, version string) (cmd.Runner, error) {
 	// Try to get policies from kubernetes CRD, policy files and CLI in that order
 
 	var k8sPolicies []v1beta1.PolicyInterface
+	var policyDocuments []v1beta1.PolicyInterface
 	var policies *policy.Policies
 
 	k8sClient, err := k8s.New()
@@ -218,19 +235,26 @@ func GetTrackerRunner(c *cobra.Command, version string) (cmd.Runner, error) {
 	}
 	if len(k8sPolicies) > 0 {
 		logger.Debugw("using policies from kubernetes crd")
+		policyDocuments = k8sPolicies
 		policies, err = createPoliciesFromK8SPolicy(k8sPolicies)
 	} else if len(policyFlags) > 0 {
 		logger.Debugw("using policies from --policy flag")
-		policies, err = createPoliciesFromPolicyFiles(policyFlags)
+		policyDocuments, policies, err = createPoliciesFromPolicyFiles(policyFlags)
 	} else {
 		logger.Debugw("using policies from --scope and --events flag")
 		policies, err = createPoliciesFromCLIFlags(scopeFlags, eventFlags)
+		if k8sClient != nil {
+			// replaced by the kubernetes policies once they are created
+			policyDocuments = []v1beta1.PolicyInterface{}
+		}
 	}
 	if err != nil {
 		return runner, err
 	}
 
 	cfg.Policies = policies
+	cfg.PolicyDocuments = policyDocuments
+	cfg.PolicyCompiler = createPoliciesFromK8SPolicy
 
 	// Output command line flags
 
@@ -243,6 +267,9 @@ func GetTrackerRunner(c *cobra.Command, version string) (cmd.Runner, error) {
 	if err != nil {
 		return runner, err
 	}
+	if err := flags.ValidateRoutePolicies(output.PrinterConfigs, policies); err != nil {
+		return runner, err
+	}
 	cfg.Output = output.TrackerConfig
 
 	// Create printer
@@ -300,6 +327,7 @@ func GetTrackerRunner(c *cobra.Command, version string) (cmd.Runner, error) {
 		viper.GetBool(server.HealthzEndpointFlag),
 		viper.GetBool(server.PProfEndpointFlag),
 		viper.GetBool(server.PyroscopeAgentFlag),
+		viper.GetBool(server.APIEndpointFlag),
 	)
 	if err != nil {
 		return runner, err
@@ -310,8 +338,53 @@ func GetTrackerRunner(c *cobra.Command, version string) (cmd.Runner, error) {
 		return runner, err
 	}
 
+	serverAuthFlags, err := GetFlagsFromViper(server.ServerAuthFlag)
+	if err != nil {
+		return runner, err
+	}
+
+	authenticator, err := server.PrepareServerAuth(serverAuthFlags)
+	if err != nil {
+		return runner, err
+	}
+	if authenticator != nil {
+		if httpServer != nil {
+			httpServer.SetAuthenticator(authenticator)
+		}
+		if grpcServer != nil {
+			grpcServer.SetAuthenticator(authenticator)
+		}
+	}
+
+	// Prepare the reloader (policies from kubernetes are not reloaded from files)
+
+	reloader := &cmd.Reloader{
+		SignaturesDirs: viper.GetStringSlice("signatures-dir"),
+		FindSignatures: func() ([]detect.Signature, error) {
+			sigs, _, err := signature.Find(
+				rego.RuntimeTarget,
+				rego.PartialEval,
+				viper.GetStringSlice("signatures-dir"),
+				nil,
+				rego.AIO,
+			)
+			return sigs, err
+		},
+		Outputs: p,
+		Watch:   viper.GetBool("auto-reload"),
+	}
+	if k8sClient == nil {
+		reloader.PolicyPaths = policyFlags
+	} else {
+		runner.PolicyWatcher = &cmd.PolicyWatcher{
+			Client:   k8sClient,
+			NodeName: os.Getenv("NODE_NAME"),
+		}
+	}
+
 	runner.HTTPServer = httpServer
 	runner.GRPCServer = grpcServer
+	runner.Reloader = reloader
 	runner.TrackerConfig = cfg
 	runner.Printer = p
 	runner.InstallPath = trackerInstallPath
@@ -2,6 +2,9 @@ package cobra
 
 import (
 	"fmt"
+	neturl "net/url"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/mitchellh/mapstructure"
@@ -45,6 +48,10 @@ func GetFlagsFromViper(key string) ([]string, error) {
 		flagger = &OutputConfig{}
 	case "dnscache":
 		flagger = &DnsCacheConfig{}
+	case "redact":
+		flagger = &RedactConfig{}
+	case "server-auth":
+		flagger = &ServerAuthConfig{}
 	default:
 		return nil, errfmt.Errorf("unrecognized key: %s", key)
 	}
@@ -131,6 +138,7 @@ func getCRIConfigFlags(rawValue interface{}) ([]string, error) {
 type CacheConfig struct {
 	Type string `mapstructure:"type"`
 	Size int    `mapstructure:"size"`
+	Dir  string `mapstructure:"dir"`
 }
 
 func (c *CacheConfig) flags() []string {
@@ -139,6 +147,15 @@ func (c *CacheConfig) flags() []string {
 	if c.Type != "" {
 		flags = append(flags, fmt.Sprintf("cache-type=%s", c.Type))
 	}
+	if c.Type == "disk" {
+		if c.Size != 0 {
+			flags = append(flags, fmt.Sprintf("disk-cache-size=%d", c.Size))
+		}
+		if c.Dir != "" {
+			flags = append(flags, fmt.Sprintf("disk-cache-dir=%s", c.Dir))
+		}
+		return flags
+	}
 	if c.Size != 0 {
 		flags = append(flags, fmt.Sprintf("mem-cache-size=%d", c.Size))
 	}
@@ -226,6 +243,90 @@ func (c *DnsCacheConfig) flags() []string {
 	return flags
 }
 
+//
+// redact flag
+//
+
+type RedactConfig struct {
+	Rules []RedactRuleConfig `mapstructure:"rules"`
+}
+
+type RedactRuleConfig struct {
+	Args    []string `mapstructure:"args"`
+	Pattern string   `mapstructure:"pattern"`
+}
+
+func (c *RedactConfig) flags() []string {
+	flags := make([]string, 0)
+
+	for _, rule := range c.Rules {
+		var parts []string
+		if len(rule.Args) > 0 {
+			parts = append(parts, "args="+strings.Join(rule.Args, ","))
+		}
+		if rule.Pattern != "" {
+			parts = append(parts, "pattern="+rule.Pattern)
+		}
+		flags = append(flags, strings.Join(parts, ":"))
+	}
+
+	return flags
+}
+
+//
+// server-auth flag
+//
+
+type ServerAuthConfig struct {
+	TLS   ServerAuthTLSConfig    `mapstructure:"tls"`
+	Roles []ServerAuthRoleConfig `mapstructure:"roles"`
+}
+
+type ServerAuthTLSConfig struct {
+	Cert     string `mapstructure:"cert"`
+	Key      string `mapstructure:"key"`
+	ClientCA string `mapstructure:"client-ca"`
+}
+
+type ServerAuthRoleConfig struct {
+	Role        string   `mapstructure:"role"`
+	TokenFiles  []string `mapstructure:"token-files"`
+	UIDs        []string `mapstructure:"uids"`
+	GIDs        []string `mapstructure:"gids"`
+	CommonNames []string `mapstructure:"common-names"`
+}
+
+func (c *ServerAuthConfig) flags() []string {
+	flags := make([]string, 0)
+
+	if c.TLS.Cert != "" {
+		flags = append(flags, "tls-cert="+c.TLS.Cert)
+	}
+	if c.TLS.Key != "" {
+		flags = append(flags, "tls-key="+c.TLS.Key)
+	}
+	if c.TLS.ClientCA != "" {
+		flags = append(flags, "tls-client-ca="+c.TLS.ClientCA)
+	}
+
+	for _, role := range c.Roles {
+		for _, file := range role.TokenFiles {
+			flags = append(flags, fmt.Sprintf("token=%s:%s", role.Role, file))
+		}
+		if len(role.UIDs) > 0 {
+			flags = append(flags, fmt.Sprintf("uid=%s:%s", role.Role, strings.Join(role.UIDs, ",")))
+		}
+		if len(role.GIDs) > 0 {
+			flags = append(flags, fmt.Sprintf("gid=%s:%s", role.Role, strings.Join(role.GIDs, ",")))
+		}
+		if len(role.CommonNames) > 0 {
+			flags = append(flags, fmt.Sprintf("cn=%s:%s", role.Role, strings.Join(role.CommonNames, ",")))
+		}
+	}
+
+	return flags
+}
+
 //
 // capabilities flag
 //
@@ -376,10 +477,15 @@ type OutputConfig struct {
 	Options      OutputOptsConfig               `mapstructure:"options"`
 	Table        OutputFormatConfig             `mapstructure:"table"`
 	TableVerbose OutputFormatConfig             `mapstructure:"table-verbose"`
-	JSON         OutputFormatConfig             `mapstructure:"json"`
+	JSON         OutputJSONConfig               `mapstructure:"json"`
+	CEF          OutputFormatConfig             `mapstructure:"cef"`
+	Protobuf     OutputFormatConfig             `mapstructure:"protobuf"`
 	GoTemplate   OutputGoTemplateConfig         `mapstructure:"gotemplate"`
 	Forwards     map[string]OutputForwardConfig `mapstructure:"forward"`
 	Webhooks     map[string]OutputWebhookConfig `mapstructure:"webhook"`
+	Syslogs      map[string]OutputSyslogConfig  `mapstructure:"syslog"`
+	OTLPs        map[string]OutputOTLPConfig    `mapstructure:"otlp"`
+	Kafkas       map[string]OutputKafkaConfig   `mapstructure:"kafka"`
 }
 
 func (c *OutputConfig) flags() []string {
@@ -410,12 +516,24 @@ func (c *OutputConfig) flags() []string {
 	if c.Options.SortEvents {
 		flags = append(flags, "option:sort-events")
 	}
+	labels := make([]string, 0, len(c.Options.Labels))
+	for key := range c.Options.Labels {
+		labels = append(labels, key)
+	}
+	sort.Strings(labels)
+	for _, key := range labels {
+		flags = append(flags, fmt.Sprintf("option:label=%s=%s", key, c.Options.Labels[key]))
+	}
+	if c.Options.NodeLabels {
+		flags = append(flags, "option:node-labels")
+	}
 
 	// formats with files
 	formatFilesMap := map[string][]string{
 		"table":         c.Table.Files,
 		"table-verbose": c.TableVerbose.Files,
-		"json":          c.JSON.Files,
+		"cef":           c.CEF.Files,
+		"protobuf":      c.Protobuf.Files,
 	}
 	for format, files := range formatFilesMap {
 		for _, file := range files {
@@ -423,6 +541,11 @@ func (c *OutputConfig) flags() []string {
 		}
 	}
 
+	// json
+	for _, file := range c.JSON.Files {
+		flags = append(flags, fmt.Sprintf("json:%s", appendJSONParams(file, c.JSON)))
+	}
+
 	// gotemplate
 	if c.GoTemplate.Template != "" {
 		templateFlag := fmt.Sprintf("gotemplate=%s", c.GoTemplate.Template)
@@ -448,6 +571,8 @@ func (c *OutputConfig) flags() []string {
 			url += fmt.Sprintf("?tag=%s", forward.Tag)
 		}
 
+		url = appendRouteParams(url, forward.Route)
+
 		flags = append(flags, fmt.Sprintf("forward:%s", url))
 	}
 
@@ -466,48 +591,324 @@ func (c *OutputConfig) flags() []string {
 		}
 		if webhook.ContentType != "" {
 			url += fmt.Sprintf("%scontentType=%s", delim, webhook.ContentType)
+			delim = "&"
 		}
+		params := []struct{ key, value string }{
+			{"batchSize", nonZero(webhook.BatchSize)},
+			{"batchTimeout", webhook.BatchTimeout},
+			{"batchFormat", webhook.BatchFormat},
+			{"queueSize", nonZero(webhook.QueueSize)},
+			{"compression", webhook.Compression},
+			{"tokenFile", webhook.TokenFile},
+			{"tokenEnv", webhook.TokenEnv},
+			{"cert", webhook.Cert},
+			{"key", webhook.Key},
+			{"ca", webhook.CA},
+		}
+		if webhook.Retries != nil {
+			params = append(params, struct{ key, value string }{"retries", strconv.Itoa(*webhook.Retries)})
+		}
+		for _, header := range webhook.Headers {
+			params = append(params, struct{ key, value string }{"header", neturl.QueryEscape(header)})
+		}
+		for _, header := range webhook.HeaderFiles {
+			name, path, _ := strings.Cut(header, ":")
+			params = append(params, struct{ key, value string }{"headerFile", strings.TrimSpace(name) + ":" + strings.TrimSpace(path)})
+		}
+		for _, param := range params {
+			if param.value != "" {
+				url += fmt.Sprintf("%s%s=%s", delim, param.key, param.value)
+				delim = "&"
+			}
+		}
+
+		url = appendRouteParams(url, webhook.Route)
 
 		flags = append(flags, fmt.Sprintf("webhook:%s", url))
 	}
 
+	// syslog
+	for syslogName, syslog := range c.Syslogs {
+		_ = syslogName
+		delim := "?"
+		url := fmt.Sprintf("%s://%s:%d", syslog.Protocol, syslog.Host, syslog.Port)
+		params := []struct{ key, value string }{
+			{"format", syslog.Format},
+			{"facility", syslog.Facility},
+			{"appName", syslog.AppName},
+			{"ca", syslog.CA},
+			{"timeout", syslog.Timeout},
+		}
+		for _, param := range params {
+			if param.value != "" {
+				url += fmt.Sprintf("%s%s=%s", delim, param.key, param.value)
+				delim = "&"
+			}
+		}
+
+		url = appendRouteParams(url, syslog.Route)
+
+		flags = append(flags, fmt.Sprintf("syslog:%s", url))
+	}
+
+	// otlp
+	for otlpName, otlp := range c.OTLPs {
+		_ = otlpName
+		kind := "otlp"
+		if otlp.Protocol == "http" || otlp.Protocol == "https" {
+			kind = "otlp-http"
+		}
+		delim := "?"
+		url := fmt.Sprintf("%s://%s:%d%s", otlp.Protocol, otlp.Host, otlp.Port, otlp.Path)
+		if otlp.BatchSize != 0 {
+			url += fmt.Sprintf("%sbatchSize=%d", delim, otlp.BatchSize)
+			delim = "&"
+		}
+		if otlp.BatchTimeout != "" {
+			url += fmt.Sprintf("%sbatchTimeout=%s", delim, otlp.BatchTimeout)
+			delim = "&"
+		}
+		if otlp.Retries != nil {
+			url += fmt.Sprintf("%sretries=%d", delim, *otlp.Retries)
+			delim = "&"
+		}
+		if otlp.Timeout != "" {
+			url += fmt.Sprintf("%stimeout=%s", delim, otlp.Timeout)
+			delim = "&"
+		}
+		if otlp.CA != "" {
+			url += fmt.Sprintf("%sca=%s", delim, otlp.CA)
+		}
+
+		url = appendRouteParams(url, otlp.Route)
+
+		flags = append(flags, fmt.Sprintf("%s:%s", kind, url))
+	}
+
+	// kafka
+	for kafkaName, kafka := range c.Kafkas {
+		_ = kafkaName
+		url := fmt.Sprintf("%s?topic=%s", strings.Join(kafka.Brokers, ","), kafka.Topic)
+		if kafka.Key != "" {
+			url += fmt.Sprintf("&key=%s", kafka.Key)
+		}
+		if kafka.Compression != "" {
+			url += fmt.Sprintf("&compression=%s", kafka.Compression)
+		}
+		if kafka.BatchBytes != 0 {
+			url += fmt.Sprintf("&batchBytes=%d", kafka.BatchBytes)
+		}
+		if kafka.BatchTimeout != "" {
+			url += fmt.Sprintf("&batchTimeout=%s", kafka.BatchTimeout)
+		}
+		if kafka.Retries != nil {
+			url += fmt.Sprintf("&retries=%d", *kafka.Retries)
+		}
+		if kafka.Acks != "" {
+			url += fmt.Sprintf("&acks=%s", kafka.Acks)
+		}
+		if kafka.Timeout != "" {
+			url += fmt.Sprintf("&timeout=%s", kafka.Timeout)
+		}
+		if kafka.QueueSize != 0 {
+			url += fmt.Sprintf("&queueSize=%d", kafka.QueueSize)
+		}
+		if kafka.TLS {
+			url += "&tls=true"
+		}
+		if kafka.TLSCert != "" {
+			url += fmt.Sprintf("&tlsCert=%s", kafka.TLSCert)
+		}
+		if kafka.TLSKey != "" {
+			url += fmt.Sprintf("&tlsKey=%s", kafka.TLSKey)
+		}
+		if kafka.TLSCA != "" {
+			url += fmt.Sprintf("&tlsCA=%s", kafka.TLSCA)
+		}
+		if kafka.SASL != "" {
+			url += fmt.Sprintf("&sasl=%s&user=%s", kafka.SASL, kafka.User)
+		}
+		if kafka.PasswordFile != "" {
+			url += fmt.Sprintf("&passwordFile=%s", kafka.PasswordFile)
+		}
+
+		url = appendRouteParams(url, kafka.Route)
+
+		flags = append(flags, fmt.Sprintf("kafka:%s", url))
+	}
+
 	return flags
 }
 
 type OutputOptsConfig struct {
-	None              bool   `mapstructure:"none"`
-	StackAddresses    bool   `mapstructure:"stack-addresses"`
-	ExecEnv           bool   `mapstructure:"exec-env"`
-	RelativeTime      bool   `mapstructure:"relative-time"`
-	ExecHash          string `mapstructure:"exec-hash"`
-	ParseArguments    bool   `mapstructure:"parse-arguments"`
-	ParseArgumentsFDs bool   `mapstructure:"parse-arguments-fds"`
-	SortEvents        bool   `mapstructure:"sort-events"`
+	None              bool              `mapstructure:"none"`
+	StackAddresses    bool              `mapstructure:"stack-addresses"`
+	ExecEnv           bool              `mapstructure:"exec-env"`
+	RelativeTime      bool              `mapstructure:"relative-time"`
+	ExecHash          string            `mapstructure:"exec-hash"`
+	ParseArguments    bool              `mapstructure:"parse-arguments"`
+	ParseArgumentsFDs bool              `mapstructure:"parse-arguments-fds"`
+	SortEvents        bool              `mapstructure:"sort-events"`
+	Labels            map[string]string `mapstructure:"labels"`
+	NodeLabels        bool              `mapstructure:"node-labels"`
 }
 
 type OutputFormatConfig struct {
 	Files []string `mapstructure:"files"`
 }
 
+type OutputJSONConfig struct {
+	Files     []string `mapstructure:"files"`
+	Fields    []string `mapstructure:"fields"`
+	OmitEmpty bool     `mapstructure:"omit-empty"`
+}
+
+// appendJSONParams appends the json options to a file path, the fields last as their
+// commas would otherwise separate files
+func appendJSONParams(file string, c OutputJSONConfig) string {
+	var params []string
+	if c.OmitEmpty {
+		params = append(params, "omit-empty=true")
+	}
+	if len(c.Fields) > 0 {
+		params = append(params, "fields="+strings.Join(c.Fields, ","))
+	}
+
+	for _, param := range params {
+		delim := "?"
+		if strings.Contains(file, "?") {
+			delim = "&"
+		}
+		file += delim + param
+	}
+
+	return file
+}
+
 type OutputGoTemplateConfig struct {
 	Template string   `mapstructure:"template"`
 	Files    []string `mapstructure:"files"`
 }
 
 type OutputForwardConfig struct {
-	Protocol string `mapstructure:"protocol"`
-	User     string `mapstructure:"user"`
-	Password string `mapstructure:"password"`
-	Host     string `mapstructure:"host"`
-	Port     int    `mapstructure:"port"`
-	Tag      string `mapstructure:"tag"`
+	Protocol string            `mapstructure:"protocol"`
+	User     string            `mapstructure:"user"`
+	Password string            `mapstructure:"password"`
+	Host     string            `mapstructure:"host"`
+	Port     int               `mapstructure:"port"`
+	Tag      string            `mapstructure:"tag"`
+	Route    OutputRouteConfig `mapstructure:"route"`
+}
+
+// nonZero returns n as a string, or an empty string if n is zero
+func nonZero(n int) string {
+	if n == 0 {
+		return ""
+	}
+
+	return strconv.Itoa(n)
 }
 
 type OutputWebhookConfig struct {
-	Protocol    string `mapstructure:"protocol"`
-	Host        string `mapstructure:"host"`
-	Port        int    `mapstructure:"port"`
-	Timeout     string `mapstructure:"timeout"`
-	GoTemplate  string `mapstructure:"gotemplate"`
-	ContentType string `mapstructure:"content-type"`
+	Protocol     string            `mapstructure:"protocol"`
+	Host         string            `mapstructure:"host"`
+	Port         int               `mapstructure:"port"`
+	Timeout      string            `mapstructure:"timeout"`
+	GoTemplate   string            `mapstructure:"gotemplate"`
+	ContentType  string            `mapstructure:"content-type"`
+	BatchSize    int               `mapstructure:"batch-size"`
+	BatchTimeout string            `mapstructure:"batch-timeout"`
+	BatchFormat  string            `mapstructure:"batch-format"`
+	QueueSize    int               `mapstructure:"queue-size"`
+	Retries      *int              `mapstructure:"retries"`
+	Headers      []string          `mapstructure:"headers"`      // name: value
+	HeaderFiles  []string          `mapstructure:"header-files"` // name: path
+	TokenFile    string            `mapstructure:"token-file"`
+	TokenEnv     string            `mapstructure:"token-env"`
+	Compression  string            `mapstructure:"compression"`
+	Cert         string            `mapstructure:"cert"`
+	Key          string            `mapstructure:"key"`
+	CA           string            `mapstructure:"ca"`
+	Route        OutputRouteConfig `mapstructure:"route"`
+}
+
+type OutputSyslogConfig struct {
+	Protocol string            `mapstructure:"protocol"`
+	Host     string            `mapstructure:"host"`
+	Port     int               `mapstructure:"port"`
+	Format   string            `mapstructure:"format"`
+	Facility string            `mapstructure:"facility"`
+	AppName  string            `mapstructure:"app-name"`
+	CA       string            `mapstructure:"ca"`
+	Timeout  string            `mapstructure:"timeout"`
+	Route    OutputRouteConfig `mapstructure:"route"`
+}
+
+type OutputOTLPConfig struct {
+	Protocol     string            `mapstructure:"protocol"`
+	Host         string            `mapstructure:"host"`
+	Port         int               `mapstructure:"port"`
+	Path         string            `mapstructure:"path"`
+	BatchSize    int               `mapstructure:"batch-size"`
+	BatchTimeout string            `mapstructure:"batch-timeout"`
+	Retries      *int              `mapstructure:"retries"`
+	Timeout      string            `mapstructure:"timeout"`
+	CA           string            `mapstructure:"ca"`
+	Route        OutputRouteConfig `mapstructure:"route"`
+}
+
+type OutputKafkaConfig struct {
+	Brokers      []string          `mapstructure:"brokers"`
+	Topic        string            `mapstructure:"topic"`
+	Key          string            `mapstructure:"key"`
+	Compression  string            `mapstructure:"compression"`
+	BatchBytes   int               `mapstructure:"batch-bytes"`
+	BatchTimeout string            `mapstructure:"batch-timeout"`
+	Retries      *int              `mapstructure:"retries"`
+	Acks         string            `mapstructure:"acks"`
+	Timeout      string            `mapstructure:"timeout"`
+	QueueSize    int               `mapstructure:"queue-size"`
+	TLS          bool              `mapstructure:"tls"`
+	TLSCert      string            `mapstructure:"tls-cert"`
+	TLSKey       string            `mapstructure:"tls-key"`
+	TLSCA        string            `mapstructure:"tls-ca"`
+	SASL         string            `mapstructure:"sasl"`
+	User         string            `mapstructure:"user"`
+	PasswordFile string            `mapstructure:"password-file"` // or TRACKER_KAFKA_PASSWORD
+	Route        OutputRouteConfig `mapstructure:"route"`
+}
+
+// OutputRouteConfig selects the events sent to an output
+type OutputRouteConfig struct {
+	Policies     []string `mapstructure:"policies"`
+	Events       []string `mapstructure:"events"`
+	FindingsOnly bool     `mapstructure:"findings-only"`
+	MinSeverity  int      `mapstructure:"min-severity"`
+}
+
+// appendRouteParams appends the routing selectors of an output to its url
+func appendRouteParams(url string, route OutputRouteConfig) string {
+	var params []string
+	for _, policy := range route.Policies {
+		params = append(params, "policy="+neturl.QueryEscape(policy))
+	}
+	for _, event := range route.Events {
+		params = append(params, "event="+neturl.QueryEscape(event))
+	}
+	if route.FindingsOnly {
+		params = append(params, "findingsOnly=true")
+	}
+	if route.MinSeverity != 0 {
+		params = append(params, fmt.Sprintf("minSeverity=%d", route.MinSeverity))
+	}
+
+	for _, param := range params {
+		delim := "?"
+		if strings.Contains(url, "?") {
+			delim = "&"
+		}
+		url += delim + param
+	}
+
+	return url
 }
@@ -16,18 +16,25 @@ func createPoliciesFromK8SPolicy(policies []k8s.PolicyInterface) (*policy.Polici
 	return flags.CreatePolicies(policyScopeMap, policyEventsMap, true)
 }
 
-func createPoliciesFromPolicyFiles(policyFlags []string) (*policy.Policies, error) {
+// createPoliciesFromPolicyFiles returns the policies created from the given policy
+// files, along with the policy documents read from them.
+func createPoliciesFromPolicyFiles(policyFlags []string) ([]k8s.PolicyInterface, *policy.Policies, error) {
 	policyFiles, err := v1beta1.PoliciesFromPaths(policyFlags)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	policyScopeMap, policyEventsMap, err := flags.PrepareFilterMapsFromPolicies(policyFiles)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return flags.CreatePolicies(policyScopeMap, policyEventsMap, true)
+	policies, err := flags.CreatePolicies(policyScopeMap, policyEventsMap, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return policyFiles, policies, nil
 }
 
 func createPoliciesFromCLIFlags(scopeFlags, eventFlags []string) (*policy.Policies, error) {
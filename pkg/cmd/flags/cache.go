@@ -8,14 +8,19 @@ import (
 	"github.com/khulnasoft-lab/tracker/pkg/events/queue"
 )
 
+const DefaultDiskCacheDir = "/var/lib/tracker"
+
 func cacheHelp() string {
 	return `Select different cache types for the event pipeline queueing.
 Possible options:
-cache-type={none,mem}                              pick the appropriate cache type.
+cache-type={none,mem,disk}                         pick the appropriate cache type.
 mem-cache-size=256                                 set memory cache size in MB. only works for cache-type=mem.
+disk-cache-dir=/var/lib/tracker                    set disk cache directory. only works for cache-type=disk.
+disk-cache-size=1024                               set disk cache size in MB. only works for cache-type=disk.
 Example:
   --cache cache-type=mem                                   | will cache events in memory using default values.
   --cache cache-type=mem --cache mem-cache-size=1024       | will cache events in memory. will set memory cache size to 1024 MB.
+  --cache cache-type=disk --cache disk-cache-size=4096     | will cache events on disk, surviving restarts. will set disk cache size to 4096 MB.
   --cache none                                             | no event caching in the pipeline (default).
 Use this flag multiple times to choose multiple output options
 `
@@ -25,12 +30,15 @@ func PrepareCache(cacheSlice []string) (queue.CacheConfig, error) {
 	var cache queue.CacheConfig
 	var err error
 	cacheTypeMem := false
+	cacheTypeDisk := false
 
 	if strings.Contains(cacheSlice[0], "none") {
 		return nil, nil
 	}
 
 	eventsCacheMemSizeMb := 0
+	eventsCacheDiskSizeMb := 0
+	eventsCacheDiskDir := DefaultDiskCacheDir
 	for _, o := range cacheSlice {
 		cacheParts := strings.SplitN(o, "=", 2)
 		if len(cacheParts) != 2 {
@@ -43,9 +51,17 @@ func PrepareCache(cacheSlice []string) (queue.CacheConfig, error) {
 		case "cache-type":
 			switch value {
 			case "mem":
+				if cacheTypeDisk {
+					return nil, errfmt.Errorf("cache-type=mem conflicts with cache-type=disk, choose one of them")
+				}
 				cacheTypeMem = true
+			case "disk":
+				if cacheTypeMem {
+					return nil, errfmt.Errorf("cache-type=disk conflicts with cache-type=mem, choose one of them")
+				}
+				cacheTypeDisk = true
 			default:
-				return nil, errfmt.Errorf("unrecognized cache-mem option: %s (valid options are: none,mem)", o)
+				return nil, errfmt.Errorf("unrecognized cache-mem option: %s (valid options are: none,mem,disk)", o)
 			}
 		case "mem-cache-size":
 			if !cacheTypeMem {
@@ -55,6 +71,22 @@ func PrepareCache(cacheSlice []string) (queue.CacheConfig, error) {
 			if err != nil {
 				return nil, errfmt.Errorf("could not parse mem-cache-size value: %v", err)
 			}
+		case "disk-cache-size":
+			if !cacheTypeDisk {
+				return nil, errfmt.Errorf("you need to specify cache-type=disk before setting disk-cache-size")
+			}
+			eventsCacheDiskSizeMb, err = strconv.Atoi(value)
+			if err != nil || eventsCacheDiskSizeMb <= 0 {
+				return nil, errfmt.Errorf("could not parse disk-cache-size value: %s", value)
+			}
+		case "disk-cache-dir":
+			if !cacheTypeDisk {
+				return nil, errfmt.Errorf("you need to specify cache-type=disk before setting disk-cache-dir")
+			}
+			if value == "" {
+				return nil, errfmt.Errorf("disk-cache-dir cannot be empty")
+			}
+			eventsCacheDiskDir = value
 
 		default:
 			return nil, errfmt.Errorf("unrecognized cache option format: %s", o)
@@ -63,6 +95,13 @@ func PrepareCache(cacheSlice []string) (queue.CacheConfig, error) {
 	if cacheTypeMem {
 		return queue.NewEventQueueMem(eventsCacheMemSizeMb), nil
 	}
+	if cacheTypeDisk {
+		cache, err = queue.NewEventQueueDisk(eventsCacheDiskDir, eventsCacheDiskSizeMb)
+		if err != nil {
+			return nil, errfmt.Errorf("could not create disk cache: %v", err)
+		}
+		return cache, nil
+	}
 
 	return nil, nil
 }
@@ -2,9 +2,11 @@ package flags
 
 import (
 	"errors"
+	"io"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/khulnasoft-lab/tracker/pkg/events/queue"
 )
@@ -28,7 +30,7 @@ func TestPrepareCache(t *testing.T) {
 			testName:      "invalid cache-type",
 			cacheSlice:    []string{"cache-type=bleh"},
 			expectedCache: nil,
-			expectedError: errors.New("unrecognized cache-mem option: cache-type=bleh (valid options are: none,mem)"),
+			expectedError: errors.New("unrecognized cache-mem option: cache-type=bleh (valid options are: none,mem,disk)"),
 		},
 		{
 			testName:      "cache-type=none",
@@ -51,6 +53,36 @@ func TestPrepareCache(t *testing.T) {
 			cacheSlice:    []string{"cache-type=mem", "mem-cache-size=512"},
 			expectedCache: queue.NewEventQueueMem(512),
 		},
+		{
+			testName:      "disk-cache-size=X without cache-type=disk",
+			cacheSlice:    []string{"cache-type=mem", "disk-cache-size=256"},
+			expectedCache: nil,
+			expectedError: errors.New("you need to specify cache-type=disk before setting disk-cache-size"),
+		},
+		{
+			testName:      "disk-cache-dir=X without cache-type=disk",
+			cacheSlice:    []string{"disk-cache-dir=/var/lib/tracker"},
+			expectedCache: nil,
+			expectedError: errors.New("you need to specify cache-type=disk before setting disk-cache-dir"),
+		},
+		{
+			testName:      "cache-type=mem and cache-type=disk",
+			cacheSlice:    []string{"cache-type=mem", "cache-type=disk"},
+			expectedCache: nil,
+			expectedError: errors.New("cache-type=disk conflicts with cache-type=mem, choose one of them"),
+		},
+		{
+			testName:      "cache-type=disk and cache-type=mem",
+			cacheSlice:    []string{"cache-type=disk", "cache-type=mem"},
+			expectedCache: nil,
+			expectedError: errors.New("cache-type=mem conflicts with cache-type=disk, choose one of them"),
+		},
+		{
+			testName:      "cache-type=disk with invalid disk-cache-size",
+			cacheSlice:    []string{"cache-type=disk", "disk-cache-size=0"},
+			expectedCache: nil,
+			expectedError: errors.New("could not parse disk-cache-size value: 0"),
+		},
 	}
 
 	for _, testcase := range testCases {
@@ -67,3 +99,14 @@ func TestPrepareCache(t *testing.T) {
 		})
 	}
 }
+
+func TestPrepareCacheDisk(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	cache, err := PrepareCache([]string{"cache-type=disk", "disk-cache-dir=" + dir, "disk-cache-size=16"})
+	require.NoError(t, err)
+	assert.Equal(t, "On-Disk Event Queue (Dir = "+dir+", Size = 16 MB)", cache.String())
+	assert.NoError(t, cache.(io.Closer).Close())
+}
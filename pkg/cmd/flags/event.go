@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/khulnasoft-lab/tracker/pkg/errfmt"
+	"github.com/khulnasoft-lab/tracker/pkg/policy/actions"
 )
 
 // PolicyEventMap maps policy id to its pre-parsed event flag fields
@@ -11,8 +12,9 @@ type PolicyEventMap map[int]policyEvents
 
 // policyEvents holds pre-parsed event flag fields of one policy
 type policyEvents struct {
-	policyName string
-	eventFlags []eventFlag
+	policyName  string
+	eventFlags  []eventFlag
+	ruleActions map[string][]actions.Action // rule event name to its actions (other than output)
 }
 
 // eventFlag holds pre-parsed event flag fields
@@ -3,13 +3,19 @@ package flags
 import (
 	"errors"
 	"fmt"
+	"math"
+	"net"
 	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/khulnasoft-lab/tracker/pkg/config"
 	"github.com/khulnasoft-lab/tracker/pkg/errfmt"
+	"github.com/khulnasoft-lab/tracker/pkg/events"
+	"github.com/khulnasoft-lab/tracker/pkg/policy"
 )
 
 type PrepareOutputResult struct {
@@ -45,7 +51,7 @@ func PrepareOutput(outputSlice []string, newBinary bool) (PrepareOutputResult, e
 				return outConfig, errors.New("none output does not support path. Use '--output help' for more info")
 			}
 			printerMap["stdout"] = "ignore"
-		case "table", "table-verbose", "json":
+		case "table", "table-verbose", "json", "cef", "protobuf":
 			err := parseFormat(outputParts, printerMap, newBinary)
 			if err != nil {
 				return outConfig, err
@@ -64,6 +70,27 @@ func PrepareOutput(outputSlice []string, newBinary bool) (PrepareOutputResult, e
 			}
 
 			printerMap[outputParts[1]] = "webhook"
+		case "syslog":
+			err := validateSyslog(outputParts, newBinary)
+			if err != nil {
+				return outConfig, err
+			}
+
+			printerMap[outputParts[1]] = "syslog"
+		case "otlp", "otlp-http":
+			err := validateOTLP(outputParts, newBinary)
+			if err != nil {
+				return outConfig, err
+			}
+
+			printerMap[outputParts[1]] = outputParts[0]
+		case "kafka":
+			err := validateKafka(outputParts, newBinary)
+			if err != nil {
+				return outConfig, err
+			}
+
+			printerMap[outputParts[1]] = "kafka"
 		case "option":
 			err := parseOption(outputParts, trackerConfig, newBinary)
 			if err != nil {
@@ -110,7 +137,21 @@ func setOption(cfg *config.OutputConfig, option string, newBinary bool) error {
 		cfg.ParseArguments = true // no point in parsing file descriptor args only
 	case "sort-events":
 		cfg.EventsSorting = true
+	case "node-labels":
+		cfg.NodeLabels = true
 	default:
+		if strings.HasPrefix(option, "label=") {
+			key, value, ok := strings.Cut(strings.TrimPrefix(option, "label="), "=")
+			if !ok || key == "" || strings.ContainsAny(key, " \t") {
+				goto invalidOption
+			}
+			if cfg.Labels == nil {
+				cfg.Labels = make(map[string]string)
+			}
+			cfg.Labels[key] = value
+
+			return nil
+		}
 		if strings.HasPrefix(option, "exec-hash") {
 			hashExecParts := strings.Split(option, "=")
 			if len(hashExecParts) == 1 {
@@ -164,11 +205,42 @@ func getPrinterConfigs(printerMap map[string]string, trackerConfig *config.Outpu
 			}
 		}
 
+		outPath, route, err := parseRoute(outPath, newBinary)
+		if err != nil {
+			return nil, err
+		}
+
 		outFile := os.Stdout
-		var err error
+		var rotation config.FileRotation
+
+		var projection config.JSONProjection
+
+		if !isNetworkPrinter(printerKind) {
+			var query string
+			outPath, query, _ = strings.Cut(outPath, "?")
+			projection, query, err = parseJSONProjection(printerKind, query, newBinary)
+			if err != nil {
+				return nil, err
+			}
+			rotation, err = parseFileRotation(query, newBinary)
+			if err != nil {
+				return nil, err
+			}
 
-		if outPath != "stdout" && printerKind != "forward" && printerKind != "webhook" {
-			outFile, err = createFile(outPath)
+			switch {
+			case outPath == "stdout" && query != "":
+				if newBinary {
+					return nil, errfmt.Errorf("stdout output does not support file options, run 'man output' for more info")
+				}
+
+				return nil, errfmt.Errorf("stdout output does not support file options, use '--output help' for more info")
+			case outPath == "stdout":
+			case rotation.Enabled():
+				// keep the events of a previous run, the file is rotated when it's full
+				outFile, err = appendFile(outPath)
+			default:
+				outFile, err = createFile(outPath)
+			}
 			if err != nil {
 				return nil, err
 			}
@@ -179,12 +251,158 @@ func getPrinterConfigs(printerMap map[string]string, trackerConfig *config.Outpu
 			OutPath:    outPath,
 			OutFile:    outFile,
 			RelativeTS: trackerConfig.RelativeTime,
+			Rotation:   rotation,
+			Projection: projection,
+			Route:      route,
 		})
 	}
 
 	return printerConfigs, nil
 }
 
+// parseRoute parses the routing selectors of an output, given as parameters of its path
+// or url, and returns the path without them
+// --output [format]:[path|url]?[policy=p1&policy=p2][&event=e1&event=e2][&findingsOnly=true][&minSeverity=n]
+func parseRoute(outPath string, newBinary bool) (string, config.PrinterRoute, error) {
+	var route config.PrinterRoute
+
+	path, query, ok := strings.Cut(outPath, "?")
+	if !ok {
+		return outPath, route, nil
+	}
+
+	invalidSelector := func(param string) error {
+		if newBinary {
+			return errfmt.Errorf("invalid output routing selector %s, run 'man output' for more info", param)
+		}
+
+		return errfmt.Errorf("invalid output routing selector %s, use '--output help' for more info", param)
+	}
+
+	eventsNameToID := events.Core.NamesToIDs()
+
+	// the other parameters belong to the output, keep them as given
+	var params []string
+	for _, param := range strings.Split(query, "&") {
+		key, value, _ := strings.Cut(param, "=")
+		value, err := url.QueryUnescape(value)
+		if err != nil {
+			return outPath, route, invalidSelector(param)
+		}
+
+		switch key {
+		case "policy":
+			if value == "" {
+				return outPath, route, invalidSelector(param)
+			}
+			route.Policies = append(route.Policies, value)
+		case "event":
+			if _, ok := eventsNameToID[value]; !ok {
+				return outPath, route, invalidSelector(param)
+			}
+			route.Events = append(route.Events, value)
+		case "findingsOnly":
+			route.FindingsOnly, err = strconv.ParseBool(value)
+			if err != nil {
+				return outPath, route, invalidSelector(param)
+			}
+		case "minSeverity":
+			route.MinSeverity, err = strconv.Atoi(value)
+			if err != nil || route.MinSeverity < 0 || route.MinSeverity > 4 {
+				return outPath, route, invalidSelector(param)
+			}
+		default:
+			params = append(params, param)
+		}
+	}
+
+	if len(params) > 0 {
+		path += "?" + strings.Join(params, "&")
+	}
+
+	return path, route, nil
+}
+
+// ValidateRoutePolicies returns an error if an output routes the events of a policy that
+// is not one of the given policies
+func ValidateRoutePolicies(printerConfigs []config.PrinterConfig, policies *policy.Policies) error {
+	for _, printerConfig := range printerConfigs {
+		for _, name := range printerConfig.Route.Policies {
+			if _, err := policies.LookupByName(name); err != nil {
+				return errfmt.Errorf("output %s routes the events of unknown policy %s", printerConfig.OutPath, name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseJSONProjection parses the fields and omit-empty options of a json output, and
+// returns the other options
+// --output json:[path]?[fields=f1,f2.f3][&omit-empty]
+func parseJSONProjection(printerKind, query string, newBinary bool) (config.JSONProjection, string, error) {
+	var projection config.JSONProjection
+	if query == "" {
+		return projection, query, nil
+	}
+
+	invalidOption := func(option string) error {
+		if newBinary {
+			return errfmt.Errorf("invalid json output option %s, run 'man output' for more info", option)
+		}
+
+		return errfmt.Errorf("invalid json output option %s, use '--output help' for more info", option)
+	}
+
+	var options []string
+	for _, option := range strings.Split(query, "&") {
+		key, value, _ := strings.Cut(option, "=")
+		if key != "fields" && key != "omit-empty" {
+			options = append(options, option)
+			continue
+		}
+		if printerKind != "json" {
+			return projection, query, invalidOption(option)
+		}
+
+		value, err := url.QueryUnescape(value)
+		if err != nil {
+			return projection, query, invalidOption(option)
+		}
+
+		switch key {
+		case "fields":
+			for _, field := range strings.Split(value, ",") {
+				if field == "" {
+					return projection, query, invalidOption(option)
+				}
+				projection.Fields = append(projection.Fields, field)
+			}
+		case "omit-empty":
+			projection.OmitEmpty = true
+			if value != "" {
+				projection.OmitEmpty, err = strconv.ParseBool(value)
+				if err != nil {
+					return projection, query, invalidOption(option)
+				}
+			}
+		}
+	}
+
+	return projection, strings.Join(options, "&"), nil
+}
+
+// isNetworkPrinter returns true if the printer sends events to a network destination
+// instead of a file
+func isNetworkPrinter(printerKind string) bool {
+	switch printerKind {
+	case "forward", "webhook", "syslog", "otlp", "otlp-http", "kafka":
+		return true
+	}
+
+	return false
+}
+
 // parseFormat parses the given format and sets it in the given printerMap
 func parseFormat(outputParts []string, printerMap map[string]string, newBinary bool) error {
 	// if not file was passed, we use stdout
@@ -192,7 +410,7 @@ func parseFormat(outputParts []string, printerMap map[string]string, newBinary b
 		outputParts = append(outputParts, "stdout")
 	}
 
-	for _, outPath := range strings.Split(outputParts[1], ",") {
+	for _, outPath := range splitOutputPaths(outputParts[1]) {
 		if outPath == "" {
 			if newBinary {
 				return errfmt.Errorf("format flag can't be empty, run 'man output' for more info")
@@ -201,7 +419,7 @@ func parseFormat(outputParts []string, printerMap map[string]string, newBinary b
 			return errfmt.Errorf("format flag can't be empty, use '--output help' for more info")
 		}
 
-		if _, ok := printerMap[outPath]; ok {
+		if outputPathInUse(printerMap, outPath) {
 			if newBinary {
 				return errfmt.Errorf("cannot use the same path for multiple outputs: %s, run  'man output' for more info", outPath)
 			}
@@ -214,6 +432,42 @@ func parseFormat(outputParts []string, printerMap map[string]string, newBinary b
 	return nil
 }
 
+// splitOutputPaths splits a comma separated list of output paths. The commas of a fields
+// option, the last option of a path, separate the fields:
+// /tmp/a.json?fields=eventName,args,/tmp/b.json -> /tmp/a.json?fields=eventName,args /tmp/b.json
+// A path following a fields option must contain a '/' or be stdout.
+func splitOutputPaths(paths string) []string {
+	var outPaths []string
+	for _, part := range strings.Split(paths, ",") {
+		if len(outPaths) > 0 && part != "" && part != "stdout" && !strings.ContainsAny(part, "/?") {
+			last := outPaths[len(outPaths)-1]
+			if _, query, ok := strings.Cut(last, "?"); ok {
+				options := strings.Split(query, "&")
+				if strings.HasPrefix(options[len(options)-1], "fields=") {
+					outPaths[len(outPaths)-1] = last + "," + part
+					continue
+				}
+			}
+		}
+		outPaths = append(outPaths, part)
+	}
+
+	return outPaths
+}
+
+// outputPathInUse returns true if the given path, ignoring its options, is already used by
+// an output of the given printerMap
+func outputPathInUse(printerMap map[string]string, outPath string) bool {
+	path, _, _ := strings.Cut(outPath, "?")
+	for p := range printerMap {
+		if p, _, _ = strings.Cut(p, "?"); p == path {
+			return true
+		}
+	}
+
+	return false
+}
+
 // parseOption parses the given option and sets it in the given config
 func parseOption(outputParts []string, trackerConfig *config.OutputConfig, newBinary bool) error {
 	if len(outputParts) == 1 || outputParts[1] == "" {
@@ -236,6 +490,15 @@ func parseOption(outputParts []string, trackerConfig *config.OutputConfig, newBi
 
 // creates *os.File for the given path
 func createFile(path string) (*os.File, error) {
+	return openFile(path, os.O_RDWR|os.O_CREATE|os.O_TRUNC)
+}
+
+// opens *os.File for the given path, appending to it if it exists
+func appendFile(path string) (*os.File, error) {
+	return openFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND)
+}
+
+func openFile(path string, flag int) (*os.File, error) {
 	fileInfo, err := os.Stat(path)
 	if err == nil && fileInfo.IsDir() {
 		return nil, errfmt.Errorf("cannot use a path of existing directory %s", path)
@@ -245,7 +508,7 @@ func createFile(path string) (*os.File, error) {
 	if err := os.MkdirAll(dir, 0755); err != nil {
 		return nil, errfmt.Errorf("failed to create directory: %v", err)
 	}
-	file, err := os.Create(path)
+	file, err := os.OpenFile(path, flag, 0666)
 	if err != nil {
 		return nil, errfmt.Errorf("failed to create output path: %v", err)
 	}
@@ -253,6 +516,110 @@ func createFile(path string) (*os.File, error) {
 	return file, nil
 }
 
+// parseFileRotation parses the options of a file output
+// --output [format]:/path/to/file?max-size=100M&max-age=168h&max-backups=5&compress=gzip
+func parseFileRotation(query string, newBinary bool) (config.FileRotation, error) {
+	var rotation config.FileRotation
+	if query == "" {
+		return rotation, nil
+	}
+
+	invalidOption := func(option, value string) error {
+		if newBinary {
+			return errfmt.Errorf("invalid file output option %s=%s, run 'man output' for more info", option, value)
+		}
+
+		return errfmt.Errorf("invalid file output option %s=%s, use '--output help' for more info", option, value)
+	}
+
+	options, err := url.ParseQuery(query)
+	if err != nil {
+		return rotation, invalidOption("options", query)
+	}
+
+	for option, values := range options {
+		value := values[len(values)-1]
+		switch option {
+		case "max-size":
+			size, err := parseFileSize(value)
+			if err != nil || size <= 0 {
+				return rotation, invalidOption(option, value)
+			}
+			rotation.MaxSize = size
+		case "max-age":
+			age, err := parseFileAge(value)
+			if err != nil || age <= 0 {
+				return rotation, invalidOption(option, value)
+			}
+			rotation.MaxAge = age
+		case "max-backups":
+			backups, err := strconv.Atoi(value)
+			if err != nil || backups <= 0 {
+				return rotation, invalidOption(option, value)
+			}
+			rotation.MaxBackups = backups
+		case "compress":
+			switch value {
+			case "none", "gzip", "zstd":
+				rotation.Compress = value
+			default:
+				return rotation, invalidOption(option, value)
+			}
+		default:
+			return rotation, invalidOption(option, value)
+		}
+	}
+
+	if !rotation.Enabled() {
+		if newBinary {
+			return rotation, errfmt.Errorf("file output options require max-size, run 'man output' for more info")
+		}
+
+		return rotation, errfmt.Errorf("file output options require max-size, use '--output help' for more info")
+	}
+
+	return rotation, nil
+}
+
+// parseFileSize parses a size in bytes with an optional K, M or G (KiB, MiB or GiB) unit
+func parseFileSize(value string) (int64, error) {
+	multiplier := int64(1)
+	switch {
+	case strings.HasSuffix(value, "K"):
+		multiplier = 1 << 10
+	case strings.HasSuffix(value, "M"):
+		multiplier = 1 << 20
+	case strings.HasSuffix(value, "G"):
+		multiplier = 1 << 30
+	}
+	if multiplier > 1 {
+		value = value[:len(value)-1]
+	}
+
+	size, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if size > math.MaxInt64/multiplier {
+		return 0, errors.New("size out of range")
+	}
+
+	return size * multiplier, nil
+}
+
+// parseFileAge parses a duration, also accepting days (e.g. 7d)
+func parseFileAge(value string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+
+	return time.ParseDuration(value)
+}
+
 // validateURL validates the given URL
 // --output [webhook|forward]:[protocol://user:pass@]host:port[?k=v#f]
 func validateURL(outputParts []string, flag string, newBinary bool) error {
@@ -276,3 +643,81 @@ func validateURL(outputParts []string, flag string, newBinary bool) error {
 
 	return nil
 }
+
+// validateSyslog validates the given syslog URL
+// --output syslog:{udp,tcp,tcp+tls}://host[:port][?k=v]
+func validateSyslog(outputParts []string, newBinary bool) error {
+	if err := validateURL(outputParts, "syslog", newBinary); err != nil {
+		return err
+	}
+
+	u, _ := url.Parse(outputParts[1])
+	switch u.Scheme {
+	case "udp", "tcp", "tcp+tls":
+		return nil
+	}
+
+	if newBinary {
+		return errfmt.Errorf("invalid protocol for syslog output %q, use one of udp, tcp or tcp+tls. Run 'man output' for more info", u.Scheme)
+	}
+
+	return errfmt.Errorf("invalid protocol for syslog output %q, use one of udp, tcp or tcp+tls. Use '--output help' for more info", u.Scheme)
+}
+
+// validateOTLP validates the given OTLP receiver URL
+// --output otlp:{grpc,grpcs}://host[:port][?k=v]
+// --output otlp-http:{http,https}://host[:port][/path][?k=v]
+func validateOTLP(outputParts []string, newBinary bool) error {
+	flag := outputParts[0]
+	if err := validateURL(outputParts, flag, newBinary); err != nil {
+		return err
+	}
+
+	u, _ := url.Parse(outputParts[1])
+	schemes := "grpc or grpcs"
+	switch {
+	case flag == "otlp" && (u.Scheme == "grpc" || u.Scheme == "grpcs"):
+		return nil
+	case flag == "otlp-http" && (u.Scheme == "http" || u.Scheme == "https"):
+		return nil
+	case flag == "otlp-http":
+		schemes = "http or https"
+	}
+
+	if newBinary {
+		return errfmt.Errorf("invalid protocol for %s output %q, use %s. Run 'man output' for more info", flag, u.Scheme, schemes)
+	}
+
+	return errfmt.Errorf("invalid protocol for %s output %q, use %s. Use '--output help' for more info", flag, u.Scheme, schemes)
+}
+
+// validateKafka validates the given kafka brokers and parameters
+// --output kafka:host:port[,host:port...]?topic=topic[&k=v]
+func validateKafka(outputParts []string, newBinary bool) error {
+	if len(outputParts) == 1 || outputParts[1] == "" {
+		if newBinary {
+			return errfmt.Errorf("kafka flag can't be empty, run 'man output' for more info")
+		}
+
+		return errfmt.Errorf("kafka flag can't be empty, use '--output help' for more info")
+	}
+
+	brokers, query, _ := strings.Cut(outputParts[1], "?")
+	parameters, err := url.ParseQuery(query)
+	valid := err == nil && parameters.Get("topic") != ""
+	for _, broker := range strings.Split(brokers, ",") {
+		if _, _, err := net.SplitHostPort(broker); err != nil {
+			valid = false
+		}
+	}
+
+	if !valid {
+		if newBinary {
+			return errfmt.Errorf("invalid kafka output %q, brokers and topic are required. Run 'man output' for more info", outputParts[1])
+		}
+
+		return errfmt.Errorf("invalid kafka output %q, brokers and topic are required. Use '--output help' for more info", outputParts[1])
+	}
+
+	return nil
+}
@@ -3,10 +3,13 @@ package flags
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/khulnasoft-lab/tracker/pkg/config"
+	"github.com/khulnasoft-lab/tracker/pkg/policy"
 )
 
 func TestPrepareOutput(t *testing.T) {
@@ -115,6 +118,182 @@ func TestPrepareOutput(t *testing.T) {
 				TrackerConfig: &config.OutputConfig{},
 			},
 		},
+		{
+			testName:    "cef to /tmp/cef",
+			outputSlice: []string{"cef:/tmp/cef"},
+			expectedOutput: PrepareOutputResult{
+				PrinterConfigs: []config.PrinterConfig{
+					{Kind: "cef", OutPath: "/tmp/cef"},
+				},
+				TrackerConfig: &config.OutputConfig{},
+			},
+		},
+		{
+			testName:    "protobuf to /tmp/protobuf",
+			outputSlice: []string{"protobuf:/tmp/protobuf"},
+			expectedOutput: PrepareOutputResult{
+				PrinterConfigs: []config.PrinterConfig{
+					{Kind: "protobuf", OutPath: "/tmp/protobuf"},
+				},
+				TrackerConfig: &config.OutputConfig{},
+			},
+		},
+		{
+			testName:    "json to /tmp/json-rotated with rotation",
+			outputSlice: []string{"json:/tmp/json-rotated?max-size=10M&max-age=7d&max-backups=5&compress=zstd"},
+			expectedOutput: PrepareOutputResult{
+				PrinterConfigs: []config.PrinterConfig{
+					{
+						Kind:    "json",
+						OutPath: "/tmp/json-rotated",
+						Rotation: config.FileRotation{
+							MaxSize:    10 << 20,
+							MaxAge:     7 * 24 * time.Hour,
+							MaxBackups: 5,
+							Compress:   "zstd",
+						},
+					},
+				},
+				TrackerConfig: &config.OutputConfig{},
+			},
+		},
+		{
+			testName:    "table to /tmp/table-rotated with max-size only",
+			outputSlice: []string{"table:/tmp/table-rotated?max-size=512"},
+			expectedOutput: PrepareOutputResult{
+				PrinterConfigs: []config.PrinterConfig{
+					{Kind: "table", OutPath: "/tmp/table-rotated", Rotation: config.FileRotation{MaxSize: 512}},
+				},
+				TrackerConfig: &config.OutputConfig{ParseArguments: true},
+			},
+		},
+		{
+			testName:      "file options without max-size",
+			outputSlice:   []string{"json:/tmp/json-rotated?max-backups=5"},
+			expectedError: errors.New("parseFileRotation: file output options require max-size, use '--output help' for more info"),
+		},
+		{
+			testName:      "invalid file option max-size",
+			outputSlice:   []string{"json:/tmp/json-rotated?max-size=10X"},
+			expectedError: errors.New("invalid file output option max-size=10X, use '--output help' for more info"),
+		},
+		{
+			testName:      "invalid file option compress",
+			outputSlice:   []string{"json:/tmp/json-rotated?max-size=10M&compress=lz4"},
+			expectedError: errors.New("invalid file output option compress=lz4, use '--output help' for more info"),
+		},
+		{
+			testName:      "unknown file option",
+			outputSlice:   []string{"json:/tmp/json-rotated?max-size=10M&foo=bar"},
+			expectedError: errors.New("invalid file output option foo=bar, use '--output help' for more info"),
+		},
+		{
+			testName:      "file options on stdout",
+			outputSlice:   []string{"json:stdout?max-size=10M"},
+			expectedError: errors.New("stdout output does not support file options, use '--output help' for more info"),
+		},
+		{
+			testName:    "json fields and omit-empty",
+			outputSlice: []string{"json:stdout?fields=timestamp,eventName,args.pathname,container.id&omit-empty"},
+			expectedOutput: PrepareOutputResult{
+				PrinterConfigs: []config.PrinterConfig{
+					{
+						Kind:    "json",
+						OutPath: "stdout",
+						Projection: config.JSONProjection{
+							Fields:    []string{"timestamp", "eventName", "args.pathname", "container.id"},
+							OmitEmpty: true,
+						},
+					},
+				},
+				TrackerConfig: &config.OutputConfig{},
+			},
+		},
+		{
+			testName:    "json fields with rotation followed by another file",
+			outputSlice: []string{"json:/tmp/json-fields?max-size=1M&omit-empty=false&fields=eventName,processName,/tmp/json-all"},
+			expectedOutput: PrepareOutputResult{
+				PrinterConfigs: []config.PrinterConfig{
+					{
+						Kind:       "json",
+						OutPath:    "/tmp/json-fields",
+						Rotation:   config.FileRotation{MaxSize: 1 << 20},
+						Projection: config.JSONProjection{Fields: []string{"eventName", "processName"}},
+					},
+					{Kind: "json", OutPath: "/tmp/json-all"},
+				},
+				TrackerConfig: &config.OutputConfig{},
+			},
+		},
+		{
+			testName:      "json fields on another format",
+			outputSlice:   []string{"table:stdout?fields=eventName"},
+			expectedError: errors.New("invalid json output option fields=eventName, use '--output help' for more info"),
+		},
+		{
+			testName:      "empty json field",
+			outputSlice:   []string{"json:stdout?fields="},
+			expectedError: errors.New("invalid json output option fields=, use '--output help' for more info"),
+		},
+		{
+			testName:      "invalid json omit-empty",
+			outputSlice:   []string{"json:stdout?omit-empty=maybe"},
+			expectedError: errors.New("invalid json output option omit-empty=maybe, use '--output help' for more info"),
+		},
+		{
+			testName:      "same path with different file options",
+			outputSlice:   []string{"json:/tmp/json-rotated?max-size=10M", "table:/tmp/json-rotated"},
+			expectedError: errors.New("cannot use the same path for multiple outputs: /tmp/json-rotated, use '--output help' for more info"),
+		},
+		{
+			testName: "routing selectors",
+			outputSlice: []string{
+				"json:/tmp/json-routed?policy=p1&policy=p2&event=openat&max-size=1M",
+				"json:stdout?findingsOnly=true",
+				"webhook:http://localhost:8080?timeout=5s&minSeverity=3&contentType=application/json",
+			},
+			expectedOutput: PrepareOutputResult{
+				PrinterConfigs: []config.PrinterConfig{
+					{
+						Kind:     "json",
+						OutPath:  "/tmp/json-routed",
+						Rotation: config.FileRotation{MaxSize: 1 << 20},
+						Route:    config.PrinterRoute{Policies: []string{"p1", "p2"}, Events: []string{"openat"}},
+					},
+					{
+						Kind:    "json",
+						OutPath: "stdout",
+						Route:   config.PrinterRoute{FindingsOnly: true},
+					},
+					{
+						Kind:    "webhook",
+						OutPath: "http://localhost:8080?timeout=5s&contentType=application/json",
+						Route:   config.PrinterRoute{MinSeverity: 3},
+					},
+				},
+				TrackerConfig: &config.OutputConfig{},
+			},
+		},
+		{
+			testName:      "invalid routing selector minSeverity on file",
+			outputSlice:   []string{"json:/tmp/json-routed?minSeverity=5"},
+			expectedError: errors.New("invalid output routing selector minSeverity=5, use '--output help' for more info"),
+		},
+		{
+			testName:      "invalid routing selector findingsOnly",
+			outputSlice:   []string{"json:stdout?findingsOnly=maybe"},
+			expectedError: errors.New("invalid output routing selector findingsOnly=maybe, use '--output help' for more info"),
+		},
+		{
+			testName:      "empty routing selector events",
+			outputSlice:   []string{"json:stdout?event="},
+			expectedError: errors.New("invalid output routing selector event=, use '--output help' for more info"),
+		},
+		{
+			testName:      "unknown routing selector events",
+			outputSlice:   []string{"json:stdout?event=not_an_event"},
+			expectedError: errors.New("invalid output routing selector event=not_an_event, use '--output help' for more info"),
+		},
 		{
 			testName:    "table-verbose to stdout",
 			outputSlice: []string{"table-verbose"},
@@ -252,6 +431,80 @@ func TestPrepareOutput(t *testing.T) {
 				TrackerConfig: &config.OutputConfig{},
 			},
 		},
+		// syslog
+		{
+			testName:      "empty syslog flag",
+			outputSlice:   []string{"syslog:"},
+			expectedError: errors.New("validateURL: syslog flag can't be empty, use '--output help' for more info"),
+		},
+		{
+			testName:      "invalid syslog protocol",
+			outputSlice:   []string{"syslog:http://localhost:514"},
+			expectedError: errors.New("validateSyslog: invalid protocol for syslog output \"http\", use one of udp, tcp or tcp+tls. Use '--output help' for more info"),
+		},
+		{
+			testName:    "syslog",
+			outputSlice: []string{"syslog:tcp+tls://localhost:6514?format=cef"},
+			expectedOutput: PrepareOutputResult{
+				PrinterConfigs: []config.PrinterConfig{
+					{Kind: "syslog", OutPath: "tcp+tls://localhost:6514?format=cef"},
+				},
+				TrackerConfig: &config.OutputConfig{},
+			},
+		},
+		// otlp
+		{
+			testName:      "empty otlp flag",
+			outputSlice:   []string{"otlp"},
+			expectedError: errors.New("validateURL: otlp flag can't be empty, use '--output help' for more info"),
+		},
+		{
+			testName:      "invalid otlp protocol",
+			outputSlice:   []string{"otlp:http://collector:4317"},
+			expectedError: errors.New("validateOTLP: invalid protocol for otlp output \"http\", use grpc or grpcs. Use '--output help' for more info"),
+		},
+		{
+			testName:      "invalid otlp-http protocol",
+			outputSlice:   []string{"otlp-http:grpc://collector:4318"},
+			expectedError: errors.New("validateOTLP: invalid protocol for otlp-http output \"grpc\", use http or https. Use '--output help' for more info"),
+		},
+		{
+			testName:    "otlp and otlp-http",
+			outputSlice: []string{"otlp:grpc://collector:4317", "otlp-http:https://collector:4318/v1/logs"},
+			expectedOutput: PrepareOutputResult{
+				PrinterConfigs: []config.PrinterConfig{
+					{Kind: "otlp", OutPath: "grpc://collector:4317"},
+					{Kind: "otlp-http", OutPath: "https://collector:4318/v1/logs"},
+				},
+				TrackerConfig: &config.OutputConfig{},
+			},
+		},
+		// kafka
+		{
+			testName:      "empty kafka flag",
+			outputSlice:   []string{"kafka:"},
+			expectedError: errors.New("validateKafka: kafka flag can't be empty, use '--output help' for more info"),
+		},
+		{
+			testName:      "kafka without topic",
+			outputSlice:   []string{"kafka:localhost:9092"},
+			expectedError: errors.New("validateKafka: invalid kafka output \"localhost:9092\", brokers and topic are required. Use '--output help' for more info"),
+		},
+		{
+			testName:      "kafka without broker port",
+			outputSlice:   []string{"kafka:localhost?topic=events"},
+			expectedError: errors.New("validateKafka: invalid kafka output \"localhost?topic=events\", brokers and topic are required. Use '--output help' for more info"),
+		},
+		{
+			testName:    "kafka",
+			outputSlice: []string{"kafka:broker1:9092,broker2:9092?topic=events&key=containerId"},
+			expectedOutput: PrepareOutputResult{
+				PrinterConfigs: []config.PrinterConfig{
+					{Kind: "kafka", OutPath: "broker1:9092,broker2:9092?topic=events&key=containerId"},
+				},
+				TrackerConfig: &config.OutputConfig{},
+			},
+		},
 		// options
 		{
 			testName:    "option stack-addresses",
@@ -365,6 +618,29 @@ func TestPrepareOutput(t *testing.T) {
 				},
 			},
 		},
+		{
+			testName:    "option labels",
+			outputSlice: []string{"json", "option:label=cluster=prod-eu,label=env=", "option:node-labels"},
+			expectedOutput: PrepareOutputResult{
+				PrinterConfigs: []config.PrinterConfig{
+					{Kind: "json", OutPath: "stdout"},
+				},
+				TrackerConfig: &config.OutputConfig{
+					Labels:     map[string]string{"cluster": "prod-eu", "env": ""},
+					NodeLabels: true,
+				},
+			},
+		},
+		{
+			testName:      "option label without value",
+			outputSlice:   []string{"option:label=cluster"},
+			expectedError: errors.New("invalid output option: label=cluster, use '--output help' for more info"),
+		},
+		{
+			testName:      "option label without key",
+			outputSlice:   []string{"option:label==prod"},
+			expectedError: errors.New("invalid output option: label==prod, use '--output help' for more info"),
+		},
 		{
 			testName: "all options",
 			outputSlice: []string{
@@ -411,6 +687,27 @@ func TestPrepareOutput(t *testing.T) {
 	}
 }
 
+func TestValidateRoutePolicies(t *testing.T) {
+	t.Parallel()
+
+	policies := policy.NewPolicies()
+	p := policy.NewPolicy()
+	p.Name = "prod"
+	require.NoError(t, policies.Add(p))
+
+	err := ValidateRoutePolicies([]config.PrinterConfig{
+		{OutPath: "stdout"},
+		{OutPath: "/tmp/prod", Route: config.PrinterRoute{Policies: []string{"prod"}}},
+	}, policies)
+	assert.NoError(t, err)
+
+	err = ValidateRoutePolicies([]config.PrinterConfig{
+		{OutPath: "/tmp/staging", Route: config.PrinterRoute{Policies: []string{"prod", "staging"}}},
+	}, policies)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "output /tmp/staging routes the events of unknown policy staging")
+}
+
 func assertPrinterConfigs(t *testing.T, expected []config.PrinterConfig, actual []config.PrinterConfig) {
 	// use a map to compare because the order of the printers is not guaranteed
 	printersMap := make(map[string]config.PrinterConfig)
@@ -427,5 +724,7 @@ func assertPrinterConfigs(t *testing.T, expected []config.PrinterConfig, actual
 		assert.Equal(t, expectedPrinter.OutPath, p.OutPath)
 		assert.Equal(t, expectedPrinter.RelativeTS, p.RelativeTS)
 		assert.Equal(t, expectedPrinter.ContainerMode, p.ContainerMode)
+		assert.Equal(t, expectedPrinter.Rotation, p.Rotation)
+		assert.Equal(t, expectedPrinter.Route, p.Route)
 	}
 }
@@ -10,6 +10,7 @@ import (
 	k8s "github.com/khulnasoft-lab/tracker/pkg/k8s/apis/tracker.khulnasoft.com/v1beta1"
 	"github.com/khulnasoft-lab/tracker/pkg/logger"
 	"github.com/khulnasoft-lab/tracker/pkg/policy"
+	"github.com/khulnasoft-lab/tracker/pkg/policy/actions"
 )
 
 // PrepareFilterMapsForPolicies prepares the scope and events PolicyFilterMap for the policies
@@ -17,7 +18,13 @@ func PrepareFilterMapsFromPolicies(policies []k8s.PolicyInterface) (PolicyScopeM
 	policyScopeMap := make(PolicyScopeMap)
 	policyEventsMap := make(PolicyEventMap)
 
-	if len(policies) == 0 {
+	count := 0
+	for _, p := range policies {
+		if p != nil {
+			count++
+		}
+	}
+	if count == 0 {
 		return nil, nil, errfmt.Errorf("no policies provided")
 	}
 
@@ -28,6 +35,10 @@ func PrepareFilterMapsFromPolicies(policies []k8s.PolicyInterface) (PolicyScopeM
 	policyNames := make(map[string]bool)
 
 	for pIdx, p := range policies {
+		// nil policies leave their ID unused, so the IDs of the others are kept
+		if p == nil {
+			continue
+		}
 		if _, ok := policyNames[p.GetName()]; ok {
 			return nil, nil, errfmt.Errorf("policy %s already exist", p.GetName())
 		}
@@ -61,6 +72,7 @@ func PrepareFilterMapsFromPolicies(policies []k8s.PolicyInterface) (PolicyScopeM
 		}
 
 		eventFlags := make([]eventFlag, 0)
+		var ruleActions map[string][]actions.Action
 
 		for _, r := range p.GetRules() {
 			evtFlags, err := parseEventFlag(r.Event)
@@ -69,6 +81,40 @@ func PrepareFilterMapsFromPolicies(policies []k8s.PolicyInterface) (PolicyScopeM
 			}
 			eventFlags = append(eventFlags, evtFlags...)
 
+			// rules without actions use the policy default actions
+			rulePolicyActions := r.Actions
+			if len(rulePolicyActions) == 0 {
+				rulePolicyActions = p.GetDefaultActions()
+			}
+			parsedActions, err := actions.ParseAll(rulePolicyActions)
+			if err != nil {
+				return nil, nil, errfmt.Errorf("policy %s, %v", p.GetName(), err)
+			}
+
+			// rules without rate limit or dedup window use the policy ones
+			rateLimit, err := actions.RuleRateLimit(parsedActions, r.RateLimit, p.GetRateLimit())
+			if err != nil {
+				return nil, nil, errfmt.Errorf("policy %s, event %s %v", p.GetName(), r.Event, err)
+			}
+			dedupWindow := r.DedupWindow
+			if dedupWindow == "" {
+				dedupWindow = p.GetDedupWindow()
+			}
+			throttleActions, err := actions.ParseThrottle(rateLimit, dedupWindow, r.DedupKeys)
+			if err != nil {
+				return nil, nil, errfmt.Errorf("policy %s, %v", p.GetName(), err)
+			}
+			parsedActions = append(parsedActions, throttleActions...)
+			for _, a := range parsedActions {
+				if a.IsOutput() {
+					continue
+				}
+				if ruleActions == nil {
+					ruleActions = make(map[string][]actions.Action)
+				}
+				ruleActions[r.Event] = append(ruleActions[r.Event], a)
+			}
+
 			for _, f := range r.Filters {
 				// event data or return value filter
 				// option "args." will be deprecate in future
@@ -94,8 +140,9 @@ func PrepareFilterMapsFromPolicies(policies []k8s.PolicyInterface) (PolicyScopeM
 		}
 
 		policyEventsMap[pIdx] = policyEvents{
-			policyName: p.GetName(),
-			eventFlags: eventFlags,
+			policyName:  p.GetName(),
+			eventFlags:  eventFlags,
+			ruleActions: ruleActions,
 		}
 	}
 
@@ -316,6 +363,11 @@ func CreatePolicies(policyScopeMap PolicyScopeMap, policyEventsMap PolicyEventMa
 			return nil, err
 		}
 
+		p.Actions, err = prepareRuleActions(policyEvents.ruleActions, eventsNameToID)
+		if err != nil {
+			return nil, err
+		}
+
 		err = policies.Set(p)
 		if err != nil {
 			logger.Warnw("Setting policy", "error", err)
@@ -324,3 +376,20 @@ func CreatePolicies(policyScopeMap PolicyScopeMap, policyEventsMap PolicyEventMa
 
 	return policies, nil
 }
+
+// prepareRuleActions maps the actions of each rule to the events selected by the rule
+func prepareRuleActions(ruleActions map[string][]actions.Action, eventsNameToID map[string]events.ID) (map[events.ID][]actions.Action, error) {
+	eventActions := make(map[events.ID][]actions.Action)
+
+	for ruleEvent, ruleEventActions := range ruleActions {
+		ruleEvents, err := prepareEventsToTrace(eventFilter{Equal: []string{ruleEvent}}, eventsNameToID)
+		if err != nil {
+			return nil, err
+		}
+		for id := range ruleEvents {
+			eventActions[id] = append(eventActions[id], ruleEventActions...)
+		}
+	}
+
+	return eventActions, nil
+}
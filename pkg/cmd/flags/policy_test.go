@@ -3,13 +3,17 @@ package flags
 import (
 	"fmt"
 	"math"
+	"syscall"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/khulnasoft-lab/tracker/pkg/events"
 	"github.com/khulnasoft-lab/tracker/pkg/filters"
 	k8s "github.com/khulnasoft-lab/tracker/pkg/k8s/apis/tracker.khulnasoft.com/v1beta1"
+	"github.com/khulnasoft-lab/tracker/pkg/policy/actions"
 	"github.com/khulnasoft-lab/tracker/pkg/policy/v1beta1"
 )
 
@@ -2129,3 +2133,60 @@ func TestCreatePolicies(t *testing.T) {
 		})
 	}
 }
+
+func TestCreatePoliciesRuleActions(t *testing.T) {
+	t.Parallel()
+
+	p := v1beta1.PolicyFile{
+		Metadata: v1beta1.Metadata{Name: "rule-actions"},
+		Spec: k8s.PolicySpec{
+			Scope:          []string{"global"},
+			DefaultActions: []string{"log"},
+			RateLimit:      "10/s",
+			DedupWindow:    "1m",
+			Rules: []k8s.Rule{
+				{Event: "write", DedupWindow: "10s", DedupKeys: []string{"fd"}},
+				{Event: "read", Actions: []string{"log", "signal=SIGKILL", "capture"}, RateLimit: "5/s"},
+				{Event: "openat", Actions: []string{"print"}},
+				{Event: "close", Actions: []string{"log", "rate-limit=20/s"}},
+			},
+		},
+	}
+
+	policyScopeMap, policyEventsMap, err := PrepareFilterMapsFromPolicies([]k8s.PolicyInterface{p})
+	require.NoError(t, err)
+
+	policies, err := CreatePolicies(policyScopeMap, policyEventsMap, true)
+	require.NoError(t, err)
+
+	created, err := policies.LookupByName("rule-actions")
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		map[events.ID][]actions.Action{
+			events.Write: {
+				{Kind: actions.RateLimit, Rate: 10},
+				{Kind: actions.Dedup, Window: 10 * time.Second, Keys: []string{"fd"}},
+			},
+			events.Read: {
+				{Kind: actions.Signal, Signal: syscall.SIGKILL},
+				{Kind: actions.Capture},
+				{Kind: actions.RateLimit, Rate: 5},
+				{Kind: actions.Dedup, Window: time.Minute},
+			},
+			events.Openat: {
+				{Kind: actions.RateLimit, Rate: 10},
+				{Kind: actions.Dedup, Window: time.Minute},
+			},
+			events.Close: {
+				{Kind: actions.RateLimit, Rate: 20},
+				{Kind: actions.Dedup, Window: time.Minute},
+			},
+		},
+		created.Actions,
+	)
+
+	p.Spec.Rules = []k8s.Rule{{Event: "read", Actions: []string{"rate-limit=20/s"}, RateLimit: "5/s"}}
+	_, _, err = PrepareFilterMapsFromPolicies([]k8s.PolicyInterface{p})
+	assert.ErrorContains(t, err, "policy rule-actions, event read rate limit 5/s is given by both the rateLimit field and the rate-limit=20/s action")
+}
@@ -0,0 +1,82 @@
+package flags
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/khulnasoft-lab/tracker/pkg/errfmt"
+	"github.com/khulnasoft-lab/tracker/pkg/redact"
+)
+
+func redactHelp() string {
+	return `Mask sensitive data in the event arguments before events are printed, streamed
+or evaluated by signatures opting out of the raw arguments.
+
+Each flag is a rule:
+  --redact pattern=<regex>                | masks the matches of regex in all string arguments.
+  --redact args=<arg>[,<arg>...]          | masks the whole values of the given arguments.
+  --redact args=<arg>,...:pattern=<regex> | masks the matches of regex in the given arguments.
+
+If the pattern has capture groups, only the groups are masked. Masked data is replaced
+by [REDACTED].
+
+Example:
+  --redact 'args=env:pattern=^AWS_SECRET_ACCESS_KEY=(.*)'  | masks the value of the variable in the exec environment.
+  --redact 'args=argv:pattern=--password=(\S+)'            | masks passwords given in command lines.
+  --redact args=pathname                                   | masks all pathnames.
+
+Use the flag multiple times to add multiple rules.
+`
+}
+
+// PrepareRedact returns the redactor of the given rules, or nil if no rule is given
+func PrepareRedact(redactSlice []string) (*redact.Redactor, error) {
+	rules := make([]redact.Rule, 0, len(redactSlice))
+
+	for _, value := range redactSlice {
+		if value == "help" {
+			return nil, fmt.Errorf(redactHelp())
+		}
+		if value == "none" {
+			return nil, nil
+		}
+
+		rule, err := parseRedactRule(value)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	return redact.New(rules)
+}
+
+// parseRedactRule parses a rule in the form [args=<arg>,...][:][pattern=<regex>]. The
+// argument names can't contain a colon, so the first one ends the list of arguments.
+func parseRedactRule(flag string) (redact.Rule, error) {
+	var rule redact.Rule
+
+	value := flag
+	if strings.HasPrefix(value, "args=") {
+		args, rest, _ := strings.Cut(strings.TrimPrefix(value, "args="), ":")
+		rule.Args = strings.Split(args, ",")
+		value = rest
+		if value == "" {
+			return rule, nil
+		}
+	}
+
+	if !strings.HasPrefix(value, "pattern=") {
+		return rule, errfmt.Errorf("invalid redact rule %q, run '--redact help' for more info", flag)
+	}
+	rule.Pattern = strings.TrimPrefix(value, "pattern=")
+	if rule.Pattern == "" {
+		return rule, errfmt.Errorf("redact pattern can't be empty, run '--redact help' for more info")
+	}
+
+	return rule, nil
+}
@@ -0,0 +1,91 @@
+package flags
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/khulnasoft-lab/tracker/pkg/redact"
+)
+
+func TestParseRedactRule(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName      string
+		flag          string
+		expectedRule  redact.Rule
+		expectedError string
+	}{
+		{
+			testName:     "pattern",
+			flag:         `pattern=(?i)token=(\S+)`,
+			expectedRule: redact.Rule{Pattern: `(?i)token=(\S+)`},
+		},
+		{
+			testName:     "arguments",
+			flag:         "args=pathname,argv",
+			expectedRule: redact.Rule{Args: []string{"pathname", "argv"}},
+		},
+		{
+			testName:     "arguments and pattern with colons",
+			flag:         "args=env:pattern=^SECRET:(.*)",
+			expectedRule: redact.Rule{Args: []string{"env"}, Pattern: "^SECRET:(.*)"},
+		},
+		{
+			testName:      "unknown option",
+			flag:          "regex=abc",
+			expectedError: "invalid redact rule \"regex=abc\", run '--redact help' for more info",
+		},
+		{
+			testName:      "unknown option after arguments",
+			flag:          "args=env:regex=abc",
+			expectedError: "invalid redact rule \"args=env:regex=abc\", run '--redact help' for more info",
+		},
+		{
+			testName:      "empty pattern",
+			flag:          "args=env:pattern=",
+			expectedError: "redact pattern can't be empty, run '--redact help' for more info",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.testName, func(t *testing.T) {
+			t.Parallel()
+
+			rule, err := parseRedactRule(tc.flag)
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedRule, rule)
+		})
+	}
+}
+
+func TestPrepareRedact(t *testing.T) {
+	t.Parallel()
+
+	redactor, err := PrepareRedact([]string{})
+	require.NoError(t, err)
+	assert.Nil(t, redactor)
+
+	redactor, err = PrepareRedact([]string{"none"})
+	require.NoError(t, err)
+	assert.Nil(t, redactor)
+
+	redactor, err = PrepareRedact([]string{"args=pathname", "pattern=secret"})
+	require.NoError(t, err)
+	assert.NotNil(t, redactor)
+
+	_, err = PrepareRedact([]string{"args=,argv"})
+	assert.ErrorContains(t, err, "empty argument name in redaction rule")
+
+	_, err = PrepareRedact([]string{"pattern=("})
+	assert.ErrorContains(t, err, "invalid redaction pattern \"(\"")
+}
@@ -0,0 +1,122 @@
+package server
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/khulnasoft-lab/tracker/pkg/errfmt"
+	"github.com/khulnasoft-lab/tracker/pkg/server/auth"
+)
+
+const ServerAuthFlag = "server-auth"
+
+func serverAuthHelp() string {
+	return `Secure the connections to the http and grpc servers and authorize their calls.
+
+TLS:
+  --server-auth tls-cert=<file>                    | server certificate, enables TLS.
+  --server-auth tls-key=<file>                     | key of the server certificate.
+  --server-auth tls-client-ca=<file>               | CA of the client certificates, requires clients to present a certificate it signed (mTLS).
+
+Roles:
+  --server-auth token=<role>:<file>                | grants the role to the bearer tokens in file, given as '<client> <token>' lines.
+  --server-auth uid=<role>:<uid>[,<uid>...]        | grants the role to the processes of the users calling through the grpc unix socket.
+  --server-auth gid=<role>:<gid>[,<gid>...]        | grants the role to the processes of the groups calling through the grpc unix socket.
+  --server-auth cn=<role>:<name>[,<name>...]       | grants the role to the client certificates of the common names.
+
+The roles are:
+  read-only  | streaming events, getting event definitions, version, metrics, policies, the process tree and the data sources.
+  admin      | all calls, including enabling and disabling events, changing the log level and changing policies.
+
+Once a role is granted, callers without one are denied, except for the healthz endpoint. Callers
+presenting an unknown token are always denied. Denied calls are logged with the caller identity.
+
+Example:
+  --server-auth tls-cert=/etc/tracker/server.crt --server-auth tls-key=/etc/tracker/server.key
+  --server-auth token=admin:/etc/tracker/admin-tokens  | tokens in the file can make any call.
+  --server-auth uid=admin:0 --server-auth gid=read-only:1001
+
+Use the flag multiple times to set multiple options.
+`
+}
+
+// PrepareServerAuth returns the authenticator of the given options, or nil if no option is
+// given
+func PrepareServerAuth(authSlice []string) (*auth.Authenticator, error) {
+	if len(authSlice) == 0 {
+		return nil, nil
+	}
+
+	cfg := auth.Config{
+		TokenFiles:  map[string]auth.Role{},
+		UIDs:        map[uint32]auth.Role{},
+		GIDs:        map[uint32]auth.Role{},
+		CommonNames: map[string]auth.Role{},
+	}
+
+	for _, value := range authSlice {
+		if value == "help" {
+			return nil, fmt.Errorf(serverAuthHelp())
+		}
+
+		option, arg, ok := strings.Cut(value, "=")
+		if !ok || arg == "" {
+			return nil, errfmt.Errorf("invalid server-auth option %q, run '--server-auth help' for more info", value)
+		}
+
+		switch option {
+		case "tls-cert":
+			cfg.CertFile = arg
+			continue
+		case "tls-key":
+			cfg.KeyFile = arg
+			continue
+		case "tls-client-ca":
+			cfg.ClientCAFile = arg
+			continue
+		}
+
+		roleName, grantees, ok := strings.Cut(arg, ":")
+		if !ok || grantees == "" {
+			return nil, errfmt.Errorf("invalid server-auth option %q, run '--server-auth help' for more info", value)
+		}
+		role, err := auth.ParseRole(roleName)
+		if err != nil {
+			return nil, errfmt.Errorf("invalid role %q, use read-only or admin, run '--server-auth help' for more info", roleName)
+		}
+
+		switch option {
+		case "token":
+			grant(cfg.TokenFiles, grantees, role)
+		case "uid", "gid":
+			ids := cfg.UIDs
+			if option == "gid" {
+				ids = cfg.GIDs
+			}
+			for _, idStr := range strings.Split(grantees, ",") {
+				id, err := strconv.ParseUint(idStr, 10, 32)
+				if err != nil {
+					return nil, errfmt.Errorf("invalid %s %q, run '--server-auth help' for more info", option, idStr)
+				}
+				grant(ids, uint32(id), role)
+			}
+		case "cn":
+			for _, name := range strings.Split(grantees, ",") {
+				grant(cfg.CommonNames, name, role)
+			}
+		default:
+			return nil, errfmt.Errorf("invalid server-auth option %q, run '--server-auth help' for more info", value)
+		}
+	}
+
+	return auth.New(cfg)
+}
+
+// grant grants the role to the grantee, keeping the highest role if it's granted more
+// than one
+func grant[K comparable](roles map[K]auth.Role, grantee K, role auth.Role) {
+	if prev, ok := roles[grantee]; !ok || role > prev {
+		roles[grantee] = role
+	}
+}
@@ -0,0 +1,81 @@
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrepareServerAuth(t *testing.T) {
+	t.Parallel()
+
+	tokens := filepath.Join(t.TempDir(), "tokens")
+	require.NoError(t, os.WriteFile(tokens, []byte("ci abc123\n"), 0600))
+
+	testCases := []struct {
+		testName        string
+		flags           []string
+		expectedEnabled bool
+		expectedError   string
+	}{
+		{
+			testName: "no flags",
+			flags:    []string{},
+		},
+		{
+			testName:        "roles",
+			flags:           []string{"token=admin:" + tokens, "uid=read-only:0,1000", "gid=admin:0", "uid=admin:0"},
+			expectedEnabled: true,
+		},
+		{
+			testName:      "unknown option",
+			flags:         []string{"user=admin:0"},
+			expectedError: "invalid server-auth option \"user=admin:0\", run '--server-auth help' for more info",
+		},
+		{
+			testName:      "missing value",
+			flags:         []string{"tls-cert="},
+			expectedError: "invalid server-auth option \"tls-cert=\", run '--server-auth help' for more info",
+		},
+		{
+			testName:      "unknown role",
+			flags:         []string{"uid=root:0"},
+			expectedError: "invalid role \"root\", use read-only or admin, run '--server-auth help' for more info",
+		},
+		{
+			testName:      "missing grantees",
+			flags:         []string{"uid=admin:"},
+			expectedError: "invalid server-auth option \"uid=admin:\", run '--server-auth help' for more info",
+		},
+		{
+			testName:      "invalid uid",
+			flags:         []string{"uid=admin:root"},
+			expectedError: "invalid uid \"root\", run '--server-auth help' for more info",
+		},
+		{
+			testName:      "common names without client CA",
+			flags:         []string{"cn=read-only:prometheus"},
+			expectedError: "roles by certificate common name require a client CA",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.testName, func(t *testing.T) {
+			t.Parallel()
+
+			a, err := PrepareServerAuth(tc.flags)
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedEnabled, a.Enabled())
+		})
+	}
+}
@@ -10,8 +10,10 @@ const (
 	MetricsEndpointFlag    = "metrics"
 	HealthzEndpointFlag    = "healthz"
 	PProfEndpointFlag      = "pprof"
+	APIEndpointFlag        = "api"
 	HTTPListenEndpointFlag = "http-listen-addr"
 	GRPCListenEndpointFlag = "grpc-listen-addr"
+	GRPCReplayBufferFlag   = "grpc-replay-buffer-size"
 	PyroscopeAgentFlag     = "pyroscope"
 )
 
@@ -21,12 +23,12 @@ const (
 // 'pkf/cmd/flags' directly libbpfgo becomes a dependency and we need to compile it with
 // tracker-rules.
 
-func PrepareHTTPServer(listenAddr string, metrics, healthz, pprof, pyro bool) (*http.Server, error) {
+func PrepareHTTPServer(listenAddr string, metrics, healthz, pprof, pyro, api bool) (*http.Server, error) {
 	if len(listenAddr) == 0 {
 		return nil, errfmt.Errorf("http listen address cannot be empty")
 	}
 
-	if metrics || healthz || pprof {
+	if metrics || healthz || pprof || api {
 		httpServer := http.New(listenAddr)
 
 		if metrics {
@@ -43,6 +45,11 @@ func PrepareHTTPServer(listenAddr string, metrics, healthz, pprof, pyro bool) (*
 			logger.Debugw("Enabling pprof endpoint")
 			httpServer.EnablePProfEndpoint()
 		}
+		if api {
+			logger.Debugw("Enabling API endpoint")
+			httpServer.EnableAPIEndpoint()
+		}
+
 		if pyro {
 			logger.Debugw("Enabling pyroscope agent")
 			err := httpServer.EnablePyroAgent()
@@ -17,7 +17,7 @@ Possible options:
 [format:]gotemplate=/path/to/template              output events formatted using a given gotemplate file
 out-file:/path/to/file                             write the output to a specified file. create/trim the file if exists (default: stdout)
 none                                               ignore stream of events output, usually used with --capture
-option:{stack-addresses,exec-env,relative-time,exec-hash,parse-arguments,sort-events}
+option:{stack-addresses,exec-env,relative-time,exec-hash,parse-arguments,sort-events,label,node-labels}
                                                    augment output according to given options (default: none)
   stack-addresses                                  include stack memory addresses for each event
   exec-env                                         when tracing execve/execveat, show the environment variables that were used for execution
@@ -26,6 +26,8 @@ option:{stack-addresses,exec-env,relative-time,exec-hash,parse-arguments,sort-ev
   parse-arguments                                  do not show raw machine-readable values for event arguments, instead parse into human readable strings
   parse-arguments-fds                              enable parse-arguments and enrich fd with its file path translation. This can cause pipeline slowdowns.
   sort-events                                      enable sorting events before passing to them output. This will decrease the overall program efficiency.
+  label=<key>=<value>                              add a static label to every event, e.g. label=cluster=prod-eu
+  node-labels                                      add labels identifying the node to every event: hostname, machine id and cloud instance
 Examples:
   --output json                                            | output as json to stdout
   --output gotemplate=/path/to/my.tmpl                     | output as the provided go template
@@ -0,0 +1,194 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	tracker "github.com/khulnasoft-lab/tracker/pkg/ebpf"
+	"github.com/khulnasoft-lab/tracker/pkg/k8s"
+	"github.com/khulnasoft-lab/tracker/pkg/k8s/apis/tracker.khulnasoft.com/v1beta1"
+	"github.com/khulnasoft-lab/tracker/pkg/logger"
+)
+
+// PolicyWatcher applies the kubernetes policies in place whenever they change, and
+// reports the state of each policy in this node back to the policy status, so the
+// operator can tell if a policy was applied in all nodes.
+type PolicyWatcher struct {
+	Client   *k8s.Client
+	NodeName string
+
+	// generation of each policy in the last applied set
+	generations map[string]int64
+	// generation of each policy in the last set that failed to be applied
+	failed map[string]int64
+	// running version of each policy, kept while the policy is invalid
+	running map[string]v1beta1.Policy
+}
+
+const (
+	policyRetryBackoff  = time.Second
+	policyMaxRetryDelay = time.Minute
+)
+
+// Run watches the kubernetes policies until the context is done. Policies that
+// failed to be applied are retried with exponential backoff, while policies using
+// events that are not being traced stop the watcher with an error, since tracker
+// must be restarted to apply them.
+func (w *PolicyWatcher) Run(ctx context.Context, t *tracker.Tracker) error {
+	updates := make(chan []v1beta1.Policy, 1)
+	go func() {
+		err := w.Client.WatchPolicies(ctx, func(policies []v1beta1.Policy) {
+			// only the latest policies are applied
+			select {
+			case <-updates:
+			default:
+			}
+			updates <- policies
+		}, func(err error) {
+			logger.Warnw("Watching kubernetes policies, retrying", "error", err)
+		})
+		if err != nil && ctx.Err() == nil {
+			logger.Errorw("Watching kubernetes policies, policies won't be updated", "error", err)
+		}
+	}()
+
+	var policies []v1beta1.Policy
+	var retry <-chan time.Time
+	attempt := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case policies = <-updates:
+		case <-retry:
+		}
+
+		retry = nil
+		if err := w.sync(ctx, t, policies); err != nil {
+			if errors.Is(err, tracker.ErrRestartRequired) {
+				return err
+			}
+			attempt++
+			retry = time.After(min(policyRetryBackoff<<(attempt-1), policyMaxRetryDelay))
+			continue
+		}
+		attempt = 0
+	}
+}
+
+// sync applies the given policies if any of them was added, changed or deleted.
+// Invalid policies are left out, or keep their running version, so they don't prevent
+// the others from being applied. It returns an error if the policies could not be
+// applied, so they are retried.
+func (w *PolicyWatcher) sync(ctx context.Context, t *tracker.Tracker, policies []v1beta1.Policy) error {
+	// status updates also trigger a sync, so only act on spec changes
+	generations := make(map[string]int64, len(policies))
+	for _, p := range policies {
+		generations[p.Name] = p.Generation
+	}
+	if w.generations != nil && generationsEqual(w.generations, generations) {
+		return nil
+	}
+	previous := w.generations
+
+	// failures are reported once, since writing the status triggers a new sync
+	report := w.failed == nil || !generationsEqual(w.failed, generations)
+
+	changed := make([]v1beta1.Policy, 0, len(policies))
+	valid := make([]v1beta1.Policy, 0, len(policies))
+	for _, p := range policies {
+		isChanged := previous[p.Name] != p.Generation
+		if err := t.ValidatePolicy(p); err != nil {
+			if isChanged && report {
+				w.report(ctx, p, v1beta1.PolicyReasonInvalid, err)
+			}
+			if r, ok := w.running[p.Name]; ok {
+				valid = append(valid, r)
+			}
+			continue
+		}
+		if isChanged {
+			changed = append(changed, p)
+		}
+		valid = append(valid, p)
+	}
+
+	// the policies tracker started with are kept until kubernetes policies are
+	// applied, then removing all of them leaves tracker without policies
+	if len(valid) == 0 && w.running == nil {
+		logger.Warnw("No valid kubernetes policies, running policies were kept")
+		w.generations = generations // nothing to retry until the policies change
+		return nil
+	}
+
+	documents := make([]v1beta1.PolicyInterface, 0, len(valid))
+	for _, p := range valid {
+		documents = append(documents, p)
+	}
+	if err := t.ReplacePolicies(documents); err != nil {
+		reason := v1beta1.PolicyReasonFailed
+		if errors.Is(err, tracker.ErrRestartRequired) {
+			reason = v1beta1.PolicyReasonRestartRequired
+			logger.Errorw("Applying kubernetes policies, tracker is restarting", "error", err)
+		} else {
+			logger.Errorw("Applying kubernetes policies, running policies were kept", "error", err)
+		}
+		if report {
+			for _, p := range changed {
+				w.report(ctx, p, reason, err)
+			}
+		}
+		w.failed = generations
+		return err
+	}
+
+	w.generations = generations
+	w.failed = nil
+	w.running = make(map[string]v1beta1.Policy, len(valid))
+	for _, p := range valid {
+		w.running[p.Name] = p
+	}
+	for _, p := range changed {
+		w.report(ctx, p, v1beta1.PolicyReasonApplied, nil)
+	}
+
+	return nil
+}
+
+// report writes the state of the given policy in this node to the policy status
+func (w *PolicyWatcher) report(ctx context.Context, p v1beta1.Policy, reason string, err error) {
+	status := v1beta1.PolicyNodeStatus{
+		ObservedGeneration: p.Generation,
+		Reason:             reason,
+		LastUpdateTime:     metav1.Now(),
+	}
+	if err != nil {
+		status.Message = err.Error()
+		// the running version of the policy, if any, is still applied
+		if r, ok := w.running[p.Name]; ok {
+			status.AppliedGeneration = r.Generation
+		}
+	} else {
+		status.AppliedGeneration = p.Generation
+	}
+
+	if err := w.Client.UpdatePolicyNodeStatus(ctx, p.Name, w.NodeName, status); err != nil {
+		logger.Warnw("Updating kubernetes policy status", "policy", p.Name, "error", err)
+	}
+}
+
+func generationsEqual(a, b map[string]int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name, generation := range a {
+		if g, ok := b[name]; !ok || g != generation {
+			return false
+		}
+	}
+
+	return true
+}
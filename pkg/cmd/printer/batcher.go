@@ -0,0 +1,83 @@
+package printer
+
+import (
+	"sync"
+	"time"
+)
+
+// batcher collects the printed items of network printers into batches, by size and time,
+// and flushes them from its own goroutine so that a slow destination doesn't block the
+// printer. The flushed batch is reused after flush returns.
+type batcher[T any] struct {
+	items    chan T
+	done     chan struct{}
+	stopOnce sync.Once
+	size     int
+	timeout  time.Duration
+	flush    func(batch []T)
+}
+
+func newBatcher[T any](size int, timeout time.Duration, buffer int, flush func(batch []T)) *batcher[T] {
+	b := &batcher[T]{
+		items:   make(chan T, buffer),
+		done:    make(chan struct{}),
+		size:    size,
+		timeout: timeout,
+		flush:   flush,
+	}
+	go b.run()
+
+	return b
+}
+
+func (b *batcher[T]) add(item T) {
+	b.items <- item
+}
+
+// tryAdd adds an item without blocking, returning false if the buffer is full
+func (b *batcher[T]) tryAdd(item T) bool {
+	select {
+	case b.items <- item:
+		return true
+	default:
+		return false
+	}
+}
+
+// stop flushes the pending items and waits for the flush to finish
+func (b *batcher[T]) stop() {
+	b.stopOnce.Do(func() {
+		close(b.items)
+	})
+	<-b.done
+}
+
+func (b *batcher[T]) run() {
+	defer close(b.done)
+
+	ticker := time.NewTicker(b.timeout)
+	defer ticker.Stop()
+
+	batch := make([]T, 0, b.size)
+	for {
+		select {
+		case item, ok := <-b.items:
+			if !ok {
+				if len(batch) > 0 {
+					b.flush(batch)
+				}
+				return
+			}
+			batch = append(batch, item)
+			if len(batch) >= b.size {
+				b.flush(batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			if len(batch) > 0 {
+				b.flush(batch)
+				batch = batch[:0]
+			}
+		}
+	}
+}
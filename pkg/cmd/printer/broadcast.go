@@ -1,9 +1,11 @@
 package printer
 
 import (
+	"os"
 	"sync"
 
 	"github.com/khulnasoft-lab/tracker/pkg/config"
+	"github.com/khulnasoft-lab/tracker/pkg/logger"
 	"github.com/khulnasoft-lab/tracker/pkg/metrics"
 	"github.com/khulnasoft-lab/tracker/types/trace"
 )
@@ -14,8 +16,21 @@ type Broadcast struct {
 	printers       []EventPrinter
 	wg             *sync.WaitGroup
 	eventsChan     []chan trace.Event
+	routes         []*eventRoute // events sent to each printer, nil for all events
 	done           chan struct{}
 	containerMode  config.ContainerMode
+	files          []*outputFile
+}
+
+// Reopener is implemented by printers writing to files that can be reopened, after the
+// files were moved by an external rotation
+type Reopener interface {
+	Reopen()
+}
+
+// StatsSetter is implemented by printers counting their deliveries in the tracker stats
+type StatsSetter interface {
+	SetStats(stats *metrics.Stats)
 }
 
 // NewBroadcast creates a new Broadcast printer
@@ -26,17 +41,28 @@ func NewBroadcast(printerConfigs []config.PrinterConfig, containerMode config.Co
 
 func (b *Broadcast) Init() error {
 	printers := make([]EventPrinter, 0, len(b.PrinterConfigs))
+	routes := make([]*eventRoute, 0, len(b.PrinterConfigs))
 	wg := &sync.WaitGroup{}
 
 	for _, pConfig := range b.PrinterConfigs {
 		pConfig.ContainerMode = b.containerMode
 
+		if file, ok := pConfig.OutFile.(*os.File); ok && file != os.Stdout {
+			f, err := newOutputFile(file, pConfig.OutPath, pConfig.Rotation)
+			if err != nil {
+				return err
+			}
+			b.files = append(b.files, f)
+			pConfig.OutFile = f
+		}
+
 		p, err := New(pConfig)
 		if err != nil {
 			return err
 		}
 
 		printers = append(printers, p)
+		routes = append(routes, newEventRoute(pConfig.Route))
 	}
 
 	eventsChan := make([]chan trace.Event, 0, len(printers))
@@ -53,6 +79,7 @@ func (b *Broadcast) Init() error {
 	}
 
 	b.printers = printers
+	b.routes = routes
 	b.eventsChan = eventsChan
 	b.wg = wg
 	b.done = done
@@ -66,9 +93,12 @@ func (b *Broadcast) Preamble() {
 	}
 }
 
-// Print broadcasts the event to all printers
+// Print broadcasts the event to the printers it's routed to
 func (b *Broadcast) Print(event trace.Event) {
-	for _, c := range b.eventsChan {
+	for i, c := range b.eventsChan {
+		if !b.routes[i].match(&event) {
+			continue
+		}
 		// we are blocking here if the printer is not consuming events fast enough
 		c <- event
 	}
@@ -91,6 +121,29 @@ func (b *Broadcast) Close() {
 	for _, p := range b.printers {
 		p.Close()
 	}
+	for _, f := range b.files {
+		if err := f.Close(); err != nil {
+			logger.Errorw("Error closing output file", "path", f.path, "error", err)
+		}
+	}
+}
+
+// SetStats sets the tracker stats of the printers counting their deliveries
+func (b *Broadcast) SetStats(stats *metrics.Stats) {
+	for _, p := range b.printers {
+		if s, ok := p.(StatsSetter); ok {
+			s.SetStats(stats)
+		}
+	}
+}
+
+// Reopen reopens the output files of the printers
+func (b *Broadcast) Reopen() {
+	for _, f := range b.files {
+		if err := f.Reopen(); err != nil {
+			logger.Errorw("Error reopening output file", "path", f.path, "error", err)
+		}
+	}
 }
 
 func startPrinter(wg *sync.WaitGroup, done chan struct{}, c chan trace.Event, p EventPrinter) {
@@ -0,0 +1,178 @@
+package printer
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/khulnasoft-lab/tracker/pkg/events/parse"
+	"github.com/khulnasoft-lab/tracker/pkg/logger"
+	"github.com/khulnasoft-lab/tracker/pkg/metrics"
+	"github.com/khulnasoft-lab/tracker/pkg/version"
+	"github.com/khulnasoft-lab/tracker/types/trace"
+)
+
+const (
+	cefVendor  = "Khulnasoft"
+	cefProduct = "Tracker"
+)
+
+// cefEventPrinter prints events in the ArcSight Common Event Format, one per line
+type cefEventPrinter struct {
+	out io.WriteCloser
+}
+
+func (p cefEventPrinter) Init() error { return nil }
+
+func (p cefEventPrinter) Preamble() {}
+
+func (p cefEventPrinter) Print(event trace.Event) {
+	if _, err := fmt.Fprintln(p.out, formatCEF(event)); err != nil {
+		logger.Errorw("Error writing CEF event", "error", err)
+	}
+}
+
+func (p cefEventPrinter) Epilogue(stats metrics.Stats) {}
+
+func (p cefEventPrinter) Close() {}
+
+// formatCEF formats an event as a CEF message:
+//
+//	CEF:0|Vendor|Product|Version|Signature ID|Name|Severity|Extension
+//
+// Findings are identified by their signature, with the severity and MITRE ATT&CK
+// technique of their metadata, other events by their name.
+func formatCEF(event trace.Event) string {
+	signatureID := event.EventName
+	name := event.EventName
+	severity := 0
+
+	ext := cefExtension{}
+	ext.add("rt", strconv.Itoa(event.Timestamp/1e6)) // milliseconds
+	ext.add("dvchost", event.HostName)
+	ext.add("sproc", event.ProcessName)
+	ext.add("spid", strconv.Itoa(event.HostProcessID))
+	ext.add("suid", strconv.Itoa(event.UserID))
+	ext.add("fname", event.Executable.Path)
+	ext.addCustom("cs1", "containerId", event.Container.ID)
+	ext.addCustom("cs2", "containerImage", event.Container.ImageName)
+	ext.addCustom("cs3", "podName", event.Kubernetes.PodName)
+	ext.addCustom("cs4", "podNamespace", event.Kubernetes.PodNamespace)
+	ext.addCustom("flexString1", "labels", cefLabels(event.Labels))
+
+	if event.Metadata != nil {
+		props := event.Metadata.Properties
+		if id, ok := props["signatureID"].(string); ok && id != "" {
+			signatureID = id
+		}
+		if sigName, ok := props["signatureName"].(string); ok && sigName != "" {
+			name = sigName
+		}
+		if s, ok := parse.FindingSeverity(event); ok {
+			severity = cefSeverity(s)
+		}
+		category, _ := props["Category"].(string)
+		ext.add("cat", category)
+		technique, _ := props["external_id"].(string)
+		ext.addCustom("cs5", "mitreTechniqueId", technique)
+		techniqueName, _ := props["Technique"].(string)
+		ext.addCustom("cs6", "mitreTechnique", techniqueName)
+		ext.add("msg", event.Metadata.Description)
+	} else {
+		ext.add("msg", cefArguments(event.Args))
+	}
+
+	return fmt.Sprintf("CEF:0|%s|%s|%s|%s|%s|%d|%s",
+		cefEscapeHeader(cefVendor),
+		cefEscapeHeader(cefProduct),
+		cefEscapeHeader(version.GetVersion()),
+		cefEscapeHeader(signatureID),
+		cefEscapeHeader(name),
+		severity,
+		ext.String(),
+	)
+}
+
+// cefLabels formats the labels of an event as comma separated key=value pairs, sorted by key
+func cefLabels(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for key, value := range labels {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ",")
+}
+
+// cefSeverity maps the severity of a finding (0 to 4) to the CEF severity (0 to 10)
+func cefSeverity(severity int) int {
+	switch {
+	case severity <= 0:
+		return 1 // low
+	case severity == 1:
+		return 3 // low
+	case severity == 2:
+		return 5 // medium
+	case severity == 3:
+		return 8 // high
+	}
+
+	return 10 // very high
+}
+
+// cefArguments formats the event arguments as space separated name=value pairs
+func cefArguments(args []trace.Argument) string {
+	pairs := make([]string, 0, len(args))
+	for _, arg := range args {
+		pairs = append(pairs, fmt.Sprintf("%s=%v", arg.Name, arg.Value))
+	}
+
+	return strings.Join(pairs, " ")
+}
+
+// cefExtension is the key=value extension of a CEF message
+type cefExtension map[string]string
+
+func (e cefExtension) add(key, value string) {
+	if value != "" {
+		e[key] = value
+	}
+}
+
+// addCustom adds a custom string field along with its label
+func (e cefExtension) addCustom(key, label, value string) {
+	if value != "" {
+		e[key] = value
+		e[key+"Label"] = label
+	}
+}
+
+func (e cefExtension) String() string {
+	keys := make([]string, 0, len(e))
+	for k := range e {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, k+"="+cefEscapeExtension(e[k]))
+	}
+
+	return strings.Join(pairs, " ")
+}
+
+var (
+	cefHeaderEscaper    = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\n", " ", "\r", " ")
+	cefExtensionEscaper = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\n", `\n`, "\r", `\r`)
+)
+
+func cefEscapeHeader(s string) string {
+	return cefHeaderEscaper.Replace(s)
+}
+
+func cefEscapeExtension(s string) string {
+	return cefExtensionEscaper.Replace(s)
+}
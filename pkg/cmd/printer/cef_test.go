@@ -0,0 +1,94 @@
+package printer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/khulnasoft-lab/tracker/types/trace"
+)
+
+func TestFormatCEF(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		event    trace.Event
+		expected string
+	}{
+		{
+			name: "event",
+			event: trace.Event{
+				Timestamp:     1700000000123456789,
+				HostName:      "node1",
+				ProcessName:   "cat",
+				HostProcessID: 1234,
+				UserID:        1000,
+				EventName:     "openat",
+				Container:     trace.Container{ID: "abc123", ImageName: "ubuntu:22.04"},
+				Args: []trace.Argument{
+					{ArgMeta: trace.ArgMeta{Name: "pathname"}, Value: "/etc/passwd"},
+					{ArgMeta: trace.ArgMeta{Name: "flags"}, Value: "O_RDONLY"},
+				},
+			},
+			expected: "CEF:0|Khulnasoft|Tracker||openat|openat|0|" +
+				"cs1=abc123 cs1Label=containerId cs2=ubuntu:22.04 cs2Label=containerImage dvchost=node1 " +
+				"msg=pathname\\=/etc/passwd flags\\=O_RDONLY rt=1700000000123 spid=1234 sproc=cat suid=1000",
+		},
+		{
+			name: "finding",
+			event: trace.Event{
+				Timestamp:     1700000000000000000,
+				HostName:      "node1",
+				ProcessName:   "strace",
+				HostProcessID: 42,
+				EventName:     "anti_debugging",
+				Kubernetes:    trace.Kubernetes{PodName: "web", PodNamespace: "default"},
+				Metadata: &trace.Metadata{
+					Description: "A process used anti-debugging techniques",
+					Properties: map[string]interface{}{
+						"signatureID":   "TRC-102",
+						"signatureName": "Anti-Debugging | detected",
+						"Severity":      3,
+						"Category":      "defense-evasion",
+						"Technique":     "Debugger Evasion",
+						"external_id":   "T1622",
+					},
+				},
+			},
+			expected: "CEF:0|Khulnasoft|Tracker||TRC-102|Anti-Debugging \\| detected|8|" +
+				"cat=defense-evasion cs3=web cs3Label=podName cs4=default cs4Label=podNamespace " +
+				"cs5=T1622 cs5Label=mitreTechniqueId cs6=Debugger Evasion cs6Label=mitreTechnique dvchost=node1 " +
+				"msg=A process used anti-debugging techniques rt=1700000000000 spid=42 sproc=strace suid=0",
+		},
+		{
+			name: "labels",
+			event: trace.Event{
+				EventName: "openat",
+				Labels:    map[string]string{"env": "dev", "cluster": "prod-eu"},
+			},
+			expected: "CEF:0|Khulnasoft|Tracker||openat|openat|0|" +
+				"flexString1=cluster\\=prod-eu,env\\=dev flexString1Label=labels rt=0 spid=0 suid=0",
+		},
+		{
+			name: "escaped extension values",
+			event: trace.Event{
+				EventName: "execve",
+				Args: []trace.Argument{
+					{ArgMeta: trace.ArgMeta{Name: "argv"}, Value: "a\\b\nc"},
+				},
+			},
+			expected: "CEF:0|Khulnasoft|Tracker||execve|execve|0|msg=argv\\=a\\\\b\\nc rt=0 spid=0 suid=0",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, formatCEF(tc.event))
+		})
+	}
+}
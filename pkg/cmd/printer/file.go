@@ -0,0 +1,291 @@
+package printer
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/khulnasoft-lab/tracker/pkg/config"
+	"github.com/khulnasoft-lab/tracker/pkg/errfmt"
+	"github.com/khulnasoft-lab/tracker/pkg/logger"
+)
+
+// backupTimeFormat is the timestamp added to the name of the rotated files, sorting them
+// by rotation time
+const backupTimeFormat = "2006-01-02T15-04-05.000000000"
+
+// outputFile is the file of a file output. It's rotated by size, moving the full file to
+// a timestamped backup and starting a new one, and it can be reopened after the file was
+// moved by an external rotation (e.g. logrotate). The backups are compressed and pruned
+// in the background.
+type outputFile struct {
+	path     string
+	rotation config.FileRotation
+
+	mu           sync.Mutex
+	file         *os.File
+	size         int64
+	rotateFailed bool // rotation is stopped until the file is reopened
+
+	backups   sync.WaitGroup // compression and pruning of the backups
+	backupsMu sync.Mutex     // one backup is processed at a time
+	now       func() time.Time
+}
+
+func newOutputFile(file *os.File, path string, rotation config.FileRotation) (*outputFile, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return nil, errfmt.Errorf("unable to stat output file %q: %v", path, err)
+	}
+
+	return &outputFile{
+		path:     path,
+		rotation: rotation,
+		file:     file,
+		size:     info.Size(),
+		now:      time.Now,
+	}, nil
+}
+
+// Write writes to the current file, rotating it first if it would exceed its maximum
+// size. If the rotation fails, the write goes to the current file and the rotation is
+// stopped until the file is reopened.
+func (f *outputFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.rotation.Enabled() && !f.rotateFailed && f.size > 0 && f.size+int64(len(p)) > f.rotation.MaxSize {
+		if err := f.rotate(); err != nil {
+			f.rotateFailed = true
+			logger.Errorw("Error rotating output file, rotation stopped until it's reopened", "path", f.path, "error", err)
+		}
+	}
+
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+
+	return n, err
+}
+
+// Reopen closes the current file and opens the file at the output path, creating it if
+// it doesn't exist
+func (f *outputFile) Reopen() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	file, err := os.OpenFile(f.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0666)
+	if err != nil {
+		return errfmt.Errorf("unable to reopen output file %q: %v", f.path, err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return errfmt.Errorf("unable to stat output file %q: %v", f.path, err)
+	}
+
+	if err := f.file.Close(); err != nil {
+		logger.Errorw("Error closing output file", "path", f.path, "error", err)
+	}
+	f.file = file
+	f.size = info.Size()
+	f.rotateFailed = false
+
+	return nil
+}
+
+// Close closes the current file and waits for the backups to be compressed
+func (f *outputFile) Close() error {
+	f.mu.Lock()
+	err := f.file.Close()
+	f.mu.Unlock()
+
+	f.backups.Wait()
+
+	return err
+}
+
+// rotate moves the current file to a backup and moves a new one to the output path. The
+// new file is opened first, so a failure leaves the current file in place, and the renames
+// are atomic, so the output path always refers to a complete file.
+func (f *outputFile) rotate() error {
+	next := f.path + ".next"
+	file, err := os.OpenFile(next, os.O_WRONLY|os.O_CREATE|os.O_TRUNC|os.O_APPEND, 0666)
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+
+	now := f.now()
+	backup := f.backupPath(now)
+	if err := os.Rename(f.path, backup); err != nil {
+		_ = file.Close()
+		_ = os.Remove(next)
+		return errfmt.WrapError(err)
+	}
+	if err := os.Rename(next, f.path); err != nil {
+		_ = file.Close()
+		_ = os.Remove(next)
+		if restoreErr := os.Rename(backup, f.path); restoreErr != nil {
+			// keep writing to the backup
+			logger.Errorw("Error restoring output file", "path", f.path, "error", restoreErr)
+		}
+		return errfmt.WrapError(err)
+	}
+
+	if err := f.file.Close(); err != nil {
+		logger.Errorw("Error closing output file", "path", backup, "error", err)
+	}
+	f.file = file
+	f.size = 0
+
+	f.backups.Add(1)
+	go func() {
+		defer f.backups.Done()
+		f.processBackup(backup, now)
+	}()
+
+	return nil
+}
+
+// backupPath returns the path of the file rotated at the given time:
+// /path/to/events.json -> /path/to/events-<time>.json
+func (f *outputFile) backupPath(t time.Time) string {
+	dir, base := filepath.Split(f.path)
+	ext := filepath.Ext(base)
+	name := strings.TrimSuffix(base, ext)
+
+	return filepath.Join(dir, name+"-"+t.UTC().Format(backupTimeFormat)+ext)
+}
+
+// processBackup compresses a rotated file and removes the backups exceeding the maximum
+// number or age at the rotation time
+func (f *outputFile) processBackup(backup string, now time.Time) {
+	f.backupsMu.Lock()
+	defer f.backupsMu.Unlock()
+
+	// the backup may have been pruned already by a later rotation
+	_, err := os.Stat(backup)
+	if err == nil && f.rotation.Compress != "" && f.rotation.Compress != "none" {
+		if err := compressFile(backup, f.rotation.Compress); err != nil {
+			logger.Errorw("Error compressing rotated output file", "path", backup, "error", err)
+		}
+	}
+
+	for _, backup := range f.expiredBackups(now) {
+		if err := os.Remove(backup); err != nil && !os.IsNotExist(err) {
+			logger.Errorw("Error removing rotated output file", "path", backup, "error", err)
+		}
+	}
+}
+
+// expiredBackups returns the backups to remove, the older ones exceeding the maximum
+// number and the ones exceeding the maximum age
+func (f *outputFile) expiredBackups(now time.Time) []string {
+	if f.rotation.MaxBackups <= 0 && f.rotation.MaxAge <= 0 {
+		return nil
+	}
+
+	type backup struct {
+		path string
+		time time.Time
+	}
+
+	dir, base := filepath.Split(f.path)
+	ext := filepath.Ext(base)
+	prefix := strings.TrimSuffix(base, ext) + "-"
+
+	entries, err := os.ReadDir(filepath.Clean(dir))
+	if err != nil {
+		logger.Errorw("Error listing rotated output files", "path", f.path, "error", err)
+		return nil
+	}
+
+	var backups []backup
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		stamp, ok := strings.CutSuffix(strings.TrimSuffix(strings.TrimSuffix(name, ".gz"), ".zst"), ext)
+		if !ok {
+			continue
+		}
+		t, err := time.Parse(backupTimeFormat, strings.TrimPrefix(stamp, prefix))
+		if err != nil {
+			continue
+		}
+		backups = append(backups, backup{path: filepath.Join(dir, name), time: t})
+	}
+
+	// newest first
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].time.After(backups[j].time)
+	})
+
+	var expired []string
+	for i, b := range backups {
+		if (f.rotation.MaxBackups > 0 && i >= f.rotation.MaxBackups) ||
+			(f.rotation.MaxAge > 0 && now.Sub(b.time) > f.rotation.MaxAge) {
+			expired = append(expired, b.path)
+		}
+	}
+
+	return expired
+}
+
+// compressFile compresses a file to path.gz or path.zst, and removes it. The compressed
+// file is written to a temporary file first so that it's never seen incomplete.
+func compressFile(path, compression string) error {
+	ext := ".gz"
+	if compression == "zstd" {
+		ext = ".zst"
+	}
+
+	in, err := os.Open(path)
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+	defer in.Close()
+
+	tmp := path + ext + ".tmp"
+	out, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0666)
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+
+	err = func() error {
+		var w io.WriteCloser
+		if compression == "zstd" {
+			if w, err = zstd.NewWriter(out); err != nil {
+				return err
+			}
+		} else {
+			w = gzip.NewWriter(out)
+		}
+		if _, err := io.Copy(w, in); err != nil {
+			return err
+		}
+		if err := w.Close(); err != nil {
+			return err
+		}
+		return out.Close()
+	}()
+	if err != nil {
+		_ = out.Close()
+		_ = os.Remove(tmp)
+		return errfmt.WrapError(err)
+	}
+
+	if err := os.Rename(tmp, path+ext); err != nil {
+		_ = os.Remove(tmp)
+		return errfmt.WrapError(err)
+	}
+
+	return errfmt.WrapError(os.Remove(path))
+}
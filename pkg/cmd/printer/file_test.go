@@ -0,0 +1,270 @@
+package printer
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/khulnasoft-lab/tracker/pkg/config"
+)
+
+func TestOutputFileRotation(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		rotation        config.FileRotation
+		writes          int
+		expectedBackups []string // file extensions of the backups, oldest first
+	}{
+		{
+			name:            "no rotation",
+			rotation:        config.FileRotation{},
+			writes:          5,
+			expectedBackups: nil,
+		},
+		{
+			name:            "rotation",
+			rotation:        config.FileRotation{MaxSize: 20},
+			writes:          5,
+			expectedBackups: []string{".json", ".json"},
+		},
+		{
+			name:            "rotation with max backups",
+			rotation:        config.FileRotation{MaxSize: 10, MaxBackups: 2},
+			writes:          5,
+			expectedBackups: []string{".json", ".json"},
+		},
+		{
+			name:            "rotation with gzip",
+			rotation:        config.FileRotation{MaxSize: 20, Compress: "gzip"},
+			writes:          5,
+			expectedBackups: []string{".json.gz", ".json.gz"},
+		},
+		{
+			name:            "rotation with zstd and max backups",
+			rotation:        config.FileRotation{MaxSize: 10, MaxBackups: 3, Compress: "zstd"},
+			writes:          5,
+			expectedBackups: []string{".json.zst", ".json.zst", ".json.zst"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			path := filepath.Join(dir, "events.json")
+			f := newTestOutputFile(t, path, tc.rotation)
+
+			var written []string
+			for i := 0; i < tc.writes; i++ {
+				line := strings.Repeat(string(rune('a'+i)), 9) + "\n"
+				written = append(written, line)
+				n, err := f.Write([]byte(line))
+				require.NoError(t, err)
+				assert.Equal(t, len(line), n)
+			}
+			require.NoError(t, f.Close())
+
+			backups := listBackups(t, dir)
+			require.Len(t, backups, len(tc.expectedBackups))
+			for i, backup := range backups {
+				assert.True(t, strings.HasSuffix(backup, tc.expectedBackups[i]), backup)
+			}
+
+			// the newest events are in the output file, preceded by the ones of the kept
+			// backups
+			var content string
+			for _, backup := range backups {
+				content += readOutputFile(t, filepath.Join(dir, backup))
+			}
+			content += readOutputFile(t, path)
+			assert.Equal(t, strings.Join(written[len(written)-len(content)/10:], ""), content)
+			if tc.rotation.MaxBackups == 0 {
+				assert.Equal(t, strings.Join(written, ""), content)
+			}
+		})
+	}
+}
+
+func TestOutputFileMaxAge(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "events.json")
+	f := newTestOutputFile(t, path, config.FileRotation{MaxSize: 10, MaxAge: time.Hour})
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	f.now = func() time.Time { return now }
+
+	_, err := f.Write([]byte("aaaaaaaaa\n"))
+	require.NoError(t, err)
+	_, err = f.Write([]byte("bbbbbbbbb\n")) // rotates a
+	require.NoError(t, err)
+	f.backups.Wait()
+	require.Len(t, listBackups(t, dir), 1)
+
+	now = now.Add(2 * time.Hour)
+	_, err = f.Write([]byte("ccccccccc\n")) // rotates b, a is too old
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	backups := listBackups(t, dir)
+	require.Len(t, backups, 1)
+	assert.Equal(t, "bbbbbbbbb\n", readOutputFile(t, filepath.Join(dir, backups[0])))
+	assert.Equal(t, "ccccccccc\n", readOutputFile(t, path))
+}
+
+func TestOutputFileReopen(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "events.json")
+	f := newTestOutputFile(t, path, config.FileRotation{})
+
+	_, err := f.Write([]byte("before\n"))
+	require.NoError(t, err)
+
+	// external rotation
+	rotated := path + ".1"
+	require.NoError(t, os.Rename(path, rotated))
+	_, err = f.Write([]byte("rotated\n"))
+	require.NoError(t, err)
+
+	require.NoError(t, f.Reopen())
+	_, err = f.Write([]byte("after\n"))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	assert.Equal(t, "before\nrotated\n", readOutputFile(t, rotated))
+	assert.Equal(t, "after\n", readOutputFile(t, path))
+}
+
+func TestOutputFileAppend(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "events.json")
+	require.NoError(t, os.WriteFile(path, []byte("previous\n"), 0644))
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	require.NoError(t, err)
+	f, err := newOutputFile(file, path, config.FileRotation{MaxSize: 15})
+	require.NoError(t, err)
+
+	// the size of the existing file is taken into account
+	_, err = f.Write([]byte("current\n"))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	backups := listBackups(t, dir)
+	require.Len(t, backups, 1)
+	assert.Equal(t, "previous\n", readOutputFile(t, filepath.Join(dir, backups[0])))
+	assert.Equal(t, "current\n", readOutputFile(t, path))
+}
+
+func TestOutputFileRotationFailure(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "events.json")
+	f := newTestOutputFile(t, path, config.FileRotation{MaxSize: 10})
+
+	// the new file can't be opened, since a directory is in its way
+	require.NoError(t, os.Mkdir(path+".next", 0755))
+
+	_, err := f.Write([]byte("aaaaaaaaa\n"))
+	require.NoError(t, err)
+	_, err = f.Write([]byte("bbbbbbbbb\n")) // rotation fails, the current file is kept
+	require.NoError(t, err)
+
+	// the rotation is not retried until the file is reopened
+	require.NoError(t, os.Remove(path+".next"))
+	_, err = f.Write([]byte("ccccccccc\n"))
+	require.NoError(t, err)
+	assert.Empty(t, listBackups(t, dir))
+	assert.Equal(t, "aaaaaaaaa\nbbbbbbbbb\nccccccccc\n", readOutputFile(t, path))
+
+	require.NoError(t, f.Reopen())
+	_, err = f.Write([]byte("ddddddddd\n"))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	assert.Len(t, listBackups(t, dir), 1)
+	assert.Equal(t, "ddddddddd\n", readOutputFile(t, path))
+}
+
+func newTestOutputFile(t *testing.T, path string, rotation config.FileRotation) *outputFile {
+	t.Helper()
+
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	f, err := newOutputFile(file, path, rotation)
+	require.NoError(t, err)
+
+	// distinct backup names for rotations in the same instant
+	var ticks time.Duration
+	now := time.Now()
+	f.now = func() time.Time {
+		ticks++
+		return now.Add(ticks)
+	}
+
+	return f
+}
+
+// listBackups returns the names of the rotated files, oldest first
+func listBackups(t *testing.T, dir string) []string {
+	t.Helper()
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+
+	var backups []string
+	for _, entry := range entries {
+		if entry.Name() != "events.json" {
+			backups = append(backups, entry.Name())
+		}
+	}
+	sort.Strings(backups)
+
+	return backups
+}
+
+// readOutputFile returns the decompressed content of an output file
+func readOutputFile(t *testing.T, path string) string {
+	t.Helper()
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var r io.Reader = file
+	switch filepath.Ext(path) {
+	case ".gz":
+		gz, err := gzip.NewReader(file)
+		require.NoError(t, err)
+		r = gz
+	case ".zst":
+		zr, err := zstd.NewReader(file)
+		require.NoError(t, err)
+		defer zr.Close()
+		r = zr
+	}
+
+	b, err := io.ReadAll(r)
+	require.NoError(t, err)
+
+	return string(b)
+}
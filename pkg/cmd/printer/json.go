@@ -0,0 +1,228 @@
+package printer
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/mennanov/fmutils"
+
+	"github.com/khulnasoft-lab/tracker/pkg/config"
+	"github.com/khulnasoft-lab/tracker/pkg/errfmt"
+	"github.com/khulnasoft-lab/tracker/types/trace"
+)
+
+var argumentsType = reflect.TypeOf([]trace.Argument{})
+
+// jsonShaper marshals events keeping the fields of a field mask, like the mask of the gRPC
+// StreamEvents but with the field names of the json output (e.g. container.id), and
+// omitting the fields with empty values if configured. The arguments are selected by
+// name (e.g. args.pathname), as are the keys of maps (e.g. metadata.Properties.Severity).
+type jsonShaper struct {
+	mask      fmutils.NestedMask
+	omitEmpty bool
+}
+
+func newJSONShaper(projection config.JSONProjection) (*jsonShaper, error) {
+	mask := fmutils.NestedMaskFromPaths(projection.Fields)
+	if err := validateJSONMask(reflect.TypeOf(trace.Event{}), mask, ""); err != nil {
+		return nil, err
+	}
+
+	return &jsonShaper{mask: mask, omitEmpty: projection.OmitEmpty}, nil
+}
+
+// validateJSONMask returns an error if a field of the mask isn't a json field of the type
+func validateJSONMask(t reflect.Type, mask fmutils.NestedMask, prefix string) error {
+	for name, subMask := range mask {
+		field, ok := findJSONField(t, name)
+		if !ok {
+			return errfmt.Errorf("invalid json field %q", prefix+name)
+		}
+		if len(subMask) == 0 {
+			continue
+		}
+
+		fieldType := t.Field(field.index).Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		switch {
+		case fieldType.Kind() == reflect.Struct:
+			if err := validateJSONMask(fieldType, subMask, prefix+name+"."); err != nil {
+				return err
+			}
+		case fieldType == argumentsType, fieldType.Kind() == reflect.Map && fieldType.Key().Kind() == reflect.String:
+			// arguments and map keys are only known at runtime
+		default:
+			return errfmt.Errorf("json field %q has no subfields", prefix+name)
+		}
+	}
+
+	return nil
+}
+
+func (s *jsonShaper) marshal(event *trace.Event) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := s.appendStruct(&buf, reflect.ValueOf(event).Elem(), s.mask); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (s *jsonShaper) appendStruct(buf *bytes.Buffer, v reflect.Value, mask fmutils.NestedMask) error {
+	buf.WriteByte('{')
+
+	first := true
+	for _, field := range jsonFields(v.Type()) {
+		subMask, ok := mask[field.name]
+		if len(mask) > 0 && !ok {
+			continue
+		}
+
+		fv := v.Field(field.index)
+		if (field.omitEmpty || s.omitEmpty) && isEmptyJSONValue(fv, s.omitEmpty) {
+			continue
+		}
+
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+
+		name, _ := json.Marshal(field.name)
+		buf.Write(name)
+		buf.WriteByte(':')
+		if err := s.appendValue(buf, fv, subMask, field.quoted); err != nil {
+			return err
+		}
+	}
+
+	buf.WriteByte('}')
+
+	return nil
+}
+
+func (s *jsonShaper) appendValue(buf *bytes.Buffer, v reflect.Value, mask fmutils.NestedMask, quoted bool) error {
+	if v.Kind() == reflect.Pointer && !v.IsNil() && v.Elem().Kind() == reflect.Struct {
+		v = v.Elem()
+	}
+
+	var value interface{}
+	switch {
+	case v.Kind() == reflect.Struct && (len(mask) > 0 || s.omitEmpty):
+		return s.appendStruct(buf, v, mask)
+	case v.Type() == argumentsType && len(mask) > 0:
+		args := []trace.Argument{}
+		for _, arg := range v.Interface().([]trace.Argument) {
+			if _, ok := mask[arg.Name]; ok {
+				args = append(args, arg)
+			}
+		}
+		value = args
+	case v.Kind() == reflect.Map && v.Type().Key().Kind() == reflect.String && len(mask) > 0:
+		m := reflect.MakeMap(v.Type())
+		for name := range mask {
+			key := reflect.ValueOf(name).Convert(v.Type().Key())
+			if mv := v.MapIndex(key); mv.IsValid() {
+				m.SetMapIndex(key, mv)
+			}
+		}
+		value = m.Interface()
+	default:
+		value = v.Interface()
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+	if quoted {
+		// the ",string" option of the field
+		b, _ = json.Marshal(string(b))
+	}
+	buf.Write(b)
+
+	return nil
+}
+
+// jsonField is a struct field as marshaled by encoding/json
+type jsonField struct {
+	index     int
+	name      string
+	omitEmpty bool
+	quoted    bool
+}
+
+var jsonFieldsCache sync.Map // reflect.Type -> []jsonField
+
+// jsonFields returns the json fields of a struct type, in the order of encoding/json
+func jsonFields(t reflect.Type) []jsonField {
+	if fields, ok := jsonFieldsCache.Load(t); ok {
+		return fields.([]jsonField)
+	}
+
+	var fields []jsonField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if !f.IsExported() || tag == "-" {
+			continue
+		}
+
+		name, options, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = f.Name
+		}
+		field := jsonField{index: i, name: name}
+		for _, option := range strings.Split(options, ",") {
+			switch option {
+			case "omitempty":
+				field.omitEmpty = true
+			case "string":
+				field.quoted = true
+			}
+		}
+		fields = append(fields, field)
+	}
+
+	jsonFieldsCache.Store(t, fields)
+
+	return fields
+}
+
+func findJSONField(t reflect.Type, name string) (jsonField, bool) {
+	for _, field := range jsonFields(t) {
+		if field.name == name {
+			return field, true
+		}
+	}
+
+	return jsonField{}, false
+}
+
+// isEmptyJSONValue returns true if the value is empty as defined by encoding/json for
+// omitempty fields, or if it's a zero struct and structs are included
+func isEmptyJSONValue(v reflect.Value, structs bool) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Pointer:
+		return v.IsNil()
+	case reflect.Struct:
+		return structs && v.IsZero()
+	}
+
+	return false
+}
@@ -0,0 +1,111 @@
+package printer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/khulnasoft-lab/tracker/pkg/config"
+	"github.com/khulnasoft-lab/tracker/types/trace"
+)
+
+func TestJSONShaper(t *testing.T) {
+	t.Parallel()
+
+	event := trace.Event{
+		Timestamp:       1700000000,
+		ThreadStartTime: 1600000000,
+		ProcessName:     "cat",
+		EventID:         257,
+		EventName:       "openat",
+		Container:       trace.Container{ID: "abc", ImageName: "ubuntu"},
+		StackAddresses:  []uint64{1, 2},
+		Args: []trace.Argument{
+			{ArgMeta: trace.ArgMeta{Name: "dirfd", Type: "int"}, Value: int32(-100)},
+			{ArgMeta: trace.ArgMeta{Name: "pathname", Type: "const char*"}, Value: "/etc/passwd"},
+		},
+		Metadata: &trace.Metadata{
+			Description: "finding",
+			Properties:  map[string]interface{}{"Severity": 2, "Category": "discovery"},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		projection config.JSONProjection
+		expected   string
+	}{
+		{
+			name:       "fields",
+			projection: config.JSONProjection{Fields: []string{"timestamp", "eventName", "processName", "eventId"}},
+			expected:   `{"timestamp":1700000000,"processName":"cat","eventId":"257","eventName":"openat"}`,
+		},
+		{
+			name:       "nested fields",
+			projection: config.JSONProjection{Fields: []string{"container.id", "args.pathname", "metadata.Properties.Severity"}},
+			expected: `{"container":{"id":"abc"},` +
+				`"args":[{"name":"pathname","type":"const char*","value":"/etc/passwd"}],` +
+				`"metadata":{"Properties":{"Severity":2}}}`,
+		},
+		{
+			name:       "fields with empty values",
+			projection: config.JSONProjection{Fields: []string{"eventName", "hostName", "kubernetes"}},
+			expected:   `{"hostName":"","kubernetes":{},"eventName":"openat"}`,
+		},
+		{
+			name:       "fields with omit empty",
+			projection: config.JSONProjection{Fields: []string{"eventName", "hostName", "kubernetes"}, OmitEmpty: true},
+			expected:   `{"eventName":"openat"}`,
+		},
+		{
+			name:       "omit empty",
+			projection: config.JSONProjection{OmitEmpty: true},
+			expected: `{"timestamp":1700000000,"threadStartTime":1600000000,"processName":"cat",` +
+				`"container":{"id":"abc","image":"ubuntu"},"eventId":"257","eventName":"openat",` +
+				`"stackAddresses":[1,2],` +
+				`"args":[{"name":"dirfd","type":"int","value":-100},{"name":"pathname","type":"const char*","value":"/etc/passwd"}],` +
+				`"metadata":{"Description":"finding","Properties":{"Category":"discovery","Severity":2}}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			shaper, err := newJSONShaper(tc.projection)
+			require.NoError(t, err)
+
+			b, err := shaper.marshal(&event)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, string(b))
+		})
+	}
+}
+
+func TestJSONShaperInvalidFields(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		fields        []string
+		expectedError string
+	}{
+		{[]string{"eventName", "foo"}, `invalid json field "foo"`},
+		{[]string{"container.foo"}, `invalid json field "container.foo"`},
+		{[]string{"eventName.foo"}, `json field "eventName" has no subfields`},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.expectedError, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := newJSONShaper(config.JSONProjection{Fields: tc.fields})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expectedError)
+		})
+	}
+}
@@ -0,0 +1,359 @@
+package printer
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sasl"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+	"github.com/twmb/franz-go/pkg/sasl/scram"
+
+	"github.com/khulnasoft-lab/tracker/pkg/counter"
+	"github.com/khulnasoft-lab/tracker/pkg/errfmt"
+	"github.com/khulnasoft-lab/tracker/pkg/logger"
+	"github.com/khulnasoft-lab/tracker/pkg/metrics"
+	"github.com/khulnasoft-lab/tracker/types/trace"
+)
+
+const (
+	kafkaClientID    = "tracker"
+	kafkaPasswordEnv = "TRACKER_KAFKA_PASSWORD"
+)
+
+// kafkaEventPrinter sends events, as JSON, to a Kafka topic. Events are buffered by the
+// kafka client, dropping them when the buffer is full so that a slow cluster doesn't
+// block the pipeline, batched by size and time, and retried on retriable broker errors.
+type kafkaEventPrinter struct {
+	outPath string
+
+	// These parameters can be set up from the URL
+	brokers []string
+	topic   string
+	key     string        // event field used as partition key
+	timeout time.Duration // dial, request and flush timeout
+
+	client    *kgo.Client
+	closeOnce sync.Once
+	failing   atomic.Bool // whether the last record failed, to log failures once
+	delivered counter.Counter
+	failed    counter.Counter
+	dropped   counter.Counter // events dropped because the buffer was full
+}
+
+func (p *kafkaEventPrinter) Init() error {
+	brokers, query, _ := strings.Cut(p.outPath, "?")
+	parameters, err := url.ParseQuery(query)
+	if err != nil {
+		return errfmt.Errorf("unable to parse kafka parameters %q: %v", query, err)
+	}
+
+	for _, broker := range strings.Split(brokers, ",") {
+		if _, _, err := net.SplitHostPort(broker); err != nil {
+			return errfmt.Errorf("invalid kafka broker %q: %v", broker, err)
+		}
+		p.brokers = append(p.brokers, broker)
+	}
+
+	p.topic = getParameterValue(parameters, "topic", "")
+	if p.topic == "" {
+		return errfmt.Errorf("kafka topic is not set")
+	}
+
+	p.key = getParameterValue(parameters, "key", "none")
+	switch p.key {
+	case "none", "containerId", "hostName", "eventName":
+	default:
+		return errfmt.Errorf("invalid kafka key %q, use one of none, containerId, hostName or eventName", p.key)
+	}
+
+	opts, err := p.clientOptions(parameters)
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+	opts = append(opts,
+		kgo.SeedBrokers(p.brokers...),
+		kgo.DefaultProduceTopic(p.topic),
+		kgo.ClientID(kafkaClientID),
+	)
+
+	p.client, err = kgo.NewClient(opts...)
+	if err != nil {
+		return errfmt.Errorf("unable to create kafka client: %v", err)
+	}
+
+	// The brokers may not be available but may appear later so do not return an error here
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+	if err := p.client.Ping(ctx); err != nil {
+		logger.Errorw("Error connecting to Kafka", "brokers", brokers, "topic", p.topic, "error", err)
+	}
+
+	return nil
+}
+
+// clientOptions returns the options of the kafka client set from the URL parameters
+func (p *kafkaEventPrinter) clientOptions(parameters url.Values) ([]kgo.Opt, error) {
+	compression := getParameterValue(parameters, "compression", "none")
+	codec, err := parseKafkaCompression(compression)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []kgo.Opt{kgo.ProducerBatchCompression(codec)}
+
+	batchBytes := getParameterValue(parameters, "batchBytes", "1000000")
+	maxBytes, err := strconv.ParseInt(batchBytes, 10, 32)
+	if err != nil || maxBytes <= 0 {
+		return nil, errfmt.Errorf("invalid kafka batchBytes value %q", batchBytes)
+	}
+	opts = append(opts, kgo.ProducerBatchMaxBytes(int32(maxBytes)))
+
+	batchTimeout := getParameterValue(parameters, "batchTimeout", "1s")
+	linger, err := time.ParseDuration(batchTimeout)
+	if err != nil || linger <= 0 {
+		return nil, errfmt.Errorf("invalid kafka batchTimeout value %q", batchTimeout)
+	}
+	opts = append(opts, kgo.ProducerLinger(linger))
+
+	retries := getParameterValue(parameters, "retries", "5")
+	n, err := strconv.Atoi(retries)
+	if err != nil || n < 0 {
+		return nil, errfmt.Errorf("invalid kafka retries value %q", retries)
+	}
+	opts = append(opts, kgo.RecordRetries(n))
+
+	// idempotent writes require the acknowledgment of all replicas
+	acks := getParameterValue(parameters, "acks", "1")
+	switch acks {
+	case "0":
+		opts = append(opts, kgo.RequiredAcks(kgo.NoAck()), kgo.DisableIdempotentWrite())
+	case "1":
+		opts = append(opts, kgo.RequiredAcks(kgo.LeaderAck()), kgo.DisableIdempotentWrite())
+	case "-1", "all":
+		opts = append(opts, kgo.RequiredAcks(kgo.AllISRAcks()))
+	default:
+		return nil, errfmt.Errorf("invalid kafka acks value %q, use one of 0, 1 or all", acks)
+	}
+
+	timeout := getParameterValue(parameters, "timeout", "10s")
+	p.timeout, err = time.ParseDuration(timeout)
+	if err != nil || p.timeout <= 0 {
+		return nil, errfmt.Errorf("invalid kafka timeout value %q", timeout)
+	}
+	opts = append(opts, kgo.DialTimeout(p.timeout), kgo.ProduceRequestTimeout(p.timeout))
+
+	queueSize := getParameterValue(parameters, "queueSize", "10000")
+	size, err := strconv.Atoi(queueSize)
+	if err != nil || size <= 0 {
+		return nil, errfmt.Errorf("invalid kafka queueSize value %q", queueSize)
+	}
+	opts = append(opts, kgo.MaxBufferedRecords(size))
+
+	tlsConfig, err := kafkaTLSConfig(parameters)
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig != nil {
+		opts = append(opts, kgo.DialTLSConfig(tlsConfig))
+	}
+
+	mechanism, err := kafkaSASLMechanism(parameters)
+	if err != nil {
+		return nil, err
+	}
+	if mechanism != nil {
+		opts = append(opts, kgo.SASL(mechanism))
+	}
+
+	return opts, nil
+}
+
+func parseKafkaCompression(codec string) (kgo.CompressionCodec, error) {
+	switch codec {
+	case "none":
+		return kgo.NoCompression(), nil
+	case "gzip":
+		return kgo.GzipCompression(), nil
+	case "snappy":
+		return kgo.SnappyCompression(), nil
+	case "lz4":
+		return kgo.Lz4Compression(), nil
+	case "zstd":
+		return kgo.ZstdCompression(), nil
+	}
+
+	return kgo.CompressionCodec{}, errfmt.Errorf("unsupported compression %q, use one of none, gzip, snappy, lz4 or zstd", codec)
+}
+
+// kafkaTLSConfig returns the TLS configuration of the broker connections, or nil if TLS
+// isn't enabled. Setting tlsCA or a client certificate enables TLS.
+func kafkaTLSConfig(parameters url.Values) (*tls.Config, error) {
+	cert := getParameterValue(parameters, "tlsCert", "")
+	key := getParameterValue(parameters, "tlsKey", "")
+	ca := getParameterValue(parameters, "tlsCA", "")
+
+	enabled := getParameterValue(parameters, "tls", "false")
+	switch enabled {
+	case "true":
+	case "false":
+		if cert == "" && key == "" && ca == "" {
+			return nil, nil
+		}
+	default:
+		return nil, errfmt.Errorf("invalid kafka tls value %q, use true or false", enabled)
+	}
+
+	tlsConfig := &tls.Config{}
+
+	if cert != "" || key != "" {
+		if cert == "" || key == "" {
+			return nil, errfmt.Errorf("kafka client certificate requires both tlsCert and tlsKey")
+		}
+		certificate, err := tls.LoadX509KeyPair(cert, key)
+		if err != nil {
+			return nil, errfmt.Errorf("unable to load kafka client certificate: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	if ca != "" {
+		pem, err := os.ReadFile(ca)
+		if err != nil {
+			return nil, errfmt.Errorf("unable to read kafka CA file %q: %v", ca, err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errfmt.Errorf("no certificates found in kafka CA file %q", ca)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return tlsConfig, nil
+}
+
+// kafkaSASLMechanism returns the SASL mechanism authenticating the broker connections,
+// or nil if SASL isn't enabled. The password is read from passwordFile or, if it's not
+// set, from the TRACKER_KAFKA_PASSWORD environment variable, so that it's not part of
+// the URL.
+func kafkaSASLMechanism(parameters url.Values) (sasl.Mechanism, error) {
+	mechanism := getParameterValue(parameters, "sasl", "")
+	if mechanism == "" {
+		return nil, nil
+	}
+	switch mechanism {
+	case "plain", "scram-sha-256", "scram-sha-512":
+	default:
+		return nil, errfmt.Errorf("unsupported sasl mechanism %q, use one of plain, scram-sha-256 or scram-sha-512", mechanism)
+	}
+
+	user := getParameterValue(parameters, "user", "")
+	if user == "" {
+		return nil, errfmt.Errorf("kafka sasl requires a user")
+	}
+
+	var password string
+	if passwordFile := getParameterValue(parameters, "passwordFile", ""); passwordFile != "" {
+		var err error
+		if password, err = readSecretFile(passwordFile); err != nil {
+			return nil, errfmt.Errorf("unable to read kafka password file %q: %v", passwordFile, err)
+		}
+	} else {
+		password = os.Getenv(kafkaPasswordEnv)
+	}
+	if password == "" {
+		return nil, errfmt.Errorf("kafka sasl requires a password, set passwordFile or %s", kafkaPasswordEnv)
+	}
+
+	switch mechanism {
+	case "scram-sha-256":
+		return scram.Auth{User: user, Pass: password}.AsSha256Mechanism(), nil
+	case "scram-sha-512":
+		return scram.Auth{User: user, Pass: password}.AsSha512Mechanism(), nil
+	}
+
+	return plain.Auth{User: user, Pass: password}.AsMechanism(), nil
+}
+
+func (p *kafkaEventPrinter) Preamble() {}
+
+func (p *kafkaEventPrinter) Print(event trace.Event) {
+	value, err := json.Marshal(event)
+	if err != nil {
+		logger.Errorw("Error marshaling event to json", "error", err)
+		_ = p.failed.Increment()
+		return
+	}
+
+	var key string
+	switch p.key {
+	case "containerId":
+		key = event.Container.ID
+	case "hostName":
+		key = event.HostName
+	case "eventName":
+		key = event.EventName
+	}
+
+	record := &kgo.Record{Value: value}
+	if key != "" {
+		record.Key = []byte(key)
+	}
+	p.client.TryProduce(context.Background(), record, p.promise)
+}
+
+// promise counts the delivery of a record. Records of the same batch fail together,
+// so only the first failure after a delivery is logged.
+func (p *kafkaEventPrinter) promise(_ *kgo.Record, err error) {
+	switch {
+	case err == nil:
+		p.failing.Store(false)
+		_ = p.delivered.Increment()
+	case errors.Is(err, kgo.ErrMaxBuffered):
+		_ = p.dropped.Increment()
+	default:
+		if !p.failing.Swap(true) {
+			logger.Errorw("Error writing to Kafka", "topic", p.topic, "error", err)
+		}
+		_ = p.failed.Increment()
+	}
+}
+
+// Epilogue flushes the pending events and reports how many were delivered
+func (p *kafkaEventPrinter) Epilogue(stats metrics.Stats) {
+	p.close()
+	logger.Infow("Kafka output",
+		"topic", p.topic,
+		"delivered", p.delivered.Get(),
+		"failed", p.failed.Get(),
+		"dropped", p.dropped.Get(),
+	)
+}
+
+func (p *kafkaEventPrinter) Close() {
+	p.close()
+}
+
+// close waits for the buffered events to be delivered, or to fail, up to the timeout,
+// and closes the client, failing the events still buffered
+func (p *kafkaEventPrinter) close() {
+	p.closeOnce.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+		defer cancel()
+		if err := p.client.Flush(ctx); err != nil {
+			logger.Errorw("Error flushing Kafka events", "topic", p.topic, "error", err)
+		}
+		p.client.Close()
+	})
+}
@@ -0,0 +1,120 @@
+package printer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/khulnasoft-lab/tracker/pkg/metrics"
+	"github.com/khulnasoft-lab/tracker/types/trace"
+)
+
+func TestKafkaEventPrinterDropped(t *testing.T) {
+	t.Parallel()
+
+	// the broker is not reachable, so the first event stays buffered while it's retried,
+	// and the next ones are dropped instead of blocking the pipeline
+	p := &kafkaEventPrinter{
+		outPath: "127.0.0.1:1?topic=tracker-events&queueSize=1&timeout=100ms",
+	}
+	require.NoError(t, p.Init())
+
+	p.Preamble()
+	p.Print(trace.Event{EventName: "openat"})
+	p.Print(trace.Event{EventName: "execve"})
+	p.Print(trace.Event{EventName: "connect"})
+	assert.Eventually(t, func() bool {
+		return p.dropped.Get() == 2
+	}, time.Second, 10*time.Millisecond)
+
+	p.Epilogue(metrics.Stats{})
+	p.Close()
+
+	assert.Equal(t, uint64(0), p.delivered.Get())
+	assert.Equal(t, uint64(1), p.failed.Get())
+	assert.Equal(t, uint64(2), p.dropped.Get())
+}
+
+func TestKafkaEventPrinterInit(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		outPath       string
+		expectedError string
+	}{
+		{
+			name:          "invalid broker",
+			outPath:       "localhost?topic=events",
+			expectedError: "invalid kafka broker \"localhost\"",
+		},
+		{
+			name:          "missing topic",
+			outPath:       "localhost:9092",
+			expectedError: "kafka topic is not set",
+		},
+		{
+			name:          "invalid key",
+			outPath:       "localhost:9092?topic=events&key=pid",
+			expectedError: "invalid kafka key \"pid\"",
+		},
+		{
+			name:          "invalid compression",
+			outPath:       "localhost:9092?topic=events&compression=brotli",
+			expectedError: "unsupported compression \"brotli\"",
+		},
+		{
+			name:          "invalid acks",
+			outPath:       "localhost:9092?topic=events&acks=2",
+			expectedError: "invalid kafka acks value \"2\"",
+		},
+		{
+			name:          "invalid batch bytes",
+			outPath:       "localhost:9092?topic=events&batchBytes=0",
+			expectedError: "invalid kafka batchBytes value \"0\"",
+		},
+		{
+			name:          "invalid queue size",
+			outPath:       "localhost:9092?topic=events&queueSize=0",
+			expectedError: "invalid kafka queueSize value \"0\"",
+		},
+		{
+			name:          "invalid tls",
+			outPath:       "localhost:9092?topic=events&tls=yes",
+			expectedError: "invalid kafka tls value \"yes\"",
+		},
+		{
+			name:          "client certificate without key",
+			outPath:       "localhost:9092?topic=events&tlsCert=/tmp/cert.pem",
+			expectedError: "kafka client certificate requires both tlsCert and tlsKey",
+		},
+		{
+			name:          "invalid sasl mechanism",
+			outPath:       "localhost:9092?topic=events&sasl=gssapi&user=tracker",
+			expectedError: "unsupported sasl mechanism \"gssapi\"",
+		},
+		{
+			name:          "sasl without user",
+			outPath:       "localhost:9092?topic=events&sasl=plain",
+			expectedError: "kafka sasl requires a user",
+		},
+		{
+			name:          "sasl password file not found",
+			outPath:       "localhost:9092?topic=events&sasl=plain&user=tracker&passwordFile=/nonexistent/password",
+			expectedError: "unable to read kafka password file \"/nonexistent/password\"",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := &kafkaEventPrinter{outPath: tc.outPath}
+			assert.ErrorContains(t, p.Init(), tc.expectedError)
+		})
+	}
+}
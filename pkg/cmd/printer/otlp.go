@@ -0,0 +1,521 @@
+package printer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/khulnasoft-lab/tracker/pkg/counter"
+	"github.com/khulnasoft-lab/tracker/pkg/errfmt"
+	"github.com/khulnasoft-lab/tracker/pkg/events/parse"
+	"github.com/khulnasoft-lab/tracker/pkg/logger"
+	"github.com/khulnasoft-lab/tracker/pkg/metrics"
+	"github.com/khulnasoft-lab/tracker/pkg/version"
+	"github.com/khulnasoft-lab/tracker/types/trace"
+)
+
+const (
+	otlpBufferSize     = 10000
+	otlpRetryBackoff   = 100 * time.Millisecond
+	otlpMaxRetryDelay  = 5 * time.Second
+	otlpDefaultLogPath = "/v1/logs"
+)
+
+// otlpEventPrinter exports events as OpenTelemetry log records to an OTLP receiver, over
+// gRPC (otlp) or HTTP (otlp-http). Events are batched by size and time, and the batches
+// failing with retriable errors are retried.
+type otlpEventPrinter struct {
+	outPath    string
+	http       bool
+	relativeTS bool
+
+	// These parameters can be set up from the URL
+	endpoint     string // host:port for gRPC, url for HTTP
+	tlsConfig    *tls.Config
+	batchSize    int
+	batchTimeout time.Duration
+	retries      int
+	timeout      time.Duration
+
+	conn       *grpc.ClientConn
+	client     collogspb.LogsServiceClient
+	httpClient *http.Client
+	batcher    *batcher[otlpLogRecord]
+	delivered  counter.Counter
+	failed     counter.Counter
+}
+
+func (p *otlpEventPrinter) Init() error {
+	u, err := url.Parse(p.outPath)
+	if err != nil {
+		return errfmt.Errorf("unable to parse URL %q: %v", p.outPath, err)
+	}
+	parameters, _ := url.ParseQuery(u.RawQuery)
+
+	secure := false
+	defaultPort := "4317"
+	if p.http {
+		defaultPort = "4318"
+		switch u.Scheme {
+		case "https":
+			secure = true
+		case "http":
+		default:
+			return errfmt.Errorf("unsupported protocol for OTLP HTTP destination: %s", u.Scheme)
+		}
+	} else {
+		switch u.Scheme {
+		case "grpcs":
+			secure = true
+		case "grpc":
+		default:
+			return errfmt.Errorf("unsupported protocol for OTLP destination: %s", u.Scheme)
+		}
+	}
+	if u.Hostname() == "" {
+		return errfmt.Errorf("OTLP host is not set")
+	}
+	if u.Port() == "" {
+		u.Host = net.JoinHostPort(u.Hostname(), defaultPort)
+	}
+
+	if secure {
+		p.tlsConfig = &tls.Config{ServerName: u.Hostname()}
+		ca := getParameterValue(parameters, "ca", "")
+		if ca != "" {
+			pem, err := os.ReadFile(ca)
+			if err != nil {
+				return errfmt.Errorf("unable to read OTLP CA file %q: %v", ca, err)
+			}
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(pem) {
+				return errfmt.Errorf("no certificates found in OTLP CA file %q", ca)
+			}
+			p.tlsConfig.RootCAs = pool
+		}
+	}
+
+	batchSize := getParameterValue(parameters, "batchSize", "512")
+	p.batchSize, err = strconv.Atoi(batchSize)
+	if err != nil || p.batchSize <= 0 {
+		return errfmt.Errorf("invalid OTLP batchSize value %q", batchSize)
+	}
+
+	batchTimeout := getParameterValue(parameters, "batchTimeout", "1s")
+	p.batchTimeout, err = time.ParseDuration(batchTimeout)
+	if err != nil || p.batchTimeout <= 0 {
+		return errfmt.Errorf("invalid OTLP batchTimeout value %q", batchTimeout)
+	}
+
+	retries := getParameterValue(parameters, "retries", "5")
+	p.retries, err = strconv.Atoi(retries)
+	if err != nil || p.retries < 0 {
+		return errfmt.Errorf("invalid OTLP retries value %q", retries)
+	}
+
+	timeout := getParameterValue(parameters, "timeout", "10s")
+	p.timeout, err = time.ParseDuration(timeout)
+	if err != nil || p.timeout <= 0 {
+		return errfmt.Errorf("invalid OTLP timeout value %q", timeout)
+	}
+
+	if p.http {
+		if u.Path == "" || u.Path == "/" {
+			u.Path = otlpDefaultLogPath
+		}
+		u.RawQuery = ""
+		p.endpoint = u.String()
+		p.httpClient = &http.Client{
+			Timeout:   p.timeout,
+			Transport: &http.Transport{TLSClientConfig: p.tlsConfig},
+		}
+	} else {
+		p.endpoint = u.Host
+		creds := insecure.NewCredentials()
+		if p.tlsConfig != nil {
+			creds = credentials.NewTLS(p.tlsConfig)
+		}
+		// the connection is established lazily, so the receiver may appear later
+		p.conn, err = grpc.NewClient(p.endpoint, grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return errfmt.Errorf("unable to create OTLP client for %q: %v", p.endpoint, err)
+		}
+		p.client = collogspb.NewLogsServiceClient(p.conn)
+	}
+
+	p.batcher = newBatcher(p.batchSize, p.batchTimeout, otlpBufferSize, p.flush)
+
+	return nil
+}
+
+func (p *otlpEventPrinter) Preamble() {}
+
+func (p *otlpEventPrinter) Print(event trace.Event) {
+	now := time.Now()
+	timestamp := now
+	if !p.relativeTS {
+		timestamp = time.Unix(0, int64(event.Timestamp))
+	}
+
+	p.batcher.add(otlpLogRecord{
+		resource: otlpResource(event),
+		record:   otlpLogRecordFrom(event, timestamp, now),
+	})
+}
+
+// Epilogue flushes the pending events and reports how many were delivered
+func (p *otlpEventPrinter) Epilogue(stats metrics.Stats) {
+	p.batcher.stop()
+	logger.Infow("OTLP output",
+		"endpoint", p.endpoint,
+		"delivered", p.delivered.Get(),
+		"failed", p.failed.Get(),
+	)
+}
+
+func (p *otlpEventPrinter) Close() {
+	p.batcher.stop()
+	if p.conn != nil {
+		if err := p.conn.Close(); err != nil {
+			logger.Errorw("Closing OTLP connection", "error", err)
+		}
+	}
+}
+
+// flush exports a batch of events, retrying on retriable errors
+func (p *otlpEventPrinter) flush(batch []otlpLogRecord) {
+	req := otlpRequest(batch)
+
+	var err error
+	for attempt := 0; attempt <= p.retries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(min(otlpRetryBackoff<<(attempt-1), otlpMaxRetryDelay))
+		}
+
+		var resp *collogspb.ExportLogsServiceResponse
+		resp, err = p.export(req)
+		if err == nil {
+			partial := resp.GetPartialSuccess()
+			rejected := min(max(partial.GetRejectedLogRecords(), 0), int64(len(batch)))
+			if rejected > 0 {
+				logger.Errorw("OTLP destination rejected events", "endpoint", p.endpoint, "events", rejected, "error", partial.GetErrorMessage())
+				_ = p.failed.Increment(uint64(rejected))
+			}
+			_ = p.delivered.Increment(uint64(int64(len(batch)) - rejected))
+			return
+		}
+		if !otlpRetriable(err) {
+			break
+		}
+	}
+
+	logger.Errorw("Error exporting to OTLP destination", "endpoint", p.endpoint, "events", len(batch), "error", err)
+	_ = p.failed.Increment(uint64(len(batch)))
+}
+
+// export sends an export request and returns the response
+func (p *otlpEventPrinter) export(req *collogspb.ExportLogsServiceRequest) (*collogspb.ExportLogsServiceResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+
+	if !p.http {
+		return p.client.Export(ctx, req)
+	}
+
+	body, err := proto.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/x-protobuf")
+
+	httpResp, err := p.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	body, err = io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, otlpHTTPError(httpResp.StatusCode)
+	}
+
+	// the events were accepted, even if the response can't be decoded
+	resp := &collogspb.ExportLogsServiceResponse{}
+	if err := proto.Unmarshal(body, resp); err != nil {
+		logger.Errorw("Error decoding OTLP response", "error", err)
+	}
+
+	return resp, nil
+}
+
+// otlpHTTPError is the status code of a failed OTLP HTTP request
+type otlpHTTPError int
+
+func (e otlpHTTPError) Error() string {
+	return fmt.Sprintf("http status: %d", int(e))
+}
+
+// otlpRetriable returns true if an export failing with err should be retried, as defined
+// by the OTLP specification
+func otlpRetriable(err error) bool {
+	var httpErr otlpHTTPError
+	if errors.As(err, &httpErr) {
+		switch int(httpErr) {
+		case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+
+	if s, ok := status.FromError(err); ok {
+		switch s.Code() {
+		case codes.Canceled, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted,
+			codes.OutOfRange, codes.Unavailable, codes.DataLoss:
+			return true
+		}
+		return false
+	}
+
+	// network errors
+	return true
+}
+
+// otlpLogRecord is an event ready to be exported, along with its resource
+type otlpLogRecord struct {
+	resource otlpAttributes
+	record   *logspb.LogRecord
+}
+
+// otlpAttributes are the attributes of a resource or of a log record, in order
+type otlpAttributes []*commonpb.KeyValue
+
+// add adds an attribute, skipping empty strings
+func (a *otlpAttributes) add(key string, value interface{}) {
+	if s, ok := value.(string); ok && s == "" {
+		return
+	}
+	*a = append(*a, &commonpb.KeyValue{Key: key, Value: otlpAnyValue(value)})
+}
+
+// key identifies the attributes of a resource
+func (a otlpAttributes) key() string {
+	var b strings.Builder
+	for _, kv := range a {
+		fmt.Fprintf(&b, "%q=%q;", kv.Key, kv.Value.GetStringValue())
+	}
+	return b.String()
+}
+
+// otlpAnyValue converts a value to an AnyValue, falling back to its string representation
+func otlpAnyValue(value interface{}) *commonpb.AnyValue {
+	intValue := func(v int64) *commonpb.AnyValue {
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: v}}
+	}
+
+	switch v := value.(type) {
+	case string:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: v}}
+	case bool:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_BoolValue{BoolValue: v}}
+	case int:
+		return intValue(int64(v))
+	case int8:
+		return intValue(int64(v))
+	case int16:
+		return intValue(int64(v))
+	case int32:
+		return intValue(int64(v))
+	case int64:
+		return intValue(v)
+	case uint8:
+		return intValue(int64(v))
+	case uint16:
+		return intValue(int64(v))
+	case uint32:
+		return intValue(int64(v))
+	case uint64:
+		if v > math.MaxInt64 {
+			return otlpAnyValue(strconv.FormatUint(v, 10))
+		}
+		return intValue(int64(v))
+	case uint:
+		return otlpAnyValue(uint64(v))
+	case float32:
+		return otlpAnyValue(float64(v))
+	case float64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: v}}
+	case []string:
+		values := make([]*commonpb.AnyValue, 0, len(v))
+		for _, s := range v {
+			values = append(values, otlpAnyValue(s))
+		}
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_ArrayValue{ArrayValue: &commonpb.ArrayValue{Values: values}}}
+	case nil:
+		return &commonpb.AnyValue{}
+	}
+
+	return otlpAnyValue(fmt.Sprintf("%v", value))
+}
+
+// otlpResource returns the resource attributes of an event, including its labels
+func otlpResource(event trace.Event) otlpAttributes {
+	var attrs otlpAttributes
+	attrs.add("service.name", "tracker")
+	attrs.add("host.name", event.HostName)
+	attrs.add("container.id", event.Container.ID)
+	attrs.add("container.name", event.Container.Name)
+	attrs.add("container.image.name", event.Container.ImageName)
+	attrs.add("k8s.pod.name", event.Kubernetes.PodName)
+	attrs.add("k8s.pod.uid", event.Kubernetes.PodUID)
+	attrs.add("k8s.namespace.name", event.Kubernetes.PodNamespace)
+
+	// static labels, sorted as events are grouped by their resource
+	keys := make([]string, 0, len(event.Labels))
+	for key := range event.Labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		attrs.add(key, event.Labels[key])
+	}
+
+	return attrs
+}
+
+// otlpLogRecordFrom converts an event to a LogRecord. The event name is the body, and the
+// event context and arguments are attributes.
+func otlpLogRecordFrom(event trace.Event, timestamp, observed time.Time) *logspb.LogRecord {
+	var attrs otlpAttributes
+	attrs.add("event.name", event.EventName)
+	attrs.add("tracker.event.id", event.EventID)
+	attrs.add("process.pid", event.HostProcessID)
+	attrs.add("process.parent_pid", event.HostParentProcessID)
+	attrs.add("thread.id", event.HostThreadID)
+	attrs.add("process.executable.name", event.ProcessName)
+	attrs.add("process.executable.path", event.Executable.Path)
+	attrs.add("tracker.process.namespace_pid", event.ProcessID)
+	attrs.add("tracker.user.id", event.UserID)
+	attrs.add("tracker.mount_namespace", event.MountNS)
+	attrs.add("tracker.pid_namespace", event.PIDNS)
+	attrs.add("tracker.syscall", event.Syscall)
+	attrs.add("tracker.return_value", event.ReturnValue)
+	if len(event.MatchedPolicies) > 0 {
+		attrs.add("tracker.matched_policies", event.MatchedPolicies)
+	}
+	for _, arg := range event.Args {
+		attrs.add("tracker.args."+arg.Name, arg.Value)
+	}
+
+	if event.Metadata != nil {
+		props := event.Metadata.Properties
+		for _, p := range []struct{ key, prop string }{
+			{"tracker.signature.id", "signatureID"},
+			{"tracker.signature.name", "signatureName"},
+			{"tracker.signature.category", "Category"},
+			{"threat.technique.id", "external_id"},
+			{"threat.technique.name", "Technique"},
+		} {
+			if v, ok := props[p.prop].(string); ok {
+				attrs.add(p.key, v)
+			}
+		}
+	}
+
+	severityNumber, severityText := otlpSeverity(event)
+
+	return &logspb.LogRecord{
+		TimeUnixNano:         uint64(timestamp.UnixNano()),
+		ObservedTimeUnixNano: uint64(observed.UnixNano()),
+		SeverityNumber:       severityNumber,
+		SeverityText:         severityText,
+		Body:                 otlpAnyValue(event.EventName),
+		Attributes:           attrs,
+	}
+}
+
+// otlpSeverity maps the severity of a finding (0 to 4) to the OTLP severity, other events
+// are informational
+func otlpSeverity(event trace.Event) (logspb.SeverityNumber, string) {
+	severity, ok := parse.FindingSeverity(event)
+	if !ok {
+		return logspb.SeverityNumber_SEVERITY_NUMBER_INFO, "INFO"
+	}
+
+	switch {
+	case severity <= 0:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_INFO, "INFO"
+	case severity == 1:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_WARN, "LOW"
+	case severity == 2:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_WARN3, "MEDIUM"
+	case severity == 3:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_ERROR, "HIGH"
+	}
+
+	return logspb.SeverityNumber_SEVERITY_NUMBER_FATAL, "CRITICAL"
+}
+
+// otlpRequest builds an ExportLogsServiceRequest of the records, grouping them by resource
+func otlpRequest(records []otlpLogRecord) *collogspb.ExportLogsServiceRequest {
+	scope := &commonpb.InstrumentationScope{
+		Name:    "tracker",
+		Version: version.GetVersion(),
+	}
+
+	groups := map[string]*logspb.ResourceLogs{}
+	for _, r := range records {
+		key := r.resource.key()
+		g, ok := groups[key]
+		if !ok {
+			g = &logspb.ResourceLogs{
+				Resource:  &resourcepb.Resource{Attributes: r.resource},
+				ScopeLogs: []*logspb.ScopeLogs{{Scope: scope}},
+			}
+			groups[key] = g
+		}
+		g.ScopeLogs[0].LogRecords = append(g.ScopeLogs[0].LogRecords, r.record)
+	}
+	keys := make([]string, 0, len(groups))
+	for k := range groups {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	req := &collogspb.ExportLogsServiceRequest{}
+	for _, k := range keys {
+		req.ResourceLogs = append(req.ResourceLogs, groups[k])
+	}
+
+	return req
+}
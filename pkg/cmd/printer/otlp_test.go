@@ -0,0 +1,375 @@
+package printer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/khulnasoft-lab/tracker/pkg/metrics"
+	"github.com/khulnasoft-lab/tracker/types/trace"
+)
+
+// otlpReceiver is an OTLP logs receiver stub, over gRPC or HTTP, keeping the received log
+// records
+type otlpReceiver struct {
+	collogspb.UnimplementedLogsServiceServer
+
+	t        *testing.T
+	endpoint string
+
+	mu       sync.Mutex
+	fail     int // requests to fail
+	rejected int64
+	records  []otlpReceivedRecord
+}
+
+type otlpReceivedRecord struct {
+	resource   map[string]interface{}
+	severity   logspb.SeverityNumber
+	severityTx string
+	body       string
+	attributes map[string]interface{}
+}
+
+func newOTLPGRPCReceiver(t *testing.T) *otlpReceiver {
+	r := &otlpReceiver{t: t}
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	r.endpoint = "grpc://" + l.Addr().String()
+
+	server := grpc.NewServer()
+	collogspb.RegisterLogsServiceServer(server, r)
+	go func() { _ = server.Serve(l) }()
+	t.Cleanup(server.Stop)
+
+	return r
+}
+
+func newOTLPHTTPReceiver(t *testing.T) *otlpReceiver {
+	r := &otlpReceiver{t: t}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, otlpDefaultLogPath, req.URL.Path)
+		assert.Equal(t, "application/x-protobuf", req.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		exportReq := &collogspb.ExportLogsServiceRequest{}
+		require.NoError(t, proto.Unmarshal(body, exportReq))
+
+		resp, fail := r.receive(exportReq)
+		if fail {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, err = proto.Marshal(resp)
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+	r.endpoint = server.URL
+
+	return r
+}
+
+func (r *otlpReceiver) Export(_ context.Context, req *collogspb.ExportLogsServiceRequest) (*collogspb.ExportLogsServiceResponse, error) {
+	resp, fail := r.receive(req)
+	if fail {
+		return nil, status.Error(codes.Unavailable, "unavailable")
+	}
+	return resp, nil
+}
+
+// receive keeps the records of an export request and returns the response, or true if
+// the request should fail
+func (r *otlpReceiver) receive(req *collogspb.ExportLogsServiceRequest) (*collogspb.ExportLogsServiceResponse, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.fail > 0 {
+		r.fail--
+		return nil, true
+	}
+
+	for _, resourceLogs := range req.GetResourceLogs() {
+		resource := otlpReceivedAttributes(resourceLogs.GetResource().GetAttributes())
+		for _, scopeLogs := range resourceLogs.GetScopeLogs() {
+			assert.Equal(r.t, "tracker", scopeLogs.GetScope().GetName())
+			for _, record := range scopeLogs.GetLogRecords() {
+				r.records = append(r.records, otlpReceivedRecord{
+					resource:   resource,
+					severity:   record.GetSeverityNumber(),
+					severityTx: record.GetSeverityText(),
+					body:       record.GetBody().GetStringValue(),
+					attributes: otlpReceivedAttributes(record.GetAttributes()),
+				})
+			}
+		}
+	}
+
+	resp := &collogspb.ExportLogsServiceResponse{}
+	if r.rejected > 0 {
+		resp.PartialSuccess = &collogspb.ExportLogsPartialSuccess{
+			RejectedLogRecords: r.rejected,
+			ErrorMessage:       "rejected",
+		}
+		r.rejected = 0
+	}
+
+	return resp, false
+}
+
+// received returns the received records by event name
+func (r *otlpReceiver) received() map[string]otlpReceivedRecord {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	records := map[string]otlpReceivedRecord{}
+	for _, record := range r.records {
+		records[record.body] = record
+	}
+	return records
+}
+
+func otlpReceivedAttributes(kvs []*commonpb.KeyValue) map[string]interface{} {
+	attrs := map[string]interface{}{}
+	for _, kv := range kvs {
+		attrs[kv.GetKey()] = otlpReceivedValue(kv.GetValue())
+	}
+	return attrs
+}
+
+func otlpReceivedValue(v *commonpb.AnyValue) interface{} {
+	switch value := v.GetValue().(type) {
+	case *commonpb.AnyValue_StringValue:
+		return value.StringValue
+	case *commonpb.AnyValue_BoolValue:
+		return value.BoolValue
+	case *commonpb.AnyValue_IntValue:
+		return value.IntValue
+	case *commonpb.AnyValue_DoubleValue:
+		return value.DoubleValue
+	case *commonpb.AnyValue_ArrayValue:
+		var values []interface{}
+		for _, v := range value.ArrayValue.GetValues() {
+			values = append(values, otlpReceivedValue(v))
+		}
+		return values
+	}
+	return nil
+}
+
+func TestOTLPEventPrinter(t *testing.T) {
+	t.Parallel()
+
+	events := []trace.Event{
+		{
+			EventName:       "openat",
+			EventID:         257,
+			HostName:        "node1",
+			HostProcessID:   1234,
+			ProcessName:     "cat",
+			MatchedPolicies: []string{"policy1"},
+			Container:       trace.Container{ID: "c1", ImageName: "ubuntu:22.04"},
+			Kubernetes:      trace.Kubernetes{PodName: "web", PodNamespace: "default"},
+			Labels:          map[string]string{"cluster": "prod-eu"},
+			Args: []trace.Argument{
+				{ArgMeta: trace.ArgMeta{Name: "pathname"}, Value: "/etc/passwd"},
+				{ArgMeta: trace.ArgMeta{Name: "flags"}, Value: int32(0)},
+				{ArgMeta: trace.ArgMeta{Name: "dirfd"}, Value: uint64(18446744073709551516)},
+			},
+		},
+		{
+			EventName:     "anti_debugging",
+			EventID:       6000,
+			HostName:      "node1",
+			HostProcessID: 42,
+			Metadata: &trace.Metadata{
+				Properties: map[string]interface{}{
+					"signatureID":   "TRC-102",
+					"signatureName": "Anti-Debugging detected",
+					"Severity":      3,
+					"external_id":   "T1622",
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		http      bool
+		params    string
+		fail      int
+		rejected  int64
+		delivered uint64
+		failed    uint64
+	}{
+		{
+			name:      "grpc",
+			delivered: 2,
+		},
+		{
+			name:      "http",
+			http:      true,
+			delivered: 2,
+		},
+		{
+			name:      "grpc retry",
+			params:    "retries=2",
+			fail:      2,
+			delivered: 2,
+		},
+		{
+			name:      "http retry",
+			http:      true,
+			params:    "retries=2",
+			fail:      2,
+			delivered: 2,
+		},
+		{
+			name:   "grpc retries exhausted",
+			params: "retries=1",
+			fail:   2,
+			failed: 2,
+		},
+		{
+			name:      "partial success",
+			http:      true,
+			rejected:  1,
+			delivered: 1,
+			failed:    1,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			receiver := newOTLPGRPCReceiver
+			if tc.http {
+				receiver = newOTLPHTTPReceiver
+			}
+			r := receiver(t)
+			r.fail = tc.fail
+			r.rejected = tc.rejected
+
+			p := &otlpEventPrinter{
+				outPath: fmt.Sprintf("%s?batchTimeout=1h&%s", r.endpoint, tc.params),
+				http:    tc.http,
+			}
+			require.NoError(t, p.Init())
+
+			p.Preamble()
+			for _, e := range events {
+				p.Print(e)
+			}
+			p.Epilogue(metrics.Stats{})
+			p.Close()
+
+			assert.Equal(t, tc.delivered, p.delivered.Get())
+			assert.Equal(t, tc.failed, p.failed.Get())
+
+			records := r.received()
+			if tc.failed == uint64(len(events)) {
+				assert.Empty(t, records)
+				return
+			}
+			require.Len(t, records, 2)
+
+			openat := records["openat"]
+			assert.Equal(t, map[string]interface{}{
+				"service.name":         "tracker",
+				"host.name":            "node1",
+				"container.id":         "c1",
+				"container.image.name": "ubuntu:22.04",
+				"k8s.pod.name":         "web",
+				"k8s.namespace.name":   "default",
+				"cluster":              "prod-eu",
+			}, openat.resource)
+			assert.Equal(t, logspb.SeverityNumber_SEVERITY_NUMBER_INFO, openat.severity)
+			assert.Equal(t, "INFO", openat.severityTx)
+			assert.Equal(t, "openat", openat.attributes["event.name"])
+			assert.Equal(t, int64(257), openat.attributes["tracker.event.id"])
+			assert.Equal(t, int64(1234), openat.attributes["process.pid"])
+			assert.Equal(t, "cat", openat.attributes["process.executable.name"])
+			assert.Equal(t, []interface{}{"policy1"}, openat.attributes["tracker.matched_policies"])
+			assert.Equal(t, "/etc/passwd", openat.attributes["tracker.args.pathname"])
+			assert.Equal(t, int64(0), openat.attributes["tracker.args.flags"])
+			assert.Equal(t, "18446744073709551516", openat.attributes["tracker.args.dirfd"])
+
+			finding := records["anti_debugging"]
+			assert.Equal(t, map[string]interface{}{
+				"service.name": "tracker",
+				"host.name":    "node1",
+			}, finding.resource)
+			assert.Equal(t, logspb.SeverityNumber_SEVERITY_NUMBER_ERROR, finding.severity)
+			assert.Equal(t, "HIGH", finding.severityTx)
+			assert.Equal(t, "TRC-102", finding.attributes["tracker.signature.id"])
+			assert.Equal(t, "Anti-Debugging detected", finding.attributes["tracker.signature.name"])
+			assert.Equal(t, "T1622", finding.attributes["threat.technique.id"])
+		})
+	}
+}
+
+func TestOTLPEventPrinterInit(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		outPath       string
+		http          bool
+		expectedError string
+	}{
+		{
+			name:          "invalid grpc protocol",
+			outPath:       "http://localhost:4317",
+			expectedError: "unsupported protocol for OTLP destination: http",
+		},
+		{
+			name:          "invalid http protocol",
+			outPath:       "grpc://localhost:4318",
+			http:          true,
+			expectedError: "unsupported protocol for OTLP HTTP destination: grpc",
+		},
+		{
+			name:          "invalid batch size",
+			outPath:       "grpc://localhost:4317?batchSize=none",
+			expectedError: "invalid OTLP batchSize value \"none\"",
+		},
+		{
+			name:          "missing CA file",
+			outPath:       "grpcs://localhost:4317?ca=/nonexistent/ca.pem",
+			expectedError: "unable to read OTLP CA file \"/nonexistent/ca.pem\"",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := &otlpEventPrinter{outPath: tc.outPath, http: tc.http}
+			assert.ErrorContains(t, p.Init(), tc.expectedError)
+		})
+	}
+}
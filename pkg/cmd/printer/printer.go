@@ -1,20 +1,18 @@
 package printer
 
 import (
-	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"net/http"
 	"net/url"
-	"path/filepath"
+	"os"
 	"strconv"
 	"strings"
 	"text/template"
 	"time"
 
 	forward "github.com/IBM/fluent-forward-go/fluent/client"
-	"github.com/Masterminds/sprig/v3"
 
 	"github.com/khulnasoft-lab/tracker/pkg/config"
 	"github.com/khulnasoft-lab/tracker/pkg/errfmt"
@@ -63,6 +61,15 @@ func New(cfg config.PrinterConfig) (EventPrinter, error) {
 		}
 	case kind == "json":
 		res = &jsonEventPrinter{
+			out:        cfg.OutFile,
+			projection: cfg.Projection,
+		}
+	case kind == "protobuf":
+		res = &protobufEventPrinter{
+			out: cfg.OutFile,
+		}
+	case kind == "cef":
+		res = &cefEventPrinter{
 			out: cfg.OutFile,
 		}
 	case kind == "forward":
@@ -73,6 +80,21 @@ func New(cfg config.PrinterConfig) (EventPrinter, error) {
 		res = &webhookEventPrinter{
 			outPath: cfg.OutPath,
 		}
+	case kind == "syslog":
+		res = &syslogEventPrinter{
+			outPath:    cfg.OutPath,
+			relativeTS: cfg.RelativeTS,
+		}
+	case kind == "otlp", kind == "otlp-http":
+		res = &otlpEventPrinter{
+			outPath:    cfg.OutPath,
+			http:       kind == "otlp-http",
+			relativeTS: cfg.RelativeTS,
+		}
+	case kind == "kafka":
+		res = &kafkaEventPrinter{
+			outPath: cfg.OutPath,
+		}
 	case strings.HasPrefix(kind, "gotemplate="):
 		res = &templateEventPrinter{
 			out:          cfg.OutFile,
@@ -387,24 +409,47 @@ func (p templateEventPrinter) Close() {
 }
 
 type jsonEventPrinter struct {
-	out io.WriteCloser
+	out        io.WriteCloser
+	projection config.JSONProjection
+	shaper     *jsonShaper
 }
 
-func (p jsonEventPrinter) Init() error { return nil }
+func (p *jsonEventPrinter) Init() error {
+	if !p.projection.Enabled() {
+		return nil
+	}
+
+	shaper, err := newJSONShaper(p.projection)
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+	p.shaper = shaper
 
-func (p jsonEventPrinter) Preamble() {}
+	return nil
+}
 
-func (p jsonEventPrinter) Print(event trace.Event) {
-	eBytes, err := json.Marshal(event)
+func (p *jsonEventPrinter) Preamble() {}
+
+func (p *jsonEventPrinter) Print(event trace.Event) {
+	var (
+		eBytes []byte
+		err    error
+	)
+
+	if p.shaper != nil {
+		eBytes, err = p.shaper.marshal(&event)
+	} else {
+		eBytes, err = json.Marshal(event)
+	}
 	if err != nil {
 		logger.Errorw("Error marshaling event to json", "error", err)
 	}
 	fmt.Fprintln(p.out, string(eBytes))
 }
 
-func (p jsonEventPrinter) Epilogue(stats metrics.Stats) {}
+func (p *jsonEventPrinter) Epilogue(stats metrics.Stats) {}
 
-func (p jsonEventPrinter) Close() {
+func (p *jsonEventPrinter) Close() {
 }
 
 // ignoreEventPrinter ignores events
@@ -431,6 +476,21 @@ type forwardEventPrinter struct {
 	tag string `default:"tracker"`
 }
 
+// readSecretFile reads a secret (a password or a token) given in a file, such as a mounted
+// kubernetes secret, instead of in the output URL
+func readSecretFile(path string) (string, error) {
+	secret, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	value := strings.TrimRight(string(secret), "\r\n")
+	if value == "" {
+		return "", errors.New("empty file")
+	}
+
+	return value, nil
+}
+
 func getParameterValue(parameters url.Values, key string, defaultValue string) string {
 	param, found := parameters[key]
 	// Ensure we have a non-empty parameter set for this key
@@ -558,96 +618,3 @@ func (p forwardEventPrinter) Close() {
 		}
 	}
 }
-
-type webhookEventPrinter struct {
-	outPath     string
-	url         *url.URL
-	timeout     time.Duration
-	templateObj *template.Template
-	contentType string
-}
-
-func (ws *webhookEventPrinter) Init() error {
-	u, err := url.Parse(ws.outPath)
-	if err != nil {
-		return errfmt.Errorf("unable to parse URL %q: %v", ws.outPath, err)
-	}
-	ws.url = u
-
-	parameters, _ := url.ParseQuery(ws.url.RawQuery)
-
-	timeout := getParameterValue(parameters, "timeout", "10s")
-	t, err := time.ParseDuration(timeout)
-	if err != nil {
-		return errfmt.Errorf("unable to convert timeout value %q: %v", timeout, err)
-	}
-	ws.timeout = t
-
-	gotemplate := getParameterValue(parameters, "gotemplate", "")
-	if gotemplate != "" {
-		tmpl, err := template.New(filepath.Base(gotemplate)).
-			Funcs(sprig.TxtFuncMap()).
-			ParseFiles(gotemplate)
-
-		if err != nil {
-			return errfmt.WrapError(err)
-		}
-		ws.templateObj = tmpl
-	}
-
-	contentType := getParameterValue(parameters, "contentType", "application/json")
-	ws.contentType = contentType
-
-	return nil
-}
-
-func (ws *webhookEventPrinter) Preamble() {}
-
-func (ws *webhookEventPrinter) Print(event trace.Event) {
-	var (
-		payload []byte
-		err     error
-	)
-
-	if ws.templateObj != nil {
-		buf := bytes.Buffer{}
-		if err := ws.templateObj.Execute(&buf, event); err != nil {
-			logger.Errorw("error writing to the template", "error", err)
-			return
-		}
-		payload = buf.Bytes()
-	} else {
-		payload, err = json.Marshal(event)
-		if err != nil {
-			logger.Errorw("Error marshalling event", "error", err)
-			return
-		}
-	}
-
-	client := http.Client{Timeout: ws.timeout}
-
-	req, err := http.NewRequest(http.MethodPost, ws.url.String(), bytes.NewReader(payload))
-	if err != nil {
-		logger.Errorw("Error creating request", "error", err)
-		return
-	}
-
-	req.Header.Set("Content-Type", ws.contentType)
-
-	resp, err := client.Do(req)
-	if err != nil {
-		logger.Errorw("Error sending webhook", "error", err)
-		return
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		logger.Errorw(fmt.Sprintf("Error sending webhook, http status: %d", resp.StatusCode))
-	}
-
-	_ = resp.Body.Close()
-}
-
-func (ws *webhookEventPrinter) Epilogue(stats metrics.Stats) {}
-
-func (ws *webhookEventPrinter) Close() {
-}
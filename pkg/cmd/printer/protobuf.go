@@ -0,0 +1,41 @@
+package printer
+
+import (
+	"io"
+
+	"github.com/khulnasoft-lab/tracker/pkg/logger"
+	"github.com/khulnasoft-lab/tracker/pkg/metrics"
+	"github.com/khulnasoft-lab/tracker/pkg/protoevent"
+	"github.com/khulnasoft-lab/tracker/types/trace"
+)
+
+// protobufEventPrinter writes events as length-delimited protobuf messages, the events of
+// the gRPC API, which can be read back by tracker analyze
+type protobufEventPrinter struct {
+	out    io.WriteCloser
+	writer *protoevent.Writer
+}
+
+func (p *protobufEventPrinter) Init() error {
+	p.writer = protoevent.NewWriter(p.out)
+
+	return nil
+}
+
+func (p *protobufEventPrinter) Preamble() {}
+
+func (p *protobufEventPrinter) Print(event trace.Event) {
+	pbEvent, err := protoevent.FromTrace(event)
+	if err != nil {
+		logger.Errorw("Error converting event to protobuf", "event", event.EventName, "error", err)
+		return
+	}
+
+	if err := p.writer.Write(pbEvent); err != nil {
+		logger.Errorw("Error writing protobuf event", "error", err)
+	}
+}
+
+func (p *protobufEventPrinter) Epilogue(stats metrics.Stats) {}
+
+func (p *protobufEventPrinter) Close() {}
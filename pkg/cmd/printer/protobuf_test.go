@@ -0,0 +1,79 @@
+package printer
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/khulnasoft-lab/tracker/pkg/config"
+	"github.com/khulnasoft-lab/tracker/pkg/events"
+	"github.com/khulnasoft-lab/tracker/pkg/metrics"
+	"github.com/khulnasoft-lab/tracker/pkg/protoevent"
+	"github.com/khulnasoft-lab/tracker/types/trace"
+)
+
+func TestProtobufEventPrinter(t *testing.T) {
+	t.Parallel()
+
+	printed := []trace.Event{
+		{
+			EventID:         int(events.Openat),
+			EventName:       "openat",
+			MatchedPolicies: []string{"default"},
+			ProcessID:       1,
+			ArgsNum:         1,
+			Args: []trace.Argument{
+				{ArgMeta: trace.ArgMeta{Name: "pathname", Type: "const char*"}, Value: "/etc/passwd"},
+			},
+		},
+		{
+			EventID:   int(events.SchedProcessExit),
+			EventName: "sched_process_exit",
+			ProcessID: 2,
+		},
+	}
+
+	path := filepath.Join(t.TempDir(), "events.pb")
+	file, err := os.Create(path)
+	require.NoError(t, err)
+
+	p, err := New(config.PrinterConfig{Kind: "protobuf", OutPath: path, OutFile: file})
+	require.NoError(t, err)
+	for _, event := range printed {
+		p.Print(event)
+	}
+	p.Epilogue(metrics.Stats{})
+	p.Close()
+	require.NoError(t, file.Close())
+
+	file, err = os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	reader := protoevent.NewReader(file)
+	var read []trace.Event
+	for {
+		pbEvent, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		event, err := protoevent.ToTrace(pbEvent)
+		require.NoError(t, err)
+		read = append(read, event)
+	}
+
+	require.Len(t, read, len(printed))
+	for i := range printed {
+		assert.Equal(t, printed[i].EventID, read[i].EventID)
+		assert.Equal(t, printed[i].EventName, read[i].EventName)
+		assert.Equal(t, printed[i].MatchedPolicies, read[i].MatchedPolicies)
+		assert.Equal(t, printed[i].ProcessID, read[i].ProcessID)
+		assert.Equal(t, printed[i].Args, read[i].Args)
+	}
+}
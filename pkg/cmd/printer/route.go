@@ -0,0 +1,79 @@
+package printer
+
+import (
+	"github.com/khulnasoft-lab/tracker/pkg/config"
+	"github.com/khulnasoft-lab/tracker/pkg/events/parse"
+	"github.com/khulnasoft-lab/tracker/types/trace"
+)
+
+// eventRoute selects the events sent to a printer
+type eventRoute struct {
+	policies     map[string]struct{}
+	events       map[string]struct{}
+	findingsOnly bool
+	minSeverity  int
+}
+
+// newEventRoute returns the route of a printer, or nil if it selects all events
+func newEventRoute(cfg config.PrinterRoute) *eventRoute {
+	if len(cfg.Policies) == 0 && len(cfg.Events) == 0 && !cfg.FindingsOnly && cfg.MinSeverity <= 0 {
+		return nil
+	}
+
+	r := &eventRoute{
+		findingsOnly: cfg.FindingsOnly,
+		minSeverity:  cfg.MinSeverity,
+	}
+	if len(cfg.Policies) > 0 {
+		r.policies = make(map[string]struct{}, len(cfg.Policies))
+		for _, policy := range cfg.Policies {
+			r.policies[policy] = struct{}{}
+		}
+	}
+	if len(cfg.Events) > 0 {
+		r.events = make(map[string]struct{}, len(cfg.Events))
+		for _, event := range cfg.Events {
+			r.events[event] = struct{}{}
+		}
+	}
+
+	return r
+}
+
+// match returns true if the event is routed to the printer: it must be matched by one of
+// the policies, have one of the event names, and be a finding of at least the minimum
+// severity, for the given selectors
+func (r *eventRoute) match(event *trace.Event) bool {
+	if r == nil {
+		return true
+	}
+
+	if r.events != nil {
+		if _, ok := r.events[event.EventName]; !ok {
+			return false
+		}
+	}
+
+	if r.findingsOnly || r.minSeverity > 0 {
+		if event.Metadata == nil {
+			return false
+		}
+		if r.minSeverity > 0 {
+			severity, ok := parse.FindingSeverity(*event)
+			if !ok || severity < r.minSeverity {
+				return false
+			}
+		}
+	}
+
+	if r.policies != nil {
+		for _, policy := range event.MatchedPolicies {
+			if _, ok := r.policies[policy]; ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
+}
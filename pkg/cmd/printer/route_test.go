@@ -0,0 +1,126 @@
+package printer
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/khulnasoft-lab/tracker/pkg/config"
+	"github.com/khulnasoft-lab/tracker/pkg/metrics"
+	"github.com/khulnasoft-lab/tracker/types/trace"
+)
+
+func TestEventRouteMatch(t *testing.T) {
+	t.Parallel()
+
+	openat := trace.Event{EventName: "openat", MatchedPolicies: []string{"files"}}
+	execve := trace.Event{EventName: "execve", MatchedPolicies: []string{"procs", "default"}}
+	finding := func(severity any) trace.Event {
+		return trace.Event{
+			EventName:       "TRC-1",
+			MatchedPolicies: []string{"default"},
+			Metadata: &trace.Metadata{
+				Properties: map[string]interface{}{"Severity": severity},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		route    config.PrinterRoute
+		event    trace.Event
+		expected bool
+	}{
+		{"no selectors", config.PrinterRoute{}, openat, true},
+		{"event name", config.PrinterRoute{Events: []string{"execve", "openat"}}, openat, true},
+		{"other event name", config.PrinterRoute{Events: []string{"execve"}}, openat, false},
+		{"policy", config.PrinterRoute{Policies: []string{"default"}}, execve, true},
+		{"other policy", config.PrinterRoute{Policies: []string{"default"}}, openat, false},
+		{"findings only, event", config.PrinterRoute{FindingsOnly: true}, execve, false},
+		{"findings only, finding", config.PrinterRoute{FindingsOnly: true}, finding(0), true},
+		{"min severity, event", config.PrinterRoute{MinSeverity: 2}, execve, false},
+		{"min severity, lower finding", config.PrinterRoute{MinSeverity: 2}, finding(1), false},
+		{"min severity, finding", config.PrinterRoute{MinSeverity: 2}, finding(3), true},
+		{"min severity, float finding", config.PrinterRoute{MinSeverity: 2}, finding(float64(3)), true},
+		{"min severity, int64 finding", config.PrinterRoute{MinSeverity: 2}, finding(int64(3)), true},
+		{
+			name:     "all selectors",
+			route:    config.PrinterRoute{Policies: []string{"default"}, Events: []string{"TRC-1"}, FindingsOnly: true, MinSeverity: 3},
+			event:    finding(3),
+			expected: true,
+		},
+		{
+			name:     "all selectors, other policy",
+			route:    config.PrinterRoute{Policies: []string{"files"}, Events: []string{"TRC-1"}, FindingsOnly: true, MinSeverity: 3},
+			event:    finding(3),
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, newEventRoute(tc.route).match(&tc.event))
+		})
+	}
+}
+
+// recordingPrinter records the printed event names
+type recordingPrinter struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (p *recordingPrinter) Init() error                  { return nil }
+func (p *recordingPrinter) Preamble()                    {}
+func (p *recordingPrinter) Epilogue(stats metrics.Stats) {}
+func (p *recordingPrinter) Close()                       {}
+
+func (p *recordingPrinter) Print(event trace.Event) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.events = append(p.events, event.EventName)
+}
+
+func TestBroadcastRouting(t *testing.T) {
+	t.Parallel()
+
+	all := &recordingPrinter{}
+	findings := &recordingPrinter{}
+
+	// set the printers up directly, Init creates them from their configs
+	b := &Broadcast{
+		printers:   []EventPrinter{all, findings},
+		routes:     []*eventRoute{nil, newEventRoute(config.PrinterRoute{FindingsOnly: true})},
+		eventsChan: []chan trace.Event{make(chan trace.Event, 10), make(chan trace.Event, 10)},
+		wg:         &sync.WaitGroup{},
+		done:       make(chan struct{}),
+	}
+	for i, p := range b.printers {
+		b.wg.Add(1)
+		go startPrinter(b.wg, b.done, b.eventsChan[i], p)
+	}
+
+	b.Print(trace.Event{EventName: "openat"})
+	b.Print(trace.Event{EventName: "TRC-1", Metadata: &trace.Metadata{}})
+	b.Print(trace.Event{EventName: "execve"})
+
+	printed := func(p *recordingPrinter) []string {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		return append([]string{}, p.events...)
+	}
+	assert.Eventually(t, func() bool {
+		return len(printed(all)) == 3 && len(printed(findings)) == 1
+	}, 5*time.Second, time.Millisecond)
+	b.Epilogue(metrics.Stats{})
+
+	assert.Equal(t, []string{"openat", "TRC-1", "execve"}, printed(all))
+	assert.Equal(t, []string{"TRC-1"}, printed(findings))
+}
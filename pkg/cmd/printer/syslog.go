@@ -0,0 +1,276 @@
+package printer
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/khulnasoft-lab/tracker/pkg/errfmt"
+	"github.com/khulnasoft-lab/tracker/pkg/events/parse"
+	"github.com/khulnasoft-lab/tracker/pkg/logger"
+	"github.com/khulnasoft-lab/tracker/pkg/metrics"
+	"github.com/khulnasoft-lab/tracker/types/trace"
+)
+
+var syslogFacilities = map[string]int{
+	"kern":     0,
+	"user":     1,
+	"daemon":   3,
+	"auth":     4,
+	"syslog":   5,
+	"authpriv": 10,
+	"local0":   16,
+	"local1":   17,
+	"local2":   18,
+	"local3":   19,
+	"local4":   20,
+	"local5":   21,
+	"local6":   22,
+	"local7":   23,
+}
+
+// syslog severities
+const (
+	syslogCritical      = 2
+	syslogError         = 3
+	syslogWarning       = 4
+	syslogNotice        = 5
+	syslogInformational = 6
+)
+
+// syslogEventPrinter sends events as RFC 5424 syslog messages over udp, tcp or tcp+tls,
+// with the event in JSON or CEF as the message
+type syslogEventPrinter struct {
+	outPath    string
+	relativeTS bool
+	url        *url.URL
+	conn       net.Conn
+	pid        string
+	hostName   string // used for events without a host name
+
+	// These parameters can be set up from the URL
+	network   string // udp or tcp
+	tlsConfig *tls.Config
+	format    string // json or cef
+	facility  int
+	appName   string
+	timeout   time.Duration
+}
+
+func (p *syslogEventPrinter) Init() error {
+	u, err := url.Parse(p.outPath)
+	if err != nil {
+		return errfmt.Errorf("unable to parse URL %q: %v", p.outPath, err)
+	}
+	p.url = u
+
+	parameters, _ := url.ParseQuery(p.url.RawQuery)
+
+	defaultPort := "514"
+	switch p.url.Scheme {
+	case "udp", "tcp":
+		p.network = p.url.Scheme
+	case "tcp+tls":
+		p.network = "tcp"
+		defaultPort = "6514"
+		p.tlsConfig = &tls.Config{ServerName: p.url.Hostname()}
+
+		ca := getParameterValue(parameters, "ca", "")
+		if ca != "" {
+			pem, err := os.ReadFile(ca)
+			if err != nil {
+				return errfmt.Errorf("unable to read syslog CA file %q: %v", ca, err)
+			}
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(pem) {
+				return errfmt.Errorf("no certificates found in syslog CA file %q", ca)
+			}
+			p.tlsConfig.RootCAs = pool
+		}
+	default:
+		return errfmt.Errorf("unsupported protocol for syslog destination: %s", p.url.Scheme)
+	}
+	if p.url.Hostname() == "" {
+		return errfmt.Errorf("syslog host is not set")
+	}
+	if p.url.Port() == "" {
+		p.url.Host = net.JoinHostPort(p.url.Hostname(), defaultPort)
+	}
+
+	p.format = getParameterValue(parameters, "format", "json")
+	if p.format != "json" && p.format != "cef" {
+		return errfmt.Errorf("invalid syslog format %q, use one of json or cef", p.format)
+	}
+
+	facility := getParameterValue(parameters, "facility", "local0")
+	var ok bool
+	p.facility, ok = syslogFacilities[facility]
+	if !ok {
+		return errfmt.Errorf("invalid syslog facility %q", facility)
+	}
+
+	p.appName = getParameterValue(parameters, "appName", "tracker")
+
+	timeout := getParameterValue(parameters, "timeout", "10s")
+	p.timeout, err = time.ParseDuration(timeout)
+	if err != nil {
+		return errfmt.Errorf("unable to convert timeout value %q: %v", timeout, err)
+	}
+
+	p.pid = strconv.Itoa(os.Getpid())
+	p.hostName, _ = os.Hostname()
+
+	// The destination may not be available but may appear later so do not return an error here and just connect later.
+	if err := p.connect(); err != nil {
+		logger.Errorw("Error connecting to syslog destination", "url", p.url.Host, "error", err)
+	}
+
+	return nil
+}
+
+func (p *syslogEventPrinter) connect() error {
+	dialer := &net.Dialer{Timeout: p.timeout}
+
+	var err error
+	if p.tlsConfig != nil {
+		p.conn, err = tls.DialWithDialer(dialer, p.network, p.url.Host, p.tlsConfig)
+	} else {
+		p.conn, err = dialer.Dial(p.network, p.url.Host)
+	}
+
+	return err
+}
+
+func (p *syslogEventPrinter) Preamble() {}
+
+func (p *syslogEventPrinter) Print(event trace.Event) {
+	msg, err := p.message(event)
+	if err != nil {
+		logger.Errorw("Error formatting syslog message", "error", err)
+		return
+	}
+
+	// tcp messages are framed by octet counting (RFC 6587)
+	if p.network == "tcp" {
+		msg = append([]byte(strconv.Itoa(len(msg))+" "), msg...)
+	}
+
+	// Assuming all is well we continue but if the connection is dropped we reconnect and retry once
+	if err = p.write(msg); err != nil {
+		if p.conn != nil {
+			_ = p.conn.Close()
+			p.conn = nil
+		}
+		err = p.write(msg)
+	}
+	if err != nil {
+		logger.Errorw("Error writing to syslog destination", "destination", p.url.Host, "error", err)
+	}
+}
+
+func (p *syslogEventPrinter) write(msg []byte) error {
+	if p.conn == nil {
+		if err := p.connect(); err != nil {
+			return err
+		}
+	}
+	if err := p.conn.SetWriteDeadline(time.Now().Add(p.timeout)); err != nil {
+		return err
+	}
+	_, err := p.conn.Write(msg)
+
+	return err
+}
+
+// message formats an event as a RFC 5424 message:
+//
+//	<PRI>1 TIMESTAMP HOSTNAME APP-NAME PROCID MSGID STRUCTURED-DATA MSG
+func (p *syslogEventPrinter) message(event trace.Event) ([]byte, error) {
+	var body []byte
+	switch p.format {
+	case "cef":
+		body = []byte(formatCEF(event))
+	default:
+		var err error
+		body, err = json.Marshal(event)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	timestamp := time.Now()
+	if !p.relativeTS {
+		timestamp = time.Unix(0, int64(event.Timestamp))
+	}
+
+	hostName := event.HostName
+	if hostName == "" {
+		hostName = p.hostName
+	}
+
+	header := fmt.Sprintf("<%d>1 %s %s %s %s %s - ",
+		p.facility*8+syslogSeverity(event),
+		timestamp.UTC().Format("2006-01-02T15:04:05.000000Z07:00"),
+		syslogHeaderField(hostName, 255),
+		syslogHeaderField(p.appName, 48),
+		p.pid,
+		syslogHeaderField(event.EventName, 32),
+	)
+
+	return append([]byte(header), body...), nil
+}
+
+// syslogSeverity maps the severity of a finding (0 to 4) to the syslog severity, other
+// events are informational
+func syslogSeverity(event trace.Event) int {
+	severity, ok := parse.FindingSeverity(event)
+	if !ok {
+		return syslogInformational
+	}
+
+	switch {
+	case severity <= 0:
+		return syslogInformational
+	case severity == 1:
+		return syslogNotice
+	case severity == 2:
+		return syslogWarning
+	case severity == 3:
+		return syslogError
+	}
+
+	return syslogCritical
+}
+
+// syslogHeaderField returns the value as a header field: printable ascii up to the given
+// length, or the nil value
+func syslogHeaderField(value string, maxLen int) string {
+	field := make([]byte, 0, len(value))
+	for i := 0; i < len(value) && len(field) < maxLen; i++ {
+		if value[i] > 32 && value[i] < 127 {
+			field = append(field, value[i])
+		}
+	}
+	if len(field) == 0 {
+		return "-"
+	}
+
+	return string(field)
+}
+
+func (p *syslogEventPrinter) Epilogue(stats metrics.Stats) {}
+
+func (p *syslogEventPrinter) Close() {
+	if p.conn != nil {
+		logger.Infow("Disconnecting from syslog destination", "url", p.url.Host)
+		if err := p.conn.Close(); err != nil {
+			logger.Errorw("Disconnecting from syslog destination", "error", err)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package printer
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/khulnasoft-lab/tracker/pkg/metrics"
+	"github.com/khulnasoft-lab/tracker/types/trace"
+)
+
+func TestSyslogEventPrinter(t *testing.T) {
+	t.Parallel()
+
+	event := trace.Event{
+		Timestamp: 1700000000123456000,
+		HostName:  "node1",
+		EventName: "anti_debugging",
+		Metadata: &trace.Metadata{
+			Properties: map[string]interface{}{
+				"signatureID": "TRC-102",
+				"Severity":    2,
+			},
+		},
+	}
+	header := fmt.Sprintf("<%d>1 2023-11-14T22:13:20.123456Z node1 tracker %d anti_debugging - ",
+		20*8+syslogWarning, os.Getpid())
+
+	testCases := []struct {
+		name    string
+		network string
+		params  string
+		body    *regexp.Regexp
+	}{
+		{
+			name:    "udp json",
+			network: "udp",
+			params:  "facility=local4",
+			body:    regexp.MustCompile(`^\{"timestamp":1700000000123456000,.*"eventName":"anti_debugging"`),
+		},
+		{
+			name:    "tcp cef",
+			network: "tcp",
+			params:  "facility=local4&format=cef",
+			body:    regexp.MustCompile(`^CEF:0\|Khulnasoft\|Tracker\|\|TRC-102\|anti_debugging\|5\|`),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var addr string
+			var receive func() string
+
+			switch tc.network {
+			case "udp":
+				conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+				require.NoError(t, err)
+				t.Cleanup(func() { _ = conn.Close() })
+				addr = conn.LocalAddr().String()
+				receive = func() string {
+					buf := make([]byte, 64*1024)
+					n, _, err := conn.ReadFrom(buf)
+					require.NoError(t, err)
+					return string(buf[:n])
+				}
+			case "tcp":
+				l, err := net.Listen("tcp", "127.0.0.1:0")
+				require.NoError(t, err)
+				t.Cleanup(func() { _ = l.Close() })
+				addr = l.Addr().String()
+				accepted := make(chan net.Conn, 1)
+				go func() {
+					conn, err := l.Accept()
+					if err == nil {
+						accepted <- conn
+					}
+				}()
+				var r *bufio.Reader
+				receive = func() string {
+					if r == nil {
+						conn := <-accepted
+						t.Cleanup(func() { _ = conn.Close() })
+						r = bufio.NewReader(conn)
+					}
+					// octet counting framing
+					size, err := r.ReadString(' ')
+					require.NoError(t, err)
+					n, err := strconv.Atoi(strings.TrimSpace(size))
+					require.NoError(t, err)
+					msg := make([]byte, n)
+					_, err = io.ReadFull(r, msg)
+					require.NoError(t, err)
+					return string(msg)
+				}
+			}
+
+			p := &syslogEventPrinter{outPath: fmt.Sprintf("%s://%s?%s", tc.network, addr, tc.params)}
+			require.NoError(t, p.Init())
+			defer p.Close()
+
+			for i := 0; i < 2; i++ {
+				p.Print(event)
+
+				msg := receive()
+				require.True(t, strings.HasPrefix(msg, header), msg)
+				assert.Regexp(t, tc.body, strings.TrimPrefix(msg, header))
+			}
+			p.Epilogue(metrics.Stats{})
+		})
+	}
+}
+
+func TestSyslogEventPrinterInit(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		outPath       string
+		expectedError string
+	}{
+		{
+			name:          "invalid protocol",
+			outPath:       "http://localhost:514",
+			expectedError: "unsupported protocol for syslog destination: http",
+		},
+		{
+			name:          "invalid format",
+			outPath:       "udp://localhost:514?format=xml",
+			expectedError: "invalid syslog format \"xml\"",
+		},
+		{
+			name:          "invalid facility",
+			outPath:       "udp://localhost:514?facility=local9",
+			expectedError: "invalid syslog facility \"local9\"",
+		},
+		{
+			name:          "missing CA file",
+			outPath:       "tcp+tls://localhost:6514?ca=/nonexistent/ca.pem",
+			expectedError: "unable to read syslog CA file \"/nonexistent/ca.pem\"",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := &syslogEventPrinter{outPath: tc.outPath}
+			assert.ErrorContains(t, p.Init(), tc.expectedError)
+		})
+	}
+}
+
+func TestSyslogHeaderField(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "-", syslogHeaderField("", 32))
+	assert.Equal(t, "node1", syslogHeaderField("node 1", 32))
+	assert.Equal(t, "abc", syslogHeaderField("abcdef", 3))
+}
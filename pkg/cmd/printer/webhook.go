@@ -0,0 +1,442 @@
+package printer
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/Masterminds/sprig/v3"
+
+	"github.com/khulnasoft-lab/tracker/pkg/counter"
+	"github.com/khulnasoft-lab/tracker/pkg/errfmt"
+	"github.com/khulnasoft-lab/tracker/pkg/logger"
+	"github.com/khulnasoft-lab/tracker/pkg/metrics"
+	"github.com/khulnasoft-lab/tracker/types/trace"
+)
+
+const (
+	webhookRetryBackoff  = 100 * time.Millisecond
+	webhookMaxRetryDelay = 5 * time.Second
+)
+
+// webhookParameters are the URL parameters configuring the webhook printer, which are
+// not sent to the webhook
+var webhookParameters = []string{
+	"timeout", "gotemplate", "contentType", "batchSize", "batchTimeout", "batchFormat",
+	"queueSize", "retries", "header", "headerFile", "tokenFile", "tokenEnv", "compression",
+	"cert", "key", "ca",
+}
+
+// webhookEventPrinter posts events to a webhook. Events are queued in a bounded queue,
+// dropping them when it's full, and posted one by one or in batches (a JSON array or
+// NDJSON) from the queue goroutine. Failed posts are retried with exponential backoff.
+type webhookEventPrinter struct {
+	outPath string
+
+	// These parameters can be set up from the URL
+	url          *url.URL
+	timeout      time.Duration
+	templateObj  *template.Template
+	contentType  string
+	batchSize    int
+	batchTimeout time.Duration
+	batchFormat  string // array or ndjson, for batches of more than one event
+	queueSize    int
+	retries      int
+	headers      http.Header
+	gzip         bool
+
+	client    *http.Client
+	batcher   *batcher[[]byte]
+	delivered counter.Counter
+	failed    counter.Counter // events that couldn't be delivered
+	dropped   counter.Counter // events dropped because the queue was full
+	stats     *metrics.Stats
+}
+
+func (ws *webhookEventPrinter) Init() error {
+	u, err := url.Parse(ws.outPath)
+	if err != nil {
+		return errfmt.Errorf("unable to parse URL %q: %v", ws.outPath, err)
+	}
+
+	parameters, _ := url.ParseQuery(u.RawQuery)
+
+	timeout := getParameterValue(parameters, "timeout", "10s")
+	ws.timeout, err = time.ParseDuration(timeout)
+	if err != nil {
+		return errfmt.Errorf("unable to convert timeout value %q: %v", timeout, err)
+	}
+
+	gotemplate := getParameterValue(parameters, "gotemplate", "")
+	if gotemplate != "" {
+		tmpl, err := template.New(filepath.Base(gotemplate)).
+			Funcs(sprig.TxtFuncMap()).
+			ParseFiles(gotemplate)
+
+		if err != nil {
+			return errfmt.WrapError(err)
+		}
+		ws.templateObj = tmpl
+	}
+
+	batchSize := getParameterValue(parameters, "batchSize", "1")
+	ws.batchSize, err = strconv.Atoi(batchSize)
+	if err != nil || ws.batchSize <= 0 {
+		return errfmt.Errorf("invalid webhook batchSize value %q", batchSize)
+	}
+
+	batchTimeout := getParameterValue(parameters, "batchTimeout", "1s")
+	ws.batchTimeout, err = time.ParseDuration(batchTimeout)
+	if err != nil || ws.batchTimeout <= 0 {
+		return errfmt.Errorf("invalid webhook batchTimeout value %q", batchTimeout)
+	}
+
+	ws.batchFormat = getParameterValue(parameters, "batchFormat", "array")
+	defaultContentType := "application/json"
+	switch ws.batchFormat {
+	case "array":
+	case "ndjson":
+		if ws.batchSize > 1 {
+			defaultContentType = "application/x-ndjson"
+		}
+	default:
+		return errfmt.Errorf("invalid webhook batchFormat %q, use one of array or ndjson", ws.batchFormat)
+	}
+	ws.contentType = getParameterValue(parameters, "contentType", defaultContentType)
+
+	queueSize := getParameterValue(parameters, "queueSize", "10000")
+	ws.queueSize, err = strconv.Atoi(queueSize)
+	if err != nil || ws.queueSize <= 0 {
+		return errfmt.Errorf("invalid webhook queueSize value %q", queueSize)
+	}
+
+	retries := getParameterValue(parameters, "retries", "3")
+	ws.retries, err = strconv.Atoi(retries)
+	if err != nil || ws.retries < 0 {
+		return errfmt.Errorf("invalid webhook retries value %q", retries)
+	}
+
+	ws.headers, err = webhookHeaders(parameters)
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+
+	compression := getParameterValue(parameters, "compression", "none")
+	switch compression {
+	case "none":
+	case "gzip":
+		ws.gzip = true
+	default:
+		return errfmt.Errorf("invalid webhook compression %q, use one of none or gzip", compression)
+	}
+
+	tlsConfig, err := webhookTLSConfig(
+		getParameterValue(parameters, "cert", ""),
+		getParameterValue(parameters, "key", ""),
+		getParameterValue(parameters, "ca", ""),
+	)
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+
+	// the printer parameters are not sent to the webhook
+	for _, parameter := range webhookParameters {
+		parameters.Del(parameter)
+	}
+	u.RawQuery = parameters.Encode()
+	ws.url = u
+
+	ws.client = &http.Client{
+		Timeout:   ws.timeout,
+		Transport: &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: tlsConfig},
+	}
+	ws.batcher = newBatcher(ws.batchSize, ws.batchTimeout, ws.queueSize, ws.flush)
+
+	return nil
+}
+
+// webhookHeaders returns the request headers. Credentials are not accepted in the URL,
+// where they would be logged and visible in the process arguments: they are read from
+// the headerFile files, as name:path, and the bearer token from tokenFile or from the
+// tokenEnv environment variable.
+func webhookHeaders(parameters url.Values) (http.Header, error) {
+	headers := http.Header{}
+
+	for _, header := range parameters["header"] {
+		name, value, ok := strings.Cut(header, ":")
+		name = http.CanonicalHeaderKey(strings.TrimSpace(name))
+		if !ok || name == "" {
+			return nil, errfmt.Errorf("invalid webhook header %q, use name:value", header)
+		}
+		if name == "Authorization" || name == "Proxy-Authorization" {
+			return nil, errfmt.Errorf("webhook %s header can't be set in the URL, use tokenFile, tokenEnv or headerFile", name)
+		}
+		headers.Add(name, strings.TrimSpace(value))
+	}
+
+	for _, header := range parameters["headerFile"] {
+		name, file, ok := strings.Cut(header, ":")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" || file == "" {
+			return nil, errfmt.Errorf("invalid webhook headerFile %q, use name:path", header)
+		}
+		value, err := readSecretFile(file)
+		if err != nil {
+			return nil, errfmt.Errorf("unable to read webhook header file %q: %v", file, err)
+		}
+		headers.Add(name, value)
+	}
+
+	var token string
+	tokenFile := getParameterValue(parameters, "tokenFile", "")
+	tokenEnv := getParameterValue(parameters, "tokenEnv", "")
+	switch {
+	case tokenFile != "" && tokenEnv != "":
+		return nil, errfmt.Errorf("webhook tokenFile and tokenEnv can't be both set")
+	case tokenFile != "":
+		var err error
+		if token, err = readSecretFile(tokenFile); err != nil {
+			return nil, errfmt.Errorf("unable to read webhook token file %q: %v", tokenFile, err)
+		}
+	case tokenEnv != "":
+		if token = os.Getenv(tokenEnv); token == "" {
+			return nil, errfmt.Errorf("webhook token environment variable %s is not set", tokenEnv)
+		}
+	}
+	if token != "" {
+		headers.Set("Authorization", "Bearer "+token)
+	}
+
+	return headers, nil
+}
+
+// webhookTLSConfig returns the TLS configuration with the client certificate and the CA
+// certificates, or nil if none is given
+func webhookTLSConfig(cert, key, ca string) (*tls.Config, error) {
+	if cert == "" && key == "" && ca == "" {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{}
+
+	if cert != "" || key != "" {
+		if cert == "" || key == "" {
+			return nil, errfmt.Errorf("webhook client certificate requires both cert and key")
+		}
+		certificate, err := tls.LoadX509KeyPair(cert, key)
+		if err != nil {
+			return nil, errfmt.Errorf("unable to load webhook client certificate: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	if ca != "" {
+		pem, err := os.ReadFile(ca)
+		if err != nil {
+			return nil, errfmt.Errorf("unable to read webhook CA file %q: %v", ca, err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errfmt.Errorf("no certificates found in webhook CA file %q", ca)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return tlsConfig, nil
+}
+
+// SetStats sets the tracker stats where the delivered, failed and dropped events are
+// counted
+func (ws *webhookEventPrinter) SetStats(stats *metrics.Stats) {
+	ws.stats = stats
+}
+
+func (ws *webhookEventPrinter) Preamble() {}
+
+func (ws *webhookEventPrinter) Print(event trace.Event) {
+	var (
+		payload []byte
+		err     error
+	)
+
+	if ws.templateObj != nil {
+		buf := bytes.Buffer{}
+		if err := ws.templateObj.Execute(&buf, event); err != nil {
+			logger.Errorw("error writing to the template", "error", err)
+			ws.count(&ws.failed, 1)
+			return
+		}
+		payload = buf.Bytes()
+	} else {
+		payload, err = json.Marshal(event)
+		if err != nil {
+			logger.Errorw("Error marshalling event", "error", err)
+			ws.count(&ws.failed, 1)
+			return
+		}
+	}
+
+	if !ws.batcher.tryAdd(payload) {
+		ws.count(&ws.dropped, 1)
+	}
+}
+
+// Epilogue sends the queued events and reports how many were delivered
+func (ws *webhookEventPrinter) Epilogue(stats metrics.Stats) {
+	ws.batcher.stop()
+	logger.Infow("Webhook output",
+		"url", ws.url.Redacted(),
+		"delivered", ws.delivered.Get(),
+		"failed", ws.failed.Get(),
+		"dropped", ws.dropped.Get(),
+	)
+}
+
+func (ws *webhookEventPrinter) Close() {
+	ws.batcher.stop()
+}
+
+// flush posts a batch of events, retrying on retriable errors
+func (ws *webhookEventPrinter) flush(batch [][]byte) {
+	body, err := ws.encodeBatch(batch)
+	if err != nil {
+		logger.Errorw("Error encoding webhook request", "error", err)
+		ws.count(&ws.failed, len(batch))
+		return
+	}
+
+	for attempt := 0; attempt <= ws.retries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(min(webhookRetryBackoff<<(attempt-1), webhookMaxRetryDelay))
+		}
+
+		err = ws.post(body)
+		if err == nil {
+			ws.count(&ws.delivered, len(batch))
+			return
+		}
+		if !webhookRetriable(err) {
+			break
+		}
+	}
+
+	logger.Errorw("Error sending webhook", "url", ws.url.Redacted(), "events", len(batch), "error", err)
+	ws.count(&ws.failed, len(batch))
+}
+
+// encodeBatch returns the request body of a batch: a single event as is, or the events as
+// a JSON array or as NDJSON, compressed if configured
+func (ws *webhookEventPrinter) encodeBatch(batch [][]byte) ([]byte, error) {
+	var body []byte
+	switch {
+	case ws.batchSize == 1:
+		body = batch[0]
+	case ws.batchFormat == "ndjson":
+		for _, payload := range batch {
+			body = append(body, bytes.TrimRight(payload, "\n")...)
+			body = append(body, '\n')
+		}
+	default:
+		body = append(body, '[')
+		body = append(body, bytes.Join(batch, []byte(","))...)
+		body = append(body, ']')
+	}
+
+	if !ws.gzip {
+		return body, nil
+	}
+
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(body); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// post sends a request body to the webhook
+func (ws *webhookEventPrinter) post(body []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), ws.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ws.url.String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	for name, values := range ws.headers {
+		req.Header[name] = values
+	}
+	req.Header.Set("Content-Type", ws.contentType)
+	if ws.gzip {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+
+	resp, err := ws.client.Do(req)
+	if err != nil {
+		return err
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return webhookHTTPError(resp.StatusCode)
+	}
+
+	return nil
+}
+
+// count increments a printer counter and, if the tracker stats are set, the matching
+// stats counter
+func (ws *webhookEventPrinter) count(c *counter.Counter, n int) {
+	_ = c.Increment(uint64(n))
+	if ws.stats == nil {
+		return
+	}
+
+	switch c {
+	case &ws.delivered:
+		_ = ws.stats.WebhookOutputDelivered.Increment(uint64(n))
+	case &ws.failed:
+		_ = ws.stats.WebhookOutputFailed.Increment(uint64(n))
+	case &ws.dropped:
+		_ = ws.stats.WebhookOutputDropped.Increment(uint64(n))
+	}
+}
+
+// webhookHTTPError is the status code of a failed webhook request
+type webhookHTTPError int
+
+func (e webhookHTTPError) Error() string {
+	return fmt.Sprintf("http status: %d", int(e))
+}
+
+// webhookRetriable returns true if a request failing with err should be retried: on
+// network errors, timeouts, throttling and server errors
+func webhookRetriable(err error) bool {
+	var httpErr webhookHTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr == http.StatusRequestTimeout || httpErr == http.StatusTooManyRequests || httpErr >= 500
+	}
+
+	return true
+}
@@ -0,0 +1,385 @@
+package printer
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/khulnasoft-lab/tracker/pkg/metrics"
+	"github.com/khulnasoft-lab/tracker/types/trace"
+)
+
+// webhookReceiver is a webhook stub recording the received requests
+type webhookReceiver struct {
+	mu       sync.Mutex
+	fail     []int // status codes of the next requests
+	requests []webhookRequest
+}
+
+type webhookRequest struct {
+	header http.Header
+	query  string
+	body   []byte
+	tls    *tls.ConnectionState
+}
+
+func (r *webhookReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	var body io.Reader = req.Body
+	if req.Header.Get("Content-Encoding") == "gzip" {
+		gz, err := gzip.NewReader(req.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		body = gz
+	}
+	b, err := io.ReadAll(body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.fail) > 0 {
+		status := r.fail[0]
+		r.fail = r.fail[1:]
+		w.WriteHeader(status)
+		return
+	}
+	r.requests = append(r.requests, webhookRequest{
+		header: req.Header,
+		query:  req.URL.RawQuery,
+		body:   b,
+		tls:    req.TLS,
+	})
+}
+
+func (r *webhookReceiver) received() []webhookRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]webhookRequest{}, r.requests...)
+}
+
+func TestWebhookEventPrinter(t *testing.T) {
+	t.Parallel()
+
+	events := []trace.Event{
+		{EventName: "openat", ProcessID: 1},
+		{EventName: "execve", ProcessID: 2},
+		{EventName: "connect", ProcessID: 3},
+	}
+
+	testCases := []struct {
+		name              string
+		parameters        string
+		fail              []int
+		expectedRequests  int
+		expectedHeaders   map[string]string
+		expectedQuery     string
+		expectedDelivered uint64
+		expectedFailed    uint64
+		check             func(t *testing.T, requests []webhookRequest)
+	}{
+		{
+			name:              "one event per request",
+			parameters:        "?timeout=5s&foo=bar",
+			expectedRequests:  3,
+			expectedHeaders:   map[string]string{"Content-Type": "application/json"},
+			expectedQuery:     "foo=bar",
+			expectedDelivered: 3,
+			check: func(t *testing.T, requests []webhookRequest) {
+				for i, req := range requests {
+					var event trace.Event
+					require.NoError(t, json.Unmarshal(req.body, &event))
+					assert.Equal(t, events[i].EventName, event.EventName)
+				}
+			},
+		},
+		{
+			name:              "json array batch",
+			parameters:        "?batchSize=3&batchTimeout=1h",
+			expectedRequests:  1,
+			expectedHeaders:   map[string]string{"Content-Type": "application/json"},
+			expectedDelivered: 3,
+			check: func(t *testing.T, requests []webhookRequest) {
+				var batch []trace.Event
+				require.NoError(t, json.Unmarshal(requests[0].body, &batch))
+				require.Len(t, batch, 3)
+				assert.Equal(t, "connect", batch[2].EventName)
+			},
+		},
+		{
+			name:              "ndjson batch with gzip and headers",
+			parameters:        "?batchSize=3&batchTimeout=1h&batchFormat=ndjson&compression=gzip&header=X-Tenant:%20a&header=X-Source:tracker",
+			expectedRequests:  1,
+			expectedHeaders:   map[string]string{"Content-Type": "application/x-ndjson", "Content-Encoding": "gzip", "X-Tenant": "a", "X-Source": "tracker"},
+			expectedDelivered: 3,
+			check: func(t *testing.T, requests []webhookRequest) {
+				scanner := bufio.NewScanner(bytes.NewReader(requests[0].body))
+				lines := 0
+				for scanner.Scan() {
+					var event trace.Event
+					require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+					assert.Equal(t, events[lines].EventName, event.EventName)
+					lines++
+				}
+				assert.Equal(t, 3, lines)
+			},
+		},
+		{
+			name:              "retry on server errors",
+			parameters:        "?batchSize=3&batchTimeout=1h&retries=2",
+			fail:              []int{http.StatusServiceUnavailable, http.StatusTooManyRequests},
+			expectedRequests:  1,
+			expectedDelivered: 3,
+		},
+		{
+			name:             "retries exhausted",
+			parameters:       "?batchSize=3&batchTimeout=1h&retries=1",
+			fail:             []int{http.StatusInternalServerError, http.StatusBadGateway},
+			expectedRequests: 0,
+			expectedFailed:   3,
+		},
+		{
+			name:             "no retry on client errors",
+			parameters:       "?batchSize=3&batchTimeout=1h&retries=3",
+			fail:             []int{http.StatusBadRequest},
+			expectedRequests: 0,
+			expectedFailed:   3,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			receiver := &webhookReceiver{fail: tc.fail}
+			server := httptest.NewServer(receiver)
+			defer server.Close()
+
+			p := &webhookEventPrinter{outPath: server.URL + tc.parameters}
+			require.NoError(t, p.Init())
+
+			stats := &metrics.Stats{}
+			p.SetStats(stats)
+
+			for _, event := range events {
+				p.Print(event)
+			}
+			p.Epilogue(metrics.Stats{})
+			p.Close()
+
+			requests := receiver.received()
+			require.Len(t, requests, tc.expectedRequests)
+			for _, req := range requests {
+				for name, value := range tc.expectedHeaders {
+					assert.Equal(t, value, req.header.Get(name), name)
+				}
+				assert.Equal(t, tc.expectedQuery, req.query)
+			}
+			if tc.check != nil {
+				tc.check(t, requests)
+			}
+
+			assert.Equal(t, tc.expectedDelivered, stats.WebhookOutputDelivered.Get())
+			assert.Equal(t, tc.expectedFailed, stats.WebhookOutputFailed.Get())
+			assert.Equal(t, uint64(0), stats.WebhookOutputDropped.Get())
+		})
+	}
+}
+
+func TestWebhookEventPrinterQueueFull(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+
+	p := &webhookEventPrinter{outPath: server.URL + "?queueSize=2&timeout=1m"}
+	require.NoError(t, p.Init())
+
+	// the first event blocks the queue goroutine, the next two fill the queue
+	p.Print(trace.Event{EventName: "first"})
+	require.Eventually(t, func() bool { return len(p.batcher.items) == 0 }, 5*time.Second, time.Millisecond)
+	for i := 0; i < 4; i++ {
+		p.Print(trace.Event{EventName: "queued"})
+	}
+	close(release)
+	p.Epilogue(metrics.Stats{})
+	p.Close()
+
+	assert.Equal(t, uint64(3), p.delivered.Get())
+	assert.Equal(t, uint64(2), p.dropped.Get())
+}
+
+func TestWebhookEventPrinterClientCertificate(t *testing.T) {
+	t.Parallel()
+
+	receiver := &webhookReceiver{}
+	server := httptest.NewUnstartedServer(receiver)
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	dir := t.TempDir()
+	ca := filepath.Join(dir, "ca.pem")
+	require.NoError(t, os.WriteFile(ca, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0600))
+	cert, key := writeClientCertificate(t, dir)
+
+	p := &webhookEventPrinter{outPath: server.URL + "?cert=" + cert + "&key=" + key + "&ca=" + ca}
+	require.NoError(t, p.Init())
+	p.Print(trace.Event{EventName: "openat"})
+	p.Epilogue(metrics.Stats{})
+	p.Close()
+
+	requests := receiver.received()
+	require.Len(t, requests, 1)
+	require.NotNil(t, requests[0].tls)
+	require.Len(t, requests[0].tls.PeerCertificates, 1)
+	assert.Equal(t, "tracker", requests[0].tls.PeerCertificates[0].Subject.CommonName)
+	assert.Empty(t, requests[0].query)
+}
+
+func TestWebhookEventPrinterInitErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		parameters    string
+		expectedError string
+	}{
+		{"?batchSize=0", "invalid webhook batchSize value \"0\""},
+		{"?batchTimeout=x", "invalid webhook batchTimeout value \"x\""},
+		{"?batchFormat=xml", "invalid webhook batchFormat \"xml\", use one of array or ndjson"},
+		{"?queueSize=-1", "invalid webhook queueSize value \"-1\""},
+		{"?retries=-1", "invalid webhook retries value \"-1\""},
+		{"?header=Authorization", "invalid webhook header \"Authorization\", use name:value"},
+		{"?header=authorization:Bearer%20token", "webhook Authorization header can't be set in the URL, use tokenFile, tokenEnv or headerFile"},
+		{"?headerFile=X-Api-Key", "invalid webhook headerFile \"X-Api-Key\", use name:path"},
+		{"?headerFile=X-Api-Key:/nonexistent/key", "unable to read webhook header file \"/nonexistent/key\""},
+		{"?tokenFile=/nonexistent/token", "unable to read webhook token file \"/nonexistent/token\""},
+		{"?tokenFile=/tmp/token&tokenEnv=TOKEN", "webhook tokenFile and tokenEnv can't be both set"},
+		{"?tokenEnv=TRACKER_TEST_WEBHOOK_UNSET_TOKEN", "webhook token environment variable TRACKER_TEST_WEBHOOK_UNSET_TOKEN is not set"},
+		{"?compression=zstd", "invalid webhook compression \"zstd\", use one of none or gzip"},
+		{"?cert=/tmp/cert.pem", "webhook client certificate requires both cert and key"},
+		{"?ca=/nonexistent/ca.pem", "unable to read webhook CA file \"/nonexistent/ca.pem\""},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.parameters, func(t *testing.T) {
+			t.Parallel()
+
+			p := &webhookEventPrinter{outPath: "http://localhost:8080" + tc.parameters}
+			err := p.Init()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expectedError)
+		})
+	}
+}
+
+// TestWebhookEventPrinterCredentials isn't parallel as it sets the token environment
+// variable
+func TestWebhookEventPrinterCredentials(t *testing.T) {
+	dir := t.TempDir()
+	tokenPath := filepath.Join(dir, "token")
+	require.NoError(t, os.WriteFile(tokenPath, []byte("file-token\n"), 0600))
+	apiKeyPath := filepath.Join(dir, "api-key")
+	require.NoError(t, os.WriteFile(apiKeyPath, []byte("key"), 0600))
+	t.Setenv("TRACKER_TEST_WEBHOOK_TOKEN", "env-token")
+
+	testCases := []struct {
+		name            string
+		parameters      string
+		expectedHeaders map[string]string
+	}{
+		{
+			name:            "token file",
+			parameters:      "?tokenFile=" + tokenPath,
+			expectedHeaders: map[string]string{"Authorization": "Bearer file-token"},
+		},
+		{
+			name:            "token environment variable",
+			parameters:      "?tokenEnv=TRACKER_TEST_WEBHOOK_TOKEN",
+			expectedHeaders: map[string]string{"Authorization": "Bearer env-token"},
+		},
+		{
+			name:            "header file",
+			parameters:      "?headerFile=X-Api-Key:" + apiKeyPath + "&header=X-Tenant:a",
+			expectedHeaders: map[string]string{"X-Api-Key": "key", "X-Tenant": "a"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			receiver := &webhookReceiver{}
+			server := httptest.NewServer(receiver)
+			defer server.Close()
+
+			p := &webhookEventPrinter{outPath: server.URL + tc.parameters}
+			require.NoError(t, p.Init())
+			p.Print(trace.Event{EventName: "openat"})
+			p.Close()
+
+			requests := receiver.received()
+			require.Len(t, requests, 1)
+			for name, value := range tc.expectedHeaders {
+				assert.Equal(t, value, requests[0].header.Get(name), name)
+			}
+			assert.Empty(t, requests[0].query)
+		})
+	}
+}
+
+// writeClientCertificate writes a self signed client certificate and its key
+func writeClientCertificate(t *testing.T, dir string) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "tracker"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPath := filepath.Join(dir, "client.pem")
+	keyPath := filepath.Join(dir, "client-key.pem")
+	require.NoError(t, os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+
+	return certPath, keyPath
+}
@@ -0,0 +1,274 @@
+package cmd
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/khulnasoft-lab/tracker/pkg/cmd/printer"
+	tracker "github.com/khulnasoft-lab/tracker/pkg/ebpf"
+	"github.com/khulnasoft-lab/tracker/pkg/errfmt"
+	"github.com/khulnasoft-lab/tracker/pkg/logger"
+	"github.com/khulnasoft-lab/tracker/pkg/policy/v1beta1"
+	"github.com/khulnasoft-lab/tracker/types/detect"
+)
+
+// reloadDelay is the time to wait for more changes before reloading, since
+// files are usually changed in bursts (e.g. editors writing a file in steps).
+const reloadDelay = time.Second
+
+// Reloader reloads policies and signatures when tracker receives SIGHUP or, if
+// enabled, when the files in the watched paths change. If the new configuration
+// fails to load, the running one is kept. SIGHUP also reopens the output files, so
+// that the files moved by an external rotation (e.g. logrotate) are released.
+type Reloader struct {
+	PolicyPaths    []string // policy files and directories (given with --policy)
+	SignaturesDirs []string
+	FindSignatures func() ([]detect.Signature, error)
+	Outputs        printer.Reopener // output files reopened on SIGHUP
+	Watch          bool             // reload when the watched paths change
+}
+
+// Run reloads policies and signatures until the context is done
+func (r *Reloader) Run(ctx context.Context, t *tracker.Tracker) {
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+	defer signal.Stop(sighup)
+
+	var watcher *reloadWatcher
+	var changes <-chan fsnotify.Event
+	var watchErrors <-chan error
+	if r.Watch {
+		var err error
+		watcher, err = r.newWatcher()
+		if err != nil {
+			logger.Errorw("Watching policies and signatures", "error", err)
+		} else {
+			defer func() {
+				if err := watcher.Close(); err != nil {
+					logger.Warnw("Closing policies and signatures watcher", "error", err)
+				}
+			}()
+			changes = watcher.Events
+			watchErrors = watcher.Errors
+		}
+	}
+
+	var reload <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-sighup:
+			logger.Infow("Received SIGHUP, reopening output files and reloading policies and signatures")
+			if r.Outputs != nil {
+				r.Outputs.Reopen()
+			}
+			r.reload(t)
+		case change, ok := <-changes:
+			if !ok {
+				changes = nil
+				continue
+			}
+			if !watcher.changed(change) {
+				continue
+			}
+			logger.Debugw("Watched path changed", "path", change.Name, "op", change.Op.String())
+			reload = time.After(reloadDelay)
+		case err, ok := <-watchErrors:
+			if !ok {
+				watchErrors = nil
+				continue
+			}
+			logger.Errorw("Watching policies and signatures", "error", err)
+		case <-reload:
+			reload = nil
+			logger.Infow("Policies or signatures changed, reloading")
+			r.reload(t)
+		}
+	}
+}
+
+// reloadWatcher watches the policy paths and signatures directories of a Reloader
+type reloadWatcher struct {
+	*fsnotify.Watcher
+	policyFiles    map[string]struct{} // policy files given with --policy
+	policyDirs     map[string]struct{} // policy directories given with --policy
+	fileDirs       map[string]struct{} // directories of the policy files
+	signaturesDirs []string
+}
+
+// newWatcher watches the policy paths and signatures directories (recursively).
+// Directories are watched instead of files, so files replaced by a rename (as
+// editors and kubernetes configmaps do) keep being watched.
+func (r *Reloader) newWatcher() (*reloadWatcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, errfmt.WrapError(err)
+	}
+
+	w := &reloadWatcher{
+		Watcher:     watcher,
+		policyFiles: make(map[string]struct{}),
+		policyDirs:  make(map[string]struct{}),
+		fileDirs:    make(map[string]struct{}),
+	}
+
+	for _, path := range r.PolicyPaths {
+		path = filepath.Clean(path)
+		info, err := os.Stat(path)
+		if err != nil {
+			_ = watcher.Close()
+			return nil, errfmt.WrapError(err)
+		}
+		if info.IsDir() {
+			w.policyDirs[path] = struct{}{}
+		} else {
+			w.policyFiles[path] = struct{}{}
+			w.fileDirs[filepath.Dir(path)] = struct{}{}
+		}
+	}
+	for _, dir := range r.SignaturesDirs {
+		w.signaturesDirs = append(w.signaturesDirs, filepath.Clean(dir))
+	}
+
+	for _, dirs := range []map[string]struct{}{w.policyDirs, w.fileDirs} {
+		for dir := range dirs {
+			if err := watcher.Add(dir); err != nil {
+				_ = watcher.Close()
+				return nil, errfmt.Errorf("failed to watch %s: %v", dir, err)
+			}
+		}
+	}
+	for _, dir := range w.signaturesDirs {
+		if err := w.addTree(dir); err != nil {
+			_ = watcher.Close()
+			return nil, err
+		}
+	}
+
+	return w, nil
+}
+
+// addTree watches a directory and its subdirectories
+func (w *reloadWatcher) addTree(root string) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return errfmt.WrapError(err)
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		if err := w.Add(path); err != nil {
+			return errfmt.Errorf("failed to watch %s: %v", path, err)
+		}
+		return nil
+	})
+}
+
+// changed returns true if the event changes the policies or signatures. Directories
+// created in the signatures directories are watched as well.
+func (w *reloadWatcher) changed(event fsnotify.Event) bool {
+	name := filepath.Clean(event.Name)
+	dir := filepath.Dir(name)
+
+	// kubernetes updates a mounted configmap by replacing its ..data symlink, the
+	// files of the configmap are symlinks to it
+	configMapUpdate := filepath.Base(name) == "..data"
+
+	if _, ok := w.policyFiles[name]; ok {
+		return true
+	}
+	if _, ok := w.fileDirs[dir]; ok && configMapUpdate {
+		return true
+	}
+	if _, ok := w.policyDirs[dir]; ok && (configMapUpdate || isPolicyFile(name)) {
+		return true
+	}
+
+	for _, sigDir := range w.signaturesDirs {
+		if !isSubPath(sigDir, name) {
+			continue
+		}
+		if event.Has(fsnotify.Create) {
+			if info, err := os.Stat(name); err == nil && info.IsDir() {
+				if err := w.addTree(name); err != nil {
+					logger.Errorw("Watching signatures", "error", err)
+				}
+				return true
+			}
+		}
+		if isSignatureFile(name) {
+			return true
+		}
+		// a removed or renamed directory may have had signatures
+		return event.Has(fsnotify.Remove|fsnotify.Rename) && filepath.Ext(name) == ""
+	}
+
+	return false
+}
+
+func isPolicyFile(name string) bool {
+	ext := filepath.Ext(name)
+	return ext == ".yaml" || ext == ".yml"
+}
+
+func isSignatureFile(name string) bool {
+	ext := filepath.Ext(name)
+	return ext == ".rego" || ext == ".so"
+}
+
+// isSubPath returns true if path is dir or is in dir
+func isSubPath(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// reload reloads policies and signatures, keeping the running ones on failure
+func (r *Reloader) reload(t *tracker.Tracker) {
+	stats := t.Stats()
+	_ = stats.ReloadCount.Increment()
+
+	if err := r.reloadPolicies(t); err != nil {
+		_ = stats.ReloadErrorCount.Increment()
+		logger.Errorw("Reloading policies, running policies were kept", "error", err)
+	}
+
+	if err := r.reloadSignatures(t); err != nil {
+		_ = stats.ReloadErrorCount.Increment()
+		logger.Errorw("Reloading signatures", "error", err)
+	}
+}
+
+func (r *Reloader) reloadPolicies(t *tracker.Tracker) error {
+	if len(r.PolicyPaths) == 0 {
+		return nil
+	}
+
+	documents, err := v1beta1.PoliciesFromPaths(r.PolicyPaths)
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+
+	return t.ReplacePolicies(documents)
+}
+
+func (r *Reloader) reloadSignatures(t *tracker.Tracker) error {
+	if r.FindSignatures == nil {
+		return nil
+	}
+
+	sigs, err := r.FindSignatures()
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+
+	return t.ReplaceSignatures(sigs)
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReloadWatcherChanged(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	etc := filepath.Join(root, "etc")
+	policiesDir := filepath.Join(root, "policies")
+	signaturesDir := filepath.Join(root, "signatures")
+	for _, dir := range []string{etc, policiesDir, signaturesDir} {
+		require.NoError(t, os.Mkdir(dir, 0o755))
+	}
+	policyFile := filepath.Join(etc, "policy.yaml")
+	require.NoError(t, os.WriteFile(policyFile, nil, 0o644))
+
+	r := &Reloader{
+		PolicyPaths:    []string{policyFile, policiesDir},
+		SignaturesDirs: []string{signaturesDir},
+	}
+	w, err := r.newWatcher()
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, w.Close())
+	}()
+
+	testCases := []struct {
+		name     string
+		path     string
+		op       fsnotify.Op
+		expected bool
+	}{
+		{"policy file", policyFile, fsnotify.Write, true},
+		{"file next to the policy file", filepath.Join(etc, "hosts"), fsnotify.Write, false},
+		{"policy next to the policy file", filepath.Join(etc, "other.yaml"), fsnotify.Create, false},
+		{"configmap next to the policy file", filepath.Join(etc, "..data"), fsnotify.Create, true},
+		{"policy in policy directory", filepath.Join(policiesDir, "p.yml"), fsnotify.Create, true},
+		{"editor file in policy directory", filepath.Join(policiesDir, ".p.yml.swp"), fsnotify.Write, false},
+		{"configmap in policy directory", filepath.Join(policiesDir, "..data"), fsnotify.Create, true},
+		{"rego signature", filepath.Join(signaturesDir, "a", "sig.rego"), fsnotify.Write, true},
+		{"go signature", filepath.Join(signaturesDir, "sig.so"), fsnotify.Remove, true},
+		{"other file in signatures directory", filepath.Join(signaturesDir, "README.md"), fsnotify.Write, false},
+		{"removed signatures subdirectory", filepath.Join(signaturesDir, "a"), fsnotify.Remove, true},
+		{"outside watched paths", filepath.Join(root, "sig.rego"), fsnotify.Write, false},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, w.changed(fsnotify.Event{Name: tc.path, Op: tc.op}), tc.name)
+	}
+
+	// directories created in the signatures directories are watched
+	newDir := filepath.Join(signaturesDir, "new", "nested")
+	require.NoError(t, os.MkdirAll(newDir, 0o755))
+	assert.True(t, w.changed(fsnotify.Event{Name: filepath.Join(signaturesDir, "new"), Op: fsnotify.Create}))
+	assert.Contains(t, w.WatchList(), newDir)
+}
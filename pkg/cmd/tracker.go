@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"os"
 	"strconv"
 	"syscall"
@@ -13,6 +14,7 @@ import (
 	"github.com/khulnasoft-lab/tracker/pkg/logger"
 	"github.com/khulnasoft-lab/tracker/pkg/server/grpc"
 	"github.com/khulnasoft-lab/tracker/pkg/server/http"
+	"github.com/khulnasoft-lab/tracker/pkg/streams"
 	"github.com/khulnasoft-lab/tracker/pkg/utils"
 )
 
@@ -22,9 +24,15 @@ type Runner struct {
 	InstallPath   string
 	HTTPServer    *http.Server
 	GRPCServer    *grpc.Server
+	Reloader      *Reloader
+	PolicyWatcher *PolicyWatcher
 }
 
 func (r Runner) Run(ctx context.Context) error {
+	// Stopped with a cause when tracker must be restarted (e.g. to apply policies)
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
 	// Create Tracker Singleton
 
 	t, err := tracker.New(r.TrackerConfig)
@@ -32,6 +40,12 @@ func (r Runner) Run(ctx context.Context) error {
 		return errfmt.Errorf("error creating Tracker: %v", err)
 	}
 
+	// Count the printers deliveries in the tracker stats
+
+	if p, ok := r.Printer.(printer.StatsSetter); ok {
+		p.SetStats(t.Stats())
+	}
+
 	// Readiness Callback: Tracker is ready to receive events
 	t.AddReadyCallback(
 		func(ctx context.Context) {
@@ -42,6 +56,20 @@ func (r Runner) Run(ctx context.Context) error {
 					if err := t.Stats().RegisterPrometheus(); err != nil {
 						logger.Errorw("Registering prometheus metrics", "error", err)
 					}
+					if err := t.Pipeline().RegisterPrometheus(); err != nil {
+						logger.Errorw("Registering pipeline prometheus metrics", "error", err)
+					}
+					if err := t.Streams().RegisterPrometheus(); err != nil {
+						logger.Errorw("Registering streams prometheus metrics", "error", err)
+					}
+					if r.TrackerConfig.Redactor != nil {
+						if err := r.TrackerConfig.Redactor.RegisterPrometheus(); err != nil {
+							logger.Errorw("Registering redaction prometheus metrics", "error", err)
+						}
+					}
+				}
+				if r.HTTPServer.APIEndpointEnabled() {
+					r.HTTPServer.SetAPIHandler(grpc.APIPrefix, grpc.NewGateway(t))
 				}
 				go r.HTTPServer.Start(ctx)
 			}
@@ -50,6 +78,17 @@ func (r Runner) Run(ctx context.Context) error {
 			if r.GRPCServer != nil {
 				go r.GRPCServer.Start(ctx, t, t.Engine())
 			}
+
+			if r.Reloader != nil {
+				go r.Reloader.Run(ctx, t)
+			}
+			if r.PolicyWatcher != nil {
+				go func() {
+					if err := r.PolicyWatcher.Run(ctx, t); err != nil {
+						cancel(err)
+					}
+				}()
+			}
 		},
 	)
 
@@ -84,7 +123,10 @@ func (r Runner) Run(ctx context.Context) error {
 		}
 	}()
 
-	stream := t.SubscribeAll()
+	stream, err := t.SubscribeAll(streams.Config{})
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
 	defer t.Unsubscribe(stream)
 
 	// Preeamble
@@ -97,7 +139,7 @@ func (r Runner) Run(ctx context.Context) error {
 		for {
 			select {
 			case event := <-stream.ReceiveEvents():
-				r.Printer.Print(event)
+				r.Printer.Print(event.Event)
 			case <-ctx.Done():
 				return
 			}
@@ -110,13 +152,17 @@ func (r Runner) Run(ctx context.Context) error {
 	// Drain remaininig channel events (sent during shutdown),
 	// the channel is closed by the tracker when it's done
 	for event := range stream.ReceiveEvents() {
-		r.Printer.Print(event)
+		r.Printer.Print(event.Event)
 	}
 
 	stats := t.Stats()
 	r.Printer.Epilogue(*stats)
 	r.Printer.Close()
 
+	if cause := context.Cause(ctx); err == nil && errors.Is(cause, tracker.ErrRestartRequired) {
+		err = cause
+	}
+
 	return err
 }
 
@@ -89,6 +89,14 @@ func GetTrackerRunner(c *cli.Context, version string) (cmd.Runner, error) {
 	}
 	cfg.ProcTree = procTree
 
+	// Redact command line flags
+
+	redactor, err := flags.PrepareRedact(c.StringSlice("redact"))
+	if err != nil {
+		return runner, err
+	}
+	cfg.Redactor = redactor
+
 	// Capture command line flags
 
 	capture, err := flags.PrepareCapture(c.StringSlice("capture"), false)
@@ -177,12 +185,21 @@ func GetTrackerRunner(c *cli.Context, version string) (cmd.Runner, error) {
 		c.Bool(server.HealthzEndpointFlag),
 		c.Bool(server.PProfEndpointFlag),
 		c.Bool(server.PyroscopeAgentFlag),
+		c.Bool(server.APIEndpointFlag),
 	)
 
 	if err != nil {
 		return runner, err
 	}
 
+	authenticator, err := server.PrepareServerAuth(c.StringSlice(server.ServerAuthFlag))
+	if err != nil {
+		return runner, err
+	}
+	if authenticator != nil && httpServer != nil {
+		httpServer.SetAuthenticator(authenticator)
+	}
+
 	runner.HTTPServer = httpServer
 	runner.TrackerConfig = cfg
 	runner.Printer = broadcast
@@ -2,13 +2,16 @@ package config
 
 import (
 	"io"
+	"time"
 
 	"github.com/khulnasoft-lab/tracker/pkg/containers/runtime"
 	"github.com/khulnasoft-lab/tracker/pkg/dnscache"
 	"github.com/khulnasoft-lab/tracker/pkg/errfmt"
 	"github.com/khulnasoft-lab/tracker/pkg/events/queue"
+	k8s "github.com/khulnasoft-lab/tracker/pkg/k8s/apis/tracker.khulnasoft.com/v1beta1"
 	"github.com/khulnasoft-lab/tracker/pkg/policy"
 	"github.com/khulnasoft-lab/tracker/pkg/proctree"
+	"github.com/khulnasoft-lab/tracker/pkg/redact"
 	"github.com/khulnasoft-lab/tracker/pkg/signatures/engine"
 	"github.com/khulnasoft-lab/tracker/pkg/utils/environment"
 )
@@ -16,6 +19,8 @@ import (
 // Config is a struct containing user defined configuration of tracker
 type Config struct {
 	Policies           *policy.Policies
+	PolicyDocuments    []k8s.PolicyInterface // documents Policies were created from (nil if created from flags)
+	PolicyCompiler     PolicyCompiler
 	Capture            *CaptureConfig
 	Capabilities       *CapabilitiesConfig
 	Output             *OutputConfig
@@ -33,8 +38,16 @@ type Config struct {
 	EngineConfig       engine.Config
 	MetricsEnabled     bool
 	DNSCacheConfig     dnscache.Config
+	StreamReplaySize   int              // number of events kept to be replayed to resumed streams
+	Redactor           *redact.Redactor // masks sensitive data in event arguments, nil if disabled
 }
 
+// PolicyCompiler compiles policy documents into policies, with the index of each
+// document as its policy ID (nil documents leave their ID unused). It is given as
+// a callback since the compilation logic lives in the flags package, which can't
+// be imported by tracker.
+type PolicyCompiler func(documents []k8s.PolicyInterface) (*policy.Policies, error)
+
 // Validate does static validation of the configuration
 func (c Config) Validate() error {
 	// Buffer sizes
@@ -63,6 +76,11 @@ func (c Config) Validate() error {
 		}
 	}
 
+	// Streams
+	if c.StreamReplaySize < 0 {
+		return errfmt.Errorf("invalid stream replay buffer size - must be positive")
+	}
+
 	// BPF
 	if c.BPFObjBytes == nil {
 		return errfmt.Errorf("nil bpf object in memory")
@@ -168,6 +186,9 @@ type OutputConfig struct {
 	ParseArguments    bool
 	ParseArgumentsFDs bool
 	EventsSorting     bool
+
+	Labels     map[string]string // static labels added to every event
+	NodeLabels bool              // add the labels identifying the node to every event
 }
 
 type ContainerMode int
@@ -184,4 +205,40 @@ type PrinterConfig struct {
 	OutFile       io.WriteCloser
 	ContainerMode ContainerMode
 	RelativeTS    bool
+	Rotation      FileRotation   // file outputs only
+	Projection    JSONProjection // json outputs only
+	Route         PrinterRoute
+}
+
+// JSONProjection shapes the events of a json output
+type JSONProjection struct {
+	Fields    []string // dot separated paths of the fields to keep, all fields if empty
+	OmitEmpty bool     // omit the fields with empty values
+}
+
+// Enabled returns true if the events are shaped
+func (p JSONProjection) Enabled() bool {
+	return len(p.Fields) > 0 || p.OmitEmpty
+}
+
+// PrinterRoute selects the events sent to a printer. Empty selectors select all events,
+// and an event must match all the given selectors.
+type PrinterRoute struct {
+	Policies     []string // events matched by any of the policies
+	Events       []string // events with any of the names
+	FindingsOnly bool     // events created by signatures
+	MinSeverity  int      // findings with at least the severity (0 to 4), 0 selects all
+}
+
+// FileRotation configures the rotation of a file output
+type FileRotation struct {
+	MaxSize    int64         // size in bytes to rotate the file at, 0 disables the rotation
+	MaxAge     time.Duration // maximum age of the rotated files, 0 keeps them regardless of age
+	MaxBackups int           // maximum number of rotated files, 0 keeps them all
+	Compress   string        // compression of the rotated files: none (or empty), gzip or zstd
+}
+
+// Enabled returns true if the file output is rotated
+func (r FileRotation) Enabled() bool {
+	return r.MaxSize > 0
 }
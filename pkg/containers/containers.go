@@ -9,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 	"sync"
 	"syscall"
@@ -412,6 +413,64 @@ func (c *Containers) FindContainerCgroupID32LSB(containerID string) ([]uint32, e
 	return cgroupIDs, nil
 }
 
+var (
+	ErrContainerNotFound  = errors.New("container not found")
+	ErrContainerAmbiguous = errors.New("container id is ambiguous")
+)
+
+// GetContainerPids returns the pids of the processes currently in the root cgroup of a
+// container, given by its ID or a unique prefix of it.
+func (c *Containers) GetContainerPids(containerID string) ([]int, error) {
+	c.cgroupsMutex.RLock()
+	var path string
+	found := 0
+	for _, info := range c.cgroupsMap {
+		if !info.ContainerRoot || info.Dead || info.Container.ContainerId == "" ||
+			!strings.HasPrefix(info.Container.ContainerId, containerID) {
+			continue
+		}
+		path = info.Path
+		found++
+	}
+	c.cgroupsMutex.RUnlock()
+
+	switch {
+	case found == 0:
+		return nil, fmt.Errorf("%w: %s", ErrContainerNotFound, containerID)
+	case found > 1:
+		return nil, fmt.Errorf("%w: %s", ErrContainerAmbiguous, containerID)
+	}
+
+	procs := filepath.Join(c.cgroups.GetDefaultCgroup().GetMountPoint(), path, "cgroup.procs")
+	file, err := os.Open(procs)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", ErrContainerNotFound, containerID)
+	}
+	if err != nil {
+		return nil, errfmt.WrapError(err)
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			logger.Errorw("Closing file", "error", err)
+		}
+	}()
+
+	var pids []int
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		pid, err := strconv.Atoi(strings.TrimSpace(scanner.Text()))
+		if err != nil {
+			continue
+		}
+		pids = append(pids, pid)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errfmt.WrapError(err)
+	}
+
+	return pids, nil
+}
+
 // GetCgroupInfo returns the contents of the Containers struct cgroupInfo data of a given cgroupId.
 func (c *Containers) GetCgroupInfo(cgroupId uint64) CgroupInfo {
 	if !c.CgroupExists(cgroupId) {
@@ -0,0 +1,451 @@
+package ebpf
+
+import (
+	"bytes"
+	gocontext "context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"syscall"
+	"time"
+
+	"golang.org/x/time/rate"
+	"kernel.org/pub/linux/libs/security/libcap/cap"
+
+	"github.com/khulnasoft-lab/tracker/pkg/capabilities"
+	"github.com/khulnasoft-lab/tracker/pkg/errfmt"
+	"github.com/khulnasoft-lab/tracker/pkg/events"
+	"github.com/khulnasoft-lab/tracker/pkg/logger"
+	"github.com/khulnasoft-lab/tracker/pkg/metrics"
+	"github.com/khulnasoft-lab/tracker/pkg/policy"
+	"github.com/khulnasoft-lab/tracker/pkg/policy/actions"
+	"github.com/khulnasoft-lab/tracker/pkg/utils"
+	"github.com/khulnasoft-lab/tracker/types/trace"
+)
+
+const (
+	actionsQueueSize   = 1024 // pending webhooks and captures
+	actionsWorkers     = 4
+	actionsHTTPTimeout = 5 * time.Second
+
+	dedupMaxEntries          = 1 << 16 // distinct events tracked within their dedup windows
+	rateLimitSummaryInterval = 10 * time.Second
+)
+
+// ruleActions runs the actions of the policy rules matched by the events in the sink
+// stage. Rate limits, dedups and signals are applied right away, while webhooks and
+// captures are done in background workers, so they don't stall the pipeline.
+//
+// The events dropped by rate limits and dedups are summed up in suppressed_events events,
+// periodically returned by summaries().
+type ruleActions struct {
+	stats    *metrics.Stats
+	outDir   *os.File
+	client   *http.Client
+	queue    chan func() error
+	limiters map[rateLimiterKey]*rate.Limiter // only accessed by the sink stage (as below)
+	limited  map[rateLimiterKey]*suppressed
+	deduped  map[dedupKey]*suppressed
+	pending  []*trace.Event // summaries of dedup windows replaced before expiring
+}
+
+// rateLimiterKey identifies the limiter of a policy rule (a changed rate gets a new one)
+type rateLimiterKey struct {
+	policyName string
+	ruleId     events.ID
+	rate       int
+}
+
+// dedupKey identifies the identical events of a policy rule
+type dedupKey struct {
+	policyName string
+	ruleId     events.ID
+	hash       uint64 // hash of the event, process and dedup keys data
+}
+
+// suppressed counts the events of a policy rule dropped since start
+type suppressed struct {
+	summary *trace.Event // suppressed_events event, without its arguments
+	reason  actions.Kind
+	start   time.Time
+	window  time.Duration
+	count   uint64
+}
+
+func newRuleActions(ctx gocontext.Context, outDir *os.File, stats *metrics.Stats) *ruleActions {
+	ra := &ruleActions{
+		stats:    stats,
+		outDir:   outDir,
+		client:   &http.Client{Timeout: actionsHTTPTimeout},
+		queue:    make(chan func() error, actionsQueueSize),
+		limiters: make(map[rateLimiterKey]*rate.Limiter),
+		limited:  make(map[rateLimiterKey]*suppressed),
+		deduped:  make(map[dedupKey]*suppressed),
+	}
+
+	for i := 0; i < actionsWorkers; i++ {
+		go ra.work(ctx)
+	}
+
+	return ra
+}
+
+func (ra *ruleActions) work(ctx gocontext.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case action := <-ra.queue:
+			if err := action(); err != nil {
+				_ = ra.stats.ActionErrorCount.Increment()
+				logger.Debugw("Running rule action", "error", err)
+			}
+		}
+	}
+}
+
+// rateLimit returns the matched policies whose rule didn't exceed its rate limit
+func (ra *ruleActions) rateLimit(matched uint64, ruleId events.ID, matchedActions []policy.RuleActions) uint64 {
+	for _, rule := range matchedActions {
+		for _, a := range rule.Actions {
+			if a.Kind != actions.RateLimit {
+				continue
+			}
+			key := rateLimiterKey{policyName: rule.PolicyName, ruleId: ruleId, rate: a.Rate}
+			limiter, ok := ra.limiters[key]
+			if !ok {
+				limiter = rate.NewLimiter(rate.Limit(a.Rate), a.Rate)
+				ra.limiters[key] = limiter
+			}
+			if !limiter.Allow() {
+				utils.ClearBit(&matched, uint(rule.PolicyID))
+				_ = ra.stats.RateLimitedCount.Increment()
+
+				s, ok := ra.limited[key]
+				if !ok {
+					s = &suppressed{
+						summary: summaryEvent(rule, &trace.Event{ProcessName: "tracker"}),
+						reason:  actions.RateLimit,
+						start:   time.Now(),
+						window:  rateLimitSummaryInterval,
+					}
+					ra.limited[key] = s
+				}
+				s.count++
+			}
+		}
+	}
+
+	return matched
+}
+
+// dedup returns the matched policies whose rule didn't see an identical event (same event,
+// process and dedup keys data) within its dedup window
+func (ra *ruleActions) dedup(event *trace.Event, matched uint64, ruleId events.ID, matchedActions []policy.RuleActions, now time.Time) uint64 {
+	for _, rule := range matchedActions {
+		if !utils.HasBit(matched, uint(rule.PolicyID)) {
+			continue
+		}
+
+		for _, a := range rule.Actions {
+			if a.Kind != actions.Dedup {
+				continue
+			}
+			key := dedupKey{policyName: rule.PolicyName, ruleId: ruleId, hash: dedupHash(event, a.Keys)}
+
+			s, ok := ra.deduped[key]
+			if ok && now.Before(s.start.Add(a.Window)) {
+				utils.ClearBit(&matched, uint(rule.PolicyID))
+				_ = ra.stats.DedupCount.Increment()
+				s.count++
+				continue
+			}
+			if ok && s.count > 0 {
+				ra.pending = append(ra.pending, s.summaryEvent(ruleId))
+			}
+			if !ok && len(ra.deduped) >= dedupMaxEntries {
+				continue // too many distinct events: let them through
+			}
+
+			// first of the identical events: the following ones are counted in its window
+			ra.deduped[key] = &suppressed{
+				summary: summaryEvent(rule, event),
+				reason:  actions.Dedup,
+				start:   now,
+				window:  a.Window,
+			}
+		}
+	}
+
+	return matched
+}
+
+// dedupHash hashes the event, its process and the given data of the event (all if empty)
+func dedupHash(event *trace.Event, keys []string) uint64 {
+	h := fnv.New64a()
+	_, _ = fmt.Fprintf(h, "%d|%d|%s|%s", event.EventID, event.HostProcessID, event.ProcessName, event.Container.ID)
+
+	for _, arg := range event.Args {
+		if len(keys) > 0 && !slices.Contains(keys, arg.Name) {
+			continue
+		}
+		_, _ = fmt.Fprintf(h, "|%s=%v", arg.Name, arg.Value)
+	}
+
+	return h.Sum64()
+}
+
+// summaries returns the suppressed_events events of the rate limits and dedup windows
+// which ended by now, and forgets about them.
+func (ra *ruleActions) summaries(now time.Time) []*trace.Event {
+	summaries := ra.pending
+	ra.pending = nil
+
+	for key, s := range ra.limited {
+		if now.Before(s.start.Add(s.window)) {
+			continue
+		}
+		summaries = append(summaries, s.summaryEvent(key.ruleId))
+		delete(ra.limited, key)
+	}
+
+	for key, s := range ra.deduped {
+		if now.Before(s.start.Add(s.window)) {
+			continue
+		}
+		if s.count > 0 {
+			summaries = append(summaries, s.summaryEvent(key.ruleId))
+		}
+		delete(ra.deduped, key)
+	}
+
+	return summaries
+}
+
+// summaryEvent returns a suppressed_events event of the given rule, in the context of the
+// process of the given event
+func summaryEvent(rule policy.RuleActions, event *trace.Event) *trace.Event {
+	def := events.Core.GetDefinitionByID(events.SuppressedEvents)
+
+	return &trace.Event{
+		ProcessID:           event.ProcessID,
+		CgroupID:            event.CgroupID,
+		ThreadID:            event.ThreadID,
+		ParentProcessID:     event.ParentProcessID,
+		HostProcessID:       event.HostProcessID,
+		HostThreadID:        event.HostThreadID,
+		HostParentProcessID: event.HostParentProcessID,
+		UserID:              event.UserID,
+		MountNS:             event.MountNS,
+		PIDNS:               event.PIDNS,
+		ProcessName:         event.ProcessName,
+		HostName:            event.HostName,
+		ContainerID:         event.ContainerID,
+		Container:           event.Container,
+		Kubernetes:          event.Kubernetes,
+		EventID:             int(events.SuppressedEvents),
+		EventName:           def.GetName(),
+		MatchedPoliciesUser: 1 << rule.PolicyID,
+		MatchedPolicies:     []string{rule.PolicyName},
+	}
+}
+
+// summaryEvent returns a copy of the summary event, completed with the count of suppressed
+// events. The copy is owned by the caller, so it can go to the events pool once sent.
+func (s *suppressed) summaryEvent(ruleId events.ID) *trace.Event {
+	params := events.Core.GetDefinitionByID(events.SuppressedEvents).GetParams()
+
+	event := *s.summary
+	event.Timestamp = int(time.Now().UnixNano())
+	event.Args = []trace.Argument{
+		{ArgMeta: params[0], Value: events.Core.GetDefinitionByID(ruleId).GetName()},
+		{ArgMeta: params[1], Value: string(s.reason)},
+		{ArgMeta: params[2], Value: s.count},
+	}
+	event.ArgsNum = len(event.Args)
+
+	return &event
+}
+
+// respond runs the webhook, capture and signal actions of the rules of the policies
+// matched by the event. Each action is run once per event, even if set by many rules.
+func (ra *ruleActions) respond(event *trace.Event, matchedActions []policy.RuleActions) {
+	var payload []byte
+	var captured, signaled bool
+	posted := map[string]bool{}
+
+	for _, rule := range matchedActions {
+		if !utils.HasBit(event.MatchedPoliciesUser, uint(rule.PolicyID)) {
+			continue // dropped by a rate limit
+		}
+
+		for _, a := range rule.Actions {
+			var err error
+
+			switch a.Kind {
+			case actions.Webhook:
+				if posted[a.URL] {
+					continue
+				}
+				posted[a.URL] = true
+				if payload == nil {
+					// the event goes back to the pool, so it is serialized right away
+					payload, err = json.Marshal(event)
+					if err != nil {
+						break
+					}
+				}
+				err = ra.enqueue(ra.webhook(a.URL, payload))
+				_ = ra.stats.WebhookActionCount.Increment()
+
+			case actions.Capture:
+				if captured {
+					continue
+				}
+				captured = true
+				err = ra.capture(event)
+				_ = ra.stats.CaptureActionCount.Increment()
+
+			case actions.Signal:
+				if signaled {
+					continue
+				}
+				signaled = true
+				err = ra.signal(event, a.Signal)
+				_ = ra.stats.SignalActionCount.Increment()
+
+			default:
+				continue
+			}
+
+			if err != nil {
+				_ = ra.stats.ActionErrorCount.Increment()
+				logger.Debugw("Running rule action", "action", a.String(), "error", err)
+			}
+		}
+	}
+}
+
+func (ra *ruleActions) enqueue(action func() error) error {
+	select {
+	case ra.queue <- action:
+		return nil
+	default:
+		return errfmt.Errorf("rule actions queue is full")
+	}
+}
+
+// webhook returns a function posting the given event payload to the given url
+func (ra *ruleActions) webhook(url string, payload []byte) func() error {
+	return func() error {
+		resp, err := ra.client.Post(url, "application/json", bytes.NewReader(payload))
+		if err != nil {
+			return errfmt.WrapError(err)
+		}
+		defer func() {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}()
+
+		if resp.StatusCode >= http.StatusBadRequest {
+			return errfmt.Errorf("webhook %s returned status %d", url, resp.StatusCode)
+		}
+
+		return nil
+	}
+}
+
+// capture saves the executable of the process (and the event itself) in the capture
+// output directory. The executable is opened right away, so it can still be copied if
+// the process exits (or is killed by a signal action).
+func (ra *ruleActions) capture(event *trace.Event) error {
+	if ra.outDir == nil {
+		return errfmt.Errorf("capture directory is not initialized")
+	}
+
+	var exe *os.File
+	err := capabilities.GetInstance().Specific(
+		func() error {
+			var err error
+			exe, err = os.Open(fmt.Sprintf("/proc/%d/exe", event.HostProcessID))
+			return err
+		},
+		cap.DAC_OVERRIDE,
+		cap.SYS_PTRACE,
+	)
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+
+	eventJSON, err := json.Marshal(event)
+	if err != nil {
+		_ = exe.Close()
+		return errfmt.WrapError(err)
+	}
+
+	containerId := event.Container.ID
+	if containerId == "" {
+		containerId = "host"
+	}
+	name := fmt.Sprintf("capture.%d.%d.%s", event.Timestamp, event.HostProcessID, event.ProcessName)
+
+	err = ra.enqueue(func() error {
+		defer func() {
+			if err := exe.Close(); err != nil {
+				logger.Errorw("Closing file", "error", err)
+			}
+		}()
+
+		if err := utils.MkdirAtExist(ra.outDir, containerId, 0755); err != nil {
+			return errfmt.WrapError(err)
+		}
+		if err := writeCaptureFile(ra.outDir, filepath.Join(containerId, name), exe); err != nil {
+			return err
+		}
+
+		return writeCaptureFile(ra.outDir, filepath.Join(containerId, name+".json"), bytes.NewReader(eventJSON))
+	})
+	if err != nil {
+		_ = exe.Close()
+	}
+
+	return err
+}
+
+func writeCaptureFile(dir *os.File, name string, src io.Reader) error {
+	dst, err := utils.CreateAt(dir, name)
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+	defer func() {
+		if err := dst.Close(); err != nil {
+			logger.Errorw("Closing file", "error", err)
+		}
+	}()
+
+	if _, err := io.Copy(dst, src); err != nil {
+		return errfmt.WrapError(err)
+	}
+
+	return nil
+}
+
+// signal sends the given signal to the process of the event
+func (ra *ruleActions) signal(event *trace.Event, sig syscall.Signal) error {
+	pid := event.HostProcessID
+	if pid <= 1 || pid == os.Getpid() {
+		return errfmt.Errorf("refusing to send %v to pid %d", sig, pid)
+	}
+
+	return capabilities.GetInstance().Specific(
+		func() error {
+			return syscall.Kill(pid, sig)
+		},
+		cap.KILL,
+	)
+}
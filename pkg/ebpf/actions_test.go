@@ -0,0 +1,134 @@
+package ebpf
+
+import (
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+
+	"github.com/khulnasoft-lab/tracker/pkg/events"
+	"github.com/khulnasoft-lab/tracker/pkg/metrics"
+	"github.com/khulnasoft-lab/tracker/pkg/policy"
+	"github.com/khulnasoft-lab/tracker/pkg/policy/actions"
+	"github.com/khulnasoft-lab/tracker/types/trace"
+)
+
+func TestRuleActions_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	ra := &ruleActions{
+		stats:    &metrics.Stats{},
+		limiters: make(map[rateLimiterKey]*rate.Limiter),
+		limited:  make(map[rateLimiterKey]*suppressed),
+	}
+
+	matchedActions := []policy.RuleActions{
+		{
+			PolicyID:   0,
+			PolicyName: "limited",
+			Actions:    []actions.Action{{Kind: actions.RateLimit, Rate: 2}},
+		},
+		{
+			PolicyID:   1,
+			PolicyName: "responding",
+			Actions:    []actions.Action{{Kind: actions.Capture}},
+		},
+	}
+
+	// the burst equals the rate, so the first 2 events pass
+	assert.Equal(t, uint64(0b11), ra.rateLimit(0b11, events.Openat, matchedActions))
+	assert.Equal(t, uint64(0b11), ra.rateLimit(0b11, events.Openat, matchedActions))
+	assert.Equal(t, uint64(0b10), ra.rateLimit(0b11, events.Openat, matchedActions))
+	assert.Equal(t, uint64(1), ra.stats.RateLimitedCount.Get())
+
+	// limiters are per rule
+	assert.Equal(t, uint64(0b11), ra.rateLimit(0b11, events.Execve, matchedActions))
+
+	// the dropped events are summed up once the summary interval ends
+	assert.Empty(t, ra.summaries(time.Now()))
+	summaries := ra.summaries(time.Now().Add(rateLimitSummaryInterval))
+	require.Len(t, summaries, 1)
+	assert.Equal(t, "suppressed_events", summaries[0].EventName)
+	assert.Equal(t, []string{"limited"}, summaries[0].MatchedPolicies)
+	assert.Equal(t, uint64(0b01), summaries[0].MatchedPoliciesUser)
+	assert.Equal(t, "openat", summaries[0].Args[0].Value)
+	assert.Equal(t, "rate-limit", summaries[0].Args[1].Value)
+	assert.Equal(t, uint64(1), summaries[0].Args[2].Value)
+	assert.Empty(t, ra.limited)
+}
+
+func TestRuleActions_Dedup(t *testing.T) {
+	t.Parallel()
+
+	ra := &ruleActions{
+		stats:   &metrics.Stats{},
+		deduped: make(map[dedupKey]*suppressed),
+	}
+
+	matchedActions := []policy.RuleActions{
+		{
+			PolicyID:   1,
+			PolicyName: "deduped",
+			Actions:    []actions.Action{{Kind: actions.Dedup, Window: 10 * time.Second, Keys: []string{"pathname"}}},
+		},
+		{
+			PolicyID:   2,
+			PolicyName: "responding",
+			Actions:    []actions.Action{{Kind: actions.Capture}},
+		},
+	}
+
+	open := func(pid int, pathname string, flags int) *trace.Event {
+		return &trace.Event{
+			EventID:       int(events.SecurityFileOpen),
+			HostProcessID: pid,
+			ProcessName:   "cat",
+			Args: []trace.Argument{
+				{ArgMeta: trace.ArgMeta{Name: "pathname"}, Value: pathname},
+				{ArgMeta: trace.ArgMeta{Name: "flags"}, Value: flags},
+			},
+		}
+	}
+
+	start := time.Now()
+	id := events.SecurityFileOpen
+
+	assert.Equal(t, uint64(0b110), ra.dedup(open(10, "/etc/passwd", 0), 0b110, id, matchedActions, start))
+	// identical events (flags are not a dedup key) are dropped within the window
+	assert.Equal(t, uint64(0b100), ra.dedup(open(10, "/etc/passwd", 1), 0b110, id, matchedActions, start.Add(time.Second)))
+	assert.Equal(t, uint64(0b100), ra.dedup(open(10, "/etc/passwd", 0), 0b110, id, matchedActions, start.Add(2*time.Second)))
+	// other pathnames or processes are not
+	assert.Equal(t, uint64(0b110), ra.dedup(open(10, "/etc/shadow", 0), 0b110, id, matchedActions, start.Add(time.Second)))
+	assert.Equal(t, uint64(0b110), ra.dedup(open(11, "/etc/passwd", 0), 0b110, id, matchedActions, start.Add(time.Second)))
+	assert.Equal(t, uint64(2), ra.stats.DedupCount.Get())
+
+	// windows without dropped events are not summed up
+	assert.Empty(t, ra.summaries(start.Add(5*time.Second)))
+	summaries := ra.summaries(start.Add(11 * time.Second))
+	require.Len(t, summaries, 1)
+	assert.Equal(t, 10, summaries[0].HostProcessID)
+	assert.Equal(t, "cat", summaries[0].ProcessName)
+	assert.Equal(t, []string{"deduped"}, summaries[0].MatchedPolicies)
+	assert.Equal(t, "security_file_open", summaries[0].Args[0].Value)
+	assert.Equal(t, "dedup", summaries[0].Args[1].Value)
+	assert.Equal(t, uint64(2), summaries[0].Args[2].Value)
+	assert.Empty(t, ra.deduped)
+
+	// a new window starts after the previous one ended
+	assert.Equal(t, uint64(0b110), ra.dedup(open(10, "/etc/passwd", 0), 0b110, id, matchedActions, start.Add(12*time.Second)))
+}
+
+func TestRuleActions_SignalRefused(t *testing.T) {
+	t.Parallel()
+
+	ra := &ruleActions{stats: &metrics.Stats{}}
+
+	for _, pid := range []int{0, 1, os.Getpid()} {
+		err := ra.signal(&trace.Event{HostProcessID: pid}, syscall.SIGKILL)
+		assert.Error(t, err, "pid %d", pid)
+	}
+}
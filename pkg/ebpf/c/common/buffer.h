@@ -470,7 +470,15 @@ statfunc int events_perf_submit(program_data_t *p, long ret)
                  :
                  : [size] "r"(size), [max_size] "i"(MAX_EVENT_SIZE));
 
-    return bpf_perf_event_output(p->ctx, &events, BPF_F_CURRENT_CPU, p->event, size);
+    int err = bpf_perf_event_output(p->ctx, &events, BPF_F_CURRENT_CPU, p->event, size);
+    if (err < 0) {
+        u32 cpu = bpf_get_smp_processor_id();
+        u64 *lost = bpf_map_lookup_elem(&events_lost, &cpu);
+        if (lost != NULL)
+            __sync_fetch_and_add(lost, 1);
+    }
+
+    return err;
 }
 
 statfunc int signal_perf_submit(void *ctx, controlplane_signal_t *sig)
@@ -616,6 +616,16 @@ struct events {
 
 typedef struct events events_t;
 
+// events lost in the submission of each cpu
+struct events_lost {
+    __uint(type, BPF_MAP_TYPE_ARRAY);
+    __uint(max_entries, 1024);
+    __type(key, u32);
+    __type(value, u64);
+} events_lost SEC(".maps");
+
+typedef struct events_lost events_lost_t;
+
 // file writes events submission
 struct file_writes {
     __uint(type, BPF_MAP_TYPE_PERF_EVENT_ARRAY);
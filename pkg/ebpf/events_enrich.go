@@ -11,6 +11,7 @@ import (
 	"github.com/khulnasoft-lab/tracker/pkg/events"
 	"github.com/khulnasoft-lab/tracker/pkg/events/parse"
 	"github.com/khulnasoft-lab/tracker/pkg/logger"
+	"github.com/khulnasoft-lab/tracker/pkg/metrics"
 	"github.com/khulnasoft-lab/tracker/types/trace"
 )
 
@@ -91,6 +92,7 @@ func (t *Tracker) enrichContainerEvents(ctx gocontext.Context, in <-chan *trace.
 				if event == nil {
 					continue // might happen during initialization (ctrl+c seg faults)
 				}
+				_ = t.pipeline.Stage(metrics.EnrichStage).Received.Increment()
 				eventID := events.ID(event.EventID)
 				// send out irrelevant events (non container or already enriched), don't skip the cgroup lifecycle events
 				if (event.Container.ID == "" || event.Container.Name != "") &&
@@ -6,12 +6,15 @@ import (
 	"encoding/binary"
 	"strconv"
 	"sync"
+	"time"
 	"unsafe"
 
 	"github.com/khulnasoft-lab/tracker/pkg/bufferdecoder"
 	"github.com/khulnasoft-lab/tracker/pkg/errfmt"
 	"github.com/khulnasoft-lab/tracker/pkg/events"
 	"github.com/khulnasoft-lab/tracker/pkg/logger"
+	"github.com/khulnasoft-lab/tracker/pkg/metrics"
+	"github.com/khulnasoft-lab/tracker/pkg/policy"
 	"github.com/khulnasoft-lab/tracker/pkg/utils"
 	"github.com/khulnasoft-lab/tracker/types/trace"
 )
@@ -33,12 +36,16 @@ func (t *Tracker) handleEvents(ctx context.Context, initialized chan<- struct{})
 
 	// Decode stage: events are read from the perf buffer and decoded into trace.Event type.
 
+	t.pipeline.Stage(metrics.DecodeStage).SetQueue(
+		func() int { return len(t.eventsChannel) }, cap(t.eventsChannel),
+	)
 	eventsChan, errc := t.decodeEvents(ctx, t.eventsChannel)
 	errcList = append(errcList, errc)
 
 	// Cache stage: events go through a caching function.
 
 	if t.config.Cache != nil {
+		t.setStageQueue(metrics.CacheStage, eventsChan)
 		eventsChan, errc = t.queueEvents(ctx, eventsChan)
 		errcList = append(errcList, errc)
 	}
@@ -46,36 +53,42 @@ func (t *Tracker) handleEvents(ctx context.Context, initialized chan<- struct{})
 	// Sort stage: events go through a sorting function.
 
 	if t.config.Output.EventsSorting {
+		t.setStageQueue(metrics.SortStage, eventsChan)
 		eventsChan, errc = t.eventsSorter.StartPipeline(ctx, eventsChan)
 		errcList = append(errcList, errc)
 	}
 
 	// Process events stage: events go through a processing functions.
 
+	t.setStageQueue(metrics.ProcessStage, eventsChan)
 	eventsChan, errc = t.processEvents(ctx, eventsChan)
 	errcList = append(errcList, errc)
 
 	// Enrichment stage: container events are enriched with additional runtime data.
 
 	if !t.config.NoContainersEnrich { // TODO: remove safe-guard soon.
+		t.setStageQueue(metrics.EnrichStage, eventsChan)
 		eventsChan, errc = t.enrichContainerEvents(ctx, eventsChan)
 		errcList = append(errcList, errc)
 	}
 
 	// Derive events stage: events go through a derivation function.
 
+	t.setStageQueue(metrics.DeriveStage, eventsChan)
 	eventsChan, errc = t.deriveEvents(ctx, eventsChan)
 	errcList = append(errcList, errc)
 
 	// Engine events stage: events go through the signatures engine for detection.
 
 	if t.config.EngineConfig.Enabled {
+		t.setStageQueue(metrics.EngineStage, eventsChan)
 		eventsChan, errc = t.engineEvents(ctx, eventsChan)
 		errcList = append(errcList, errc)
 	}
 
 	// Sink pipeline stage: events go through printers.
 
+	t.setStageQueue(metrics.SinkStage, eventsChan)
 	errc = t.sinkEvents(ctx, eventsChan)
 	errcList = append(errcList, errc)
 
@@ -88,6 +101,23 @@ func (t *Tracker) handleEvents(ctx context.Context, initialized chan<- struct{})
 	}
 }
 
+// setStageQueue sets the given channel as the input queue of the pipeline stage
+func (t *Tracker) setStageQueue(stage metrics.Stage, in <-chan *trace.Event) {
+	t.pipeline.Stage(stage).SetQueue(func() int { return len(in) }, cap(in))
+}
+
+// eventFiltered counts an event dropped by the policies or rules in the given stage
+func (t *Tracker) eventFiltered(stage metrics.Stage, event *trace.Event) {
+	_ = t.pipeline.Stage(stage).Dropped.Increment()
+	_ = t.pipeline.EventType(event.EventID, event.EventName).Filtered.Increment()
+}
+
+// eventLost counts an event dropped because of errors in the given stage
+func (t *Tracker) eventLost(stage metrics.Stage, event *trace.Event) {
+	_ = t.pipeline.Stage(stage).Dropped.Increment()
+	_ = t.pipeline.EventType(event.EventID, event.EventName).Lost.Increment()
+}
+
 // Under some circumstances, tracker-rules might be slower to consume events than
 // tracker-ebpf is capable of generating them. This requires tracker-ebpf to deal with this
 // possible lag, but, at the same, perf-buffer consumption can't be left behind (or
@@ -124,6 +154,7 @@ func (t *Tracker) queueEvents(ctx context.Context, in <-chan *trace.Event) (chan
 				return
 			case event := <-in:
 				if event != nil {
+					_ = t.pipeline.Stage(metrics.CacheStage).Received.Increment()
 					t.config.Cache.Enqueue(event) // may block if queue is full
 				}
 			}
@@ -158,24 +189,29 @@ func (t *Tracker) decodeEvents(ctx context.Context, sourceChan chan []byte) (<-c
 	out := make(chan *trace.Event, 10000)
 	errc := make(chan error, 1)
 	sysCompatTranslation := events.Core.IDs32ToIDs()
+	stage := t.pipeline.Stage(metrics.DecodeStage)
 	go func() {
 		defer close(out)
 		defer close(errc)
 		for dataRaw := range sourceChan {
+			_ = stage.Received.Increment()
 			ebpfMsgDecoder := bufferdecoder.New(dataRaw)
 			var eCtx bufferdecoder.EventContext
 			if err := ebpfMsgDecoder.DecodeContext(&eCtx); err != nil {
 				t.handleError(err)
+				_ = stage.Dropped.Increment()
 				continue
 			}
 			var argnum uint8
 			if err := ebpfMsgDecoder.DecodeUint8(&argnum); err != nil {
 				t.handleError(err)
+				_ = stage.Dropped.Increment()
 				continue
 			}
 			eventId := events.ID(eCtx.EventID)
 			if !events.Core.IsDefined(eventId) {
 				t.handleError(errfmt.Errorf("failed to get configuration of event %d", eventId))
+				_ = stage.Dropped.Increment()
 				continue
 			}
 			eventDefinition := events.Core.GetDefinitionByID(eventId)
@@ -185,6 +221,7 @@ func (t *Tracker) decodeEvents(ctx context.Context, sourceChan chan []byte) (<-c
 			err := ebpfMsgDecoder.DecodeArguments(args, int(argnum), evtParams, evtName, eventId)
 			if err != nil {
 				t.handleError(err)
+				t.eventLost(metrics.DecodeStage, &trace.Event{EventID: int(eventId), EventName: evtName})
 				continue
 			}
 
@@ -221,6 +258,7 @@ func (t *Tracker) decodeEvents(ctx context.Context, sourceChan chan []byte) (<-c
 			evt, ok := t.eventsPool.Get().(*trace.Event)
 			if !ok {
 				t.handleError(errfmt.Errorf("failed to get event from pool"))
+				t.eventLost(metrics.DecodeStage, &trace.Event{EventID: int(eventId), EventName: evtName})
 				continue
 			}
 
@@ -271,6 +309,7 @@ func (t *Tracker) decodeEvents(ctx context.Context, sourceChan chan []byte) (<-c
 
 				if !hasDerivation && !hasSignature {
 					_ = t.stats.EventsFiltered.Increment()
+					t.eventFiltered(metrics.DecodeStage, evt)
 					t.eventsPool.Put(evt)
 					continue
 				}
@@ -447,6 +486,7 @@ func (t *Tracker) processEvents(ctx context.Context, in <-chan *trace.Event) (
 			if event == nil {
 				continue // might happen during initialization (ctrl+c seg faults)
 			}
+			_ = t.pipeline.Stage(metrics.ProcessStage).Received.Increment()
 
 			// Go through event processors if needed
 			errs := t.processEvent(event)
@@ -454,6 +494,7 @@ func (t *Tracker) processEvents(ctx context.Context, in <-chan *trace.Event) (
 				for _, err := range errs {
 					t.handleError(err)
 				}
+				t.eventLost(metrics.ProcessStage, event)
 				t.eventsPool.Put(event)
 				continue
 			}
@@ -486,6 +527,7 @@ func (t *Tracker) processEvents(ctx context.Context, in <-chan *trace.Event) (
 				utils.ClearBits(&event.MatchedPoliciesUser, policiesWithContainerFilter)
 
 				if event.MatchedPoliciesKernel == 0 {
+					t.eventFiltered(metrics.ProcessStage, event)
 					t.eventsPool.Put(event)
 					continue
 				}
@@ -521,6 +563,7 @@ func (t *Tracker) deriveEvents(ctx context.Context, in <-chan *trace.Event) (
 				if event == nil {
 					continue // might happen during initialization (ctrl+c seg faults)
 				}
+				_ = t.pipeline.Stage(metrics.DeriveStage).Received.Increment()
 
 				// Get a copy of our event before sending it down the pipeline. This is
 				// needed because later modification of the event (in particular of the
@@ -557,6 +600,7 @@ func (t *Tracker) deriveEvents(ctx context.Context, in <-chan *trace.Event) (
 						// Derived events might need filtering as well
 						if t.matchPolicies(event) == 0 {
 							_ = t.stats.EventsFiltered.Increment()
+							t.eventFiltered(metrics.DeriveStage, event)
 							continue
 						}
 					}
@@ -576,35 +620,71 @@ func (t *Tracker) deriveEvents(ctx context.Context, in <-chan *trace.Event) (
 
 // sinkEvents is the event sink pipeline stage. For each received event, it goes through a
 // series of printers that will print the event to the desired output. It also handles the
-// event pool, returning the event to the pool after it is processed.
+// event pool, returning the event to the pool after it is processed. Periodically, it sends
+// the summaries of the events suppressed by the rules rate limits and dedups.
 func (t *Tracker) sinkEvents(ctx context.Context, in <-chan *trace.Event) <-chan error {
 	errc := make(chan error, 1)
 
 	go func() {
 		defer close(errc)
 
-		for event := range in {
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+
+		for {
+			var event *trace.Event
+
+			select {
+			case <-ticker.C:
+				// Summaries are already matched by the policy of their rule, and are
+				// never suppressed themselves.
+				for _, summary := range t.ruleActions.summaries(time.Now()) {
+					_ = t.pipeline.Stage(metrics.SinkStage).Received.Increment()
+					if !t.emitEvent(ctx, summary, nil) {
+						return
+					}
+				}
+				continue
+			case e, ok := <-in:
+				if !ok {
+					return
+				}
+				event = e
+			}
+
 			if event == nil {
 				continue // might happen during initialization (ctrl+c seg faults)
 			}
+			_ = t.pipeline.Stage(metrics.SinkStage).Received.Increment()
 
 			// Is the event enabled for the policies or globally?
 			if !t.policyManager.IsEnabled(event.MatchedPoliciesUser, events.ID(event.EventID)) {
-				// TODO: create metrics from dropped events
+				t.eventFiltered(metrics.SinkStage, event)
 				t.eventsPool.Put(event)
 				continue
 			}
 
 			// Only emit events requested by the user and matched by at least one policy.
 			id := events.ID(event.EventID)
-			event.MatchedPoliciesUser &= t.eventsState[id].Emit
+			event.MatchedPoliciesUser = t.policyManager.MatchedRulePolicies(event.MatchedPoliciesUser, id)
 			if event.MatchedPoliciesUser == 0 {
+				t.eventFiltered(metrics.SinkStage, event)
 				t.eventsPool.Put(event)
 				continue
 			}
 
-			// Populate the event with the names of the matched policies.
-			event.MatchedPolicies = t.policyManager.MatchedNames(event.MatchedPoliciesUser)
+			// Drop the event for the policies whose rule saw an identical event within its
+			// dedup window, or exceeded its rate limit.
+			matchedActions := t.policyManager.MatchedActions(event.MatchedPoliciesUser, id)
+			if matchedActions != nil {
+				event.MatchedPoliciesUser = t.ruleActions.dedup(event, event.MatchedPoliciesUser, id, matchedActions, time.Now())
+				event.MatchedPoliciesUser = t.ruleActions.rateLimit(event.MatchedPoliciesUser, id, matchedActions)
+				if event.MatchedPoliciesUser == 0 {
+					t.eventFiltered(metrics.SinkStage, event)
+					t.eventsPool.Put(event)
+					continue
+				}
+			}
 
 			// Parse args here if the rule engine is not enabled (parsed there if it is).
 			if !t.config.EngineConfig.Enabled {
@@ -614,14 +694,8 @@ func (t *Tracker) sinkEvents(ctx context.Context, in <-chan *trace.Event) <-chan
 				}
 			}
 
-			// Send the event to the streams.
-			select {
-			case <-ctx.Done():
+			if !t.emitEvent(ctx, event, matchedActions) {
 				return
-			default:
-				t.streamsManager.Publish(ctx, *event)
-				_ = t.stats.EventCount.Increment()
-				t.eventsPool.Put(event)
 			}
 		}
 	}()
@@ -629,6 +703,39 @@ func (t *Tracker) sinkEvents(ctx context.Context, in <-chan *trace.Event) <-chan
 	return errc
 }
 
+// emitEvent populates the event with the names of its matched policies and the static
+// labels, masks its sensitive data, responds to it as set by the matched policy rules and
+// sends it to the streams. The event is returned to the pool. It returns false if the
+// context is done.
+func (t *Tracker) emitEvent(ctx context.Context, event *trace.Event, matchedActions []policy.RuleActions) bool {
+	// Populate the event with the names of the matched policies.
+	event.MatchedPolicies = t.policyManager.MatchedNames(event.MatchedPoliciesUser)
+
+	// Add the static labels, shared by all events.
+	event.Labels = t.labels
+
+	// Mask sensitive data in the arguments before the event leaves tracker.
+	t.config.Redactor.Redact(event)
+
+	// Respond to the event as set by the matched policy rules.
+	if matchedActions != nil {
+		t.ruleActions.respond(event, matchedActions)
+	}
+
+	// Send the event to the streams.
+	select {
+	case <-ctx.Done():
+		return false
+	default:
+		t.streamsManager.Publish(ctx, *event)
+		_ = t.stats.EventCount.Increment()
+		_ = t.pipeline.EventType(event.EventID, event.EventName).Emitted.Increment()
+		t.eventsPool.Put(event)
+	}
+
+	return true
+}
+
 // getStackAddresses returns the stack addresses for a given StackID
 func (t *Tracker) getStackAddresses(stackID uint32) []uint64 {
 	stackAddresses := make([]uint64, maxStackDepth)
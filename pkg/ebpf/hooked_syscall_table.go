@@ -25,7 +25,7 @@ func (t *Tracker) hookedSyscallTableRoutine(ctx gocontext.Context) {
 	logger.Debugw("Starting hookedSyscallTable goroutine")
 	defer logger.Debugw("Stopped hookedSyscallTable goroutine")
 
-	if t.eventsState[events.HookedSyscall].Submit == 0 {
+	if t.eventState(events.HookedSyscall).Submit == 0 {
 		return
 	}
 
@@ -0,0 +1,329 @@
+package ebpf
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/khulnasoft-lab/tracker/pkg/errfmt"
+	"github.com/khulnasoft-lab/tracker/pkg/events"
+	k8s "github.com/khulnasoft-lab/tracker/pkg/k8s/apis/tracker.khulnasoft.com/v1beta1"
+	"github.com/khulnasoft-lab/tracker/pkg/logger"
+	"github.com/khulnasoft-lab/tracker/pkg/policy"
+	"github.com/khulnasoft-lab/tracker/pkg/policy/v1beta1"
+	"github.com/khulnasoft-lab/tracker/pkg/utils"
+)
+
+// Policies returns the documents of the running policies.
+func (t *Tracker) Policies() ([]k8s.PolicyInterface, error) {
+	t.policiesMutex.Lock()
+	defer t.policiesMutex.Unlock()
+
+	if err := t.checkPoliciesManaged(); err != nil {
+		return nil, err
+	}
+
+	documents := make([]k8s.PolicyInterface, 0, len(t.policyDocuments))
+	for _, d := range t.policyDocuments {
+		if d != nil {
+			documents = append(documents, d)
+		}
+	}
+
+	return documents, nil
+}
+
+// ApplyPolicy adds the given policy, or updates the running policy with the same name,
+// and applies the resulting set of policies.
+func (t *Tracker) ApplyPolicy(p v1beta1.PolicyFile) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	t.policiesMutex.Lock()
+	defer t.policiesMutex.Unlock()
+
+	if err := t.checkPoliciesManaged(); err != nil {
+		return err
+	}
+
+	documents := make([]k8s.PolicyInterface, 0, len(t.policyDocuments)+1)
+	for _, d := range t.policyDocuments {
+		if d != nil && d.GetName() != p.GetName() {
+			documents = append(documents, d)
+		}
+	}
+	documents = append(documents, p)
+
+	return t.replacePolicies(documents)
+}
+
+// ValidatePolicy checks that the given document can be compiled into a policy,
+// without changing the running policies.
+func (t *Tracker) ValidatePolicy(document k8s.PolicyInterface) error {
+	if t.config.PolicyCompiler == nil {
+		return errfmt.Errorf("policies were not created from policy files and can't be changed at runtime")
+	}
+
+	_, err := t.config.PolicyCompiler([]k8s.PolicyInterface{document})
+
+	return err
+}
+
+// DeletePolicy removes the running policy with the given name and applies the
+// remaining policies.
+func (t *Tracker) DeletePolicy(name string) error {
+	t.policiesMutex.Lock()
+	defer t.policiesMutex.Unlock()
+
+	if err := t.checkPoliciesManaged(); err != nil {
+		return err
+	}
+
+	documents := make([]k8s.PolicyInterface, 0, len(t.policyDocuments))
+	found := false
+	for _, d := range t.policyDocuments {
+		if d == nil {
+			continue
+		}
+		if d.GetName() == name {
+			found = true
+			continue
+		}
+		documents = append(documents, d)
+	}
+	if !found {
+		return policy.PolicyNotFoundByNameError(name)
+	}
+
+	return t.replacePolicies(documents)
+}
+
+// ReplacePolicies replaces the running policies with the ones created from the given
+// documents. Nothing is done if the documents didn't change.
+func (t *Tracker) ReplacePolicies(documents []k8s.PolicyInterface) error {
+	t.policiesMutex.Lock()
+	defer t.policiesMutex.Unlock()
+
+	if err := t.checkPoliciesManaged(); err != nil {
+		return err
+	}
+
+	added, removed, updated := diffPolicies(t.policyDocuments, documents)
+	if len(added)+len(removed)+len(updated) == 0 {
+		logger.Debugw("Policies unchanged")
+		return nil
+	}
+
+	logger.Infow("Policies changed", "added", added, "removed", removed, "updated", updated)
+
+	return t.replacePolicies(documents)
+}
+
+// diffPolicies returns the names of the policies added, removed and updated in the
+// given documents compared to the running ones.
+func diffPolicies(running, documents []k8s.PolicyInterface) ([]string, []string, []string) {
+	runningByName := make(map[string]k8s.PolicyInterface, len(running))
+	for _, d := range running {
+		if d != nil {
+			runningByName[d.GetName()] = d
+		}
+	}
+
+	added, removed, updated := []string{}, []string{}, []string{}
+	for _, d := range documents {
+		r, ok := runningByName[d.GetName()]
+		if !ok {
+			added = append(added, d.GetName())
+			continue
+		}
+		delete(runningByName, d.GetName())
+		if !policiesEqual(r, d) {
+			updated = append(updated, d.GetName())
+		}
+	}
+	for name := range runningByName {
+		removed = append(removed, name)
+	}
+	sort.Strings(removed)
+
+	return added, removed, updated
+}
+
+// policiesEqual compares the content of two policy documents, ignoring their origin
+// (e.g. a kubernetes object metadata and status).
+func policiesEqual(a, b k8s.PolicyInterface) bool {
+	return a.GetName() == b.GetName() &&
+		a.GetDescription() == b.GetDescription() &&
+		reflect.DeepEqual(a.GetScope(), b.GetScope()) &&
+		reflect.DeepEqual(a.GetDefaultActions(), b.GetDefaultActions()) &&
+		reflect.DeepEqual(a.GetRules(), b.GetRules())
+}
+
+// checkPoliciesManaged returns an error if the running policies can't be changed,
+// since they were not created from policy documents.
+func (t *Tracker) checkPoliciesManaged() error {
+	if t.policyDocuments == nil || t.config.PolicyCompiler == nil {
+		return errfmt.Errorf("policies were not created from policy files and can't be changed at runtime")
+	}
+
+	return nil
+}
+
+// replacePolicies compiles the given documents into a new policies snapshot and
+// replaces the running policies with it, updating the eBPF maps.
+// NOTE: only events already being traced can be used by the new policies, since
+// event probes are not attached at runtime yet.
+func (t *Tracker) replacePolicies(documents []k8s.PolicyInterface) error {
+	if t.bpfModule == nil {
+		return errfmt.Errorf("tracker is not initialized")
+	}
+
+	slots := policySlots(t.policyDocuments, documents)
+	ps := policy.NewPolicies() // removing all policies leaves an empty set
+	if len(slots) > 0 {
+		var err error
+		ps, err = t.config.PolicyCompiler(slots)
+		if err != nil {
+			return errfmt.WrapError(err)
+		}
+	}
+
+	eventsState, err := t.policiesEventsState(ps)
+	if err != nil {
+		return err
+	}
+
+	// Update eBPF maps before making the policies visible to userland, so
+	// submitted events are not matched against policies unknown to the kernel.
+
+	polCfg, err := ps.UpdateBPF(t.bpfModule, t.containers, eventsState, t.eventsParamTypes, true, true)
+	if err == nil {
+		err = t.newConfig(polCfg).UpdateBPF(t.bpfModule)
+	}
+	if err != nil {
+		// restore the eBPF maps of the running policies, still used by userland
+		polCfg, rerr := t.policyManager.UpdateBPF(t.bpfModule, t.containers, t.eventsState, t.eventsParamTypes, true, true)
+		if rerr == nil {
+			rerr = t.newConfig(polCfg).UpdateBPF(t.bpfModule)
+		}
+		if rerr != nil {
+			logger.Errorw("Restoring the running policies eBPF maps", "error", rerr)
+		}
+		return errfmt.WrapError(err)
+	}
+
+	policy.Snapshots().Store(ps)
+
+	t.eventsStateMutex.Lock()
+	t.eventsState = eventsState
+	t.eventsStateMutex.Unlock()
+
+	// Streams subscribed to removed policies stop receiving the events of the new
+	// policies given their IDs.
+	t.streamsManager.RemovePolicies(removedPolicies(t.policyDocuments, slots))
+	t.policyManager.ReplacePolicies(ps)
+	t.policyDocuments = slots
+
+	logger.Infow("Policies replaced", "policies", ps.Count())
+
+	return nil
+}
+
+// policySlots returns the given documents indexed by policy ID. The running policies
+// keep their IDs, removed policies leave a nil document, and added policies take the
+// free IDs, so the IDs known by streams and by events in the pipeline stay valid.
+func policySlots(running, documents []k8s.PolicyInterface) []k8s.PolicyInterface {
+	ids := make(map[string]int, len(running))
+	for id, d := range running {
+		if d != nil {
+			ids[d.GetName()] = id
+		}
+	}
+
+	slots := make([]k8s.PolicyInterface, len(running))
+	added := []k8s.PolicyInterface{}
+	for _, d := range documents {
+		if id, ok := ids[d.GetName()]; ok {
+			slots[id] = d
+			continue
+		}
+		added = append(added, d)
+	}
+
+	free := 0
+	for _, d := range added {
+		for free < len(slots) && slots[free] != nil {
+			free++
+		}
+		if free == len(slots) {
+			slots = append(slots, nil)
+		}
+		slots[free] = d
+	}
+
+	// trailing free IDs are not needed
+	for len(slots) > 0 && slots[len(slots)-1] == nil {
+		slots = slots[:len(slots)-1]
+	}
+
+	return slots
+}
+
+// removedPolicies returns the bitmap of the running policies IDs no longer used by the
+// same policies in the given slots.
+func removedPolicies(running, slots []k8s.PolicyInterface) uint64 {
+	removed := policy.PolicyNone
+	for id, d := range running {
+		if d == nil {
+			continue
+		}
+		if id >= len(slots) || slots[id] == nil || slots[id].GetName() != d.GetName() {
+			utils.SetBit(&removed, uint(id))
+		}
+	}
+
+	return removed
+}
+
+// ErrRestartRequired is wrapped by the errors of policies using events that are not
+// being traced, which can only be applied by restarting tracker with them.
+var ErrRestartRequired = errors.New("tracker must be restarted to apply the policies")
+
+// policiesEventsState returns the events state to be submitted by the kernel for
+// the given policies. Events selected by tracker itself keep their state, while
+// the submit bitmaps of the events chosen by the user (and their dependencies)
+// are recomputed.
+func (t *Tracker) policiesEventsState(ps *policy.Policies) (map[events.ID]events.EventState, error) {
+	eventsState := make(map[events.ID]events.EventState, len(t.eventsState))
+	for id, state := range t.eventsState {
+		if state.Submit != policy.PolicyAll {
+			state.Submit = policy.PolicyNone
+		}
+		eventsState[id] = state
+	}
+
+	var submit func(id events.ID, policyID int)
+	submit = func(id events.ID, policyID int) {
+		state := eventsState[id]
+		utils.SetBit(&state.Submit, uint(policyID))
+		eventsState[id] = state
+
+		for _, depID := range events.Core.GetDefinitionByID(id).GetDependencies().GetIDs() {
+			submit(depID, policyID)
+		}
+	}
+
+	for it := ps.CreateAllIterator(); it.HasNext(); {
+		p := it.Next()
+		for id, name := range p.EventsToTrace {
+			if _, ok := t.eventsState[id]; !ok {
+				return nil, fmt.Errorf("%w: policy %s, event %s is not being traced", ErrRestartRequired, p.Name, name)
+			}
+			submit(id, p.ID)
+		}
+	}
+
+	return eventsState, nil
+}
@@ -0,0 +1,155 @@
+package ebpf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	k8s "github.com/khulnasoft-lab/tracker/pkg/k8s/apis/tracker.khulnasoft.com/v1beta1"
+	"github.com/khulnasoft-lab/tracker/pkg/policy/v1beta1"
+)
+
+func TestDiffPolicies(t *testing.T) {
+	t.Parallel()
+
+	newPolicy := func(name string, events ...string) v1beta1.PolicyFile {
+		rules := []k8s.Rule{}
+		for _, e := range events {
+			rules = append(rules, k8s.Rule{Event: e})
+		}
+		return v1beta1.PolicyFile{
+			Metadata: v1beta1.Metadata{Name: name},
+			Spec:     k8s.PolicySpec{Scope: []string{"global"}, Rules: rules},
+		}
+	}
+
+	testCases := []struct {
+		name            string
+		running         []k8s.PolicyInterface
+		documents       []k8s.PolicyInterface
+		expectedAdded   []string
+		expectedRemoved []string
+		expectedUpdated []string
+	}{
+		{
+			name:            "unchanged",
+			running:         []k8s.PolicyInterface{newPolicy("p1", "openat")},
+			documents:       []k8s.PolicyInterface{newPolicy("p1", "openat")},
+			expectedAdded:   []string{},
+			expectedRemoved: []string{},
+			expectedUpdated: []string{},
+		},
+		{
+			name:            "added, removed and updated",
+			running:         []k8s.PolicyInterface{newPolicy("p1", "openat"), newPolicy("p2", "execve"), newPolicy("p3", "ptrace")},
+			documents:       []k8s.PolicyInterface{newPolicy("p2", "execve", "ptrace"), newPolicy("p3", "ptrace"), newPolicy("p4", "openat")},
+			expectedAdded:   []string{"p4"},
+			expectedRemoved: []string{"p1"},
+			expectedUpdated: []string{"p2"},
+		},
+		{
+			name: "kubernetes metadata and status ignored",
+			running: []k8s.PolicyInterface{k8s.Policy{
+				ObjectMeta: metav1.ObjectMeta{Name: "p1", ResourceVersion: "1"},
+				Spec:       newPolicy("p1", "openat").Spec,
+			}},
+			documents: []k8s.PolicyInterface{k8s.Policy{
+				ObjectMeta: metav1.ObjectMeta{Name: "p1", ResourceVersion: "2"},
+				Spec:       newPolicy("p1", "openat").Spec,
+				Status:     k8s.PolicyStatus{Nodes: map[string]k8s.PolicyNodeStatus{"node1": {AppliedGeneration: 1}}},
+			}},
+			expectedAdded:   []string{},
+			expectedRemoved: []string{},
+			expectedUpdated: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			added, removed, updated := diffPolicies(tc.running, tc.documents)
+			assert.Equal(t, tc.expectedAdded, added)
+			assert.Equal(t, tc.expectedRemoved, removed)
+			assert.Equal(t, tc.expectedUpdated, updated)
+		})
+	}
+}
+
+func TestPolicySlots(t *testing.T) {
+	t.Parallel()
+
+	newPolicy := func(name string) k8s.PolicyInterface {
+		return v1beta1.PolicyFile{Metadata: v1beta1.Metadata{Name: name}}
+	}
+	names := func(slots []k8s.PolicyInterface) []string {
+		names := []string{}
+		for _, d := range slots {
+			if d == nil {
+				names = append(names, "")
+				continue
+			}
+			names = append(names, d.GetName())
+		}
+		return names
+	}
+
+	testCases := []struct {
+		name            string
+		running         []k8s.PolicyInterface
+		documents       []k8s.PolicyInterface
+		expectedSlots   []string
+		expectedRemoved uint64
+	}{
+		{
+			name:            "reordered policies keep their IDs",
+			running:         []k8s.PolicyInterface{newPolicy("p1"), newPolicy("p2")},
+			documents:       []k8s.PolicyInterface{newPolicy("p2"), newPolicy("p1")},
+			expectedSlots:   []string{"p1", "p2"},
+			expectedRemoved: 0,
+		},
+		{
+			name:            "removed policy leaves its ID free",
+			running:         []k8s.PolicyInterface{newPolicy("p1"), newPolicy("p2"), newPolicy("p3")},
+			documents:       []k8s.PolicyInterface{newPolicy("p1"), newPolicy("p3")},
+			expectedSlots:   []string{"p1", "", "p3"},
+			expectedRemoved: 0b010,
+		},
+		{
+			name:            "added policy takes the first free ID",
+			running:         []k8s.PolicyInterface{newPolicy("p1"), nil, newPolicy("p3")},
+			documents:       []k8s.PolicyInterface{newPolicy("p4"), newPolicy("p5"), newPolicy("p3"), newPolicy("p1")},
+			expectedSlots:   []string{"p1", "p4", "p3", "p5"},
+			expectedRemoved: 0,
+		},
+		{
+			name:            "removed and added policies",
+			running:         []k8s.PolicyInterface{newPolicy("p1"), newPolicy("p2")},
+			documents:       []k8s.PolicyInterface{newPolicy("p3")},
+			expectedSlots:   []string{"p3"},
+			expectedRemoved: 0b11,
+		},
+		{
+			name:            "all policies removed",
+			running:         []k8s.PolicyInterface{newPolicy("p1"), newPolicy("p2")},
+			documents:       []k8s.PolicyInterface{},
+			expectedSlots:   []string{},
+			expectedRemoved: 0b11,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			slots := policySlots(tc.running, tc.documents)
+			assert.Equal(t, tc.expectedSlots, names(slots))
+			assert.Equal(t, tc.expectedRemoved, removedPolicies(tc.running, slots))
+		})
+	}
+}
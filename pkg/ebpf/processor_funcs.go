@@ -214,11 +214,11 @@ func (t *Tracker) processSchedProcessExec(event *trace.Event) error {
 // processDoFinitModule handles a do_finit_module event and triggers other hooking detection logic.
 func (t *Tracker) processDoInitModule(event *trace.Event) error {
 	// Check if related events are being traced.
-	_, okSyscalls := t.eventsState[events.HookedSyscall]
-	_, okSeqOps := t.eventsState[events.HookedSeqOps]
-	_, okProcFops := t.eventsState[events.HookedProcFops]
-	_, okMemDump := t.eventsState[events.PrintMemDump]
-	_, okFtrace := t.eventsState[events.FtraceHook]
+	okSyscalls := t.isEventTraced(events.HookedSyscall)
+	okSeqOps := t.isEventTraced(events.HookedSeqOps)
+	okProcFops := t.isEventTraced(events.HookedProcFops)
+	okMemDump := t.isEventTraced(events.PrintMemDump)
+	okFtrace := t.isEventTraced(events.FtraceHook)
 
 	if !okSyscalls && !okSeqOps && !okProcFops && !okMemDump && !okFtrace {
 		return nil
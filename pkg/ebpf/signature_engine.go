@@ -2,11 +2,14 @@ package ebpf
 
 import (
 	"context"
+	"errors"
 
 	"github.com/khulnasoft-lab/tracker/pkg/containers"
 	"github.com/khulnasoft-lab/tracker/pkg/dnscache"
+	"github.com/khulnasoft-lab/tracker/pkg/errfmt"
 	"github.com/khulnasoft-lab/tracker/pkg/events"
 	"github.com/khulnasoft-lab/tracker/pkg/logger"
+	"github.com/khulnasoft-lab/tracker/pkg/metrics"
 	"github.com/khulnasoft-lab/tracker/pkg/proctree"
 	"github.com/khulnasoft-lab/tracker/pkg/signatures/engine"
 	"github.com/khulnasoft-lab/tracker/types/detect"
@@ -29,10 +32,12 @@ func (t *Tracker) engineEvents(ctx context.Context, in <-chan *trace.Event) (<-c
 
 	// Share event states (by reference)
 	t.config.EngineConfig.ShouldDispatchEvent = func(eventIdInt32 int32) bool {
-		_, ok := t.eventsState[events.ID(eventIdInt32)]
-		return ok
+		return t.isEventTraced(events.ID(eventIdInt32))
 	}
 
+	// Signatures opting out of the raw arguments get the redacted events
+	t.config.EngineConfig.Redactor = t.config.Redactor
+
 	sigEngine, err := engine.NewEngine(t.config.EngineConfig, source, engineOutput)
 	if err != nil {
 		logger.Fatalw("failed to start signature engine in \"everything is an event\" mode", "error", err)
@@ -62,10 +67,11 @@ func (t *Tracker) engineEvents(ctx context.Context, in <-chan *trace.Event) (<-c
 		id := events.ID(event.EventID)
 
 		// if the event is marked as submit, we pass it to the engine
-		if t.eventsState[id].Submit > 0 {
+		if t.eventState(id).Submit > 0 {
 			err := t.parseArguments(event)
 			if err != nil {
 				t.handleError(err)
+				t.eventLost(metrics.EngineStage, event)
 				return
 			}
 
@@ -95,6 +101,9 @@ func (t *Tracker) engineEvents(ctx context.Context, in <-chan *trace.Event) (<-c
 		for {
 			select {
 			case event := <-in:
+				if event != nil {
+					_ = t.pipeline.Stage(metrics.EngineStage).Received.Increment()
+				}
 				feedFunc(event)
 			case event := <-engineOutputEvents:
 				feedFunc(event)
@@ -157,3 +166,33 @@ func (t *Tracker) PrepareBuiltinDataSources() []detect.DataSource {
 
 	return datasources
 }
+
+// ReplaceSignatures replaces the signatures loaded in the signature engine. Signatures
+// of events not defined when tracker started are skipped, since the running policies
+// can't select them.
+func (t *Tracker) ReplaceSignatures(sigs []detect.Signature) error {
+	if t.sigEngine == nil {
+		return errfmt.Errorf("signature engine is not running")
+	}
+
+	var errs []error
+	selected := make([]detect.Signature, 0, len(sigs))
+	for _, sig := range sigs {
+		m, err := sig.GetMetadata()
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if _, ok := t.config.EngineConfig.SigNameToEventID[m.EventName]; !ok {
+			errs = append(errs, errfmt.Errorf("signature %s, event %s is not defined, tracker must be restarted to load it", m.ID, m.EventName))
+			continue
+		}
+		selected = append(selected, sig)
+	}
+
+	if err := t.sigEngine.ReplaceSignatures(selected); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
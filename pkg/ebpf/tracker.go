@@ -3,7 +3,9 @@ package ebpf
 import (
 	gocontext "context"
 	"encoding/binary"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
 	"strings"
@@ -29,10 +31,12 @@ import (
 	"github.com/khulnasoft-lab/tracker/pkg/events"
 	"github.com/khulnasoft-lab/tracker/pkg/events/dependencies"
 	"github.com/khulnasoft-lab/tracker/pkg/events/derive"
+	"github.com/khulnasoft-lab/tracker/pkg/events/queue"
 	"github.com/khulnasoft-lab/tracker/pkg/events/sorting"
 	"github.com/khulnasoft-lab/tracker/pkg/events/trigger"
 	"github.com/khulnasoft-lab/tracker/pkg/filehash"
 	"github.com/khulnasoft-lab/tracker/pkg/filters"
+	k8s "github.com/khulnasoft-lab/tracker/pkg/k8s/apis/tracker.khulnasoft.com/v1beta1"
 	"github.com/khulnasoft-lab/tracker/pkg/logger"
 	"github.com/khulnasoft-lab/tracker/pkg/metrics"
 	"github.com/khulnasoft-lab/tracker/pkg/pcaps"
@@ -61,9 +65,11 @@ type Tracker struct {
 	done      chan struct{} // signal to safely stop end-stage processing
 	OutDir    *os.File      // use utils.XXX functions to create or write to this file
 	stats     metrics.Stats
+	pipeline  metrics.PipelineStats // per stage, event type and cpu events pipeline metrics
 	sigEngine *engine.Engine
 	// Events States
-	eventsState map[events.ID]events.EventState
+	eventsState      map[events.ID]events.EventState
+	eventsStateMutex sync.RWMutex // guards eventsState replacements by runtime policy changes
 	// Events
 	eventsSorter     *sorting.EventsChronologicalSorter
 	eventsPool       *sync.Pool
@@ -119,6 +125,14 @@ type Tracker struct {
 	streamsManager *streams.StreamsManager
 	// policyManager manages policy state
 	policyManager *policy.PolicyManager
+	// ruleActions runs the actions of the matched policy rules
+	ruleActions *ruleActions
+	// policyDocuments are the documents the running policies were created from, indexed
+	// by policy ID (removed policies leave a nil document, so the other IDs are kept)
+	policyDocuments []k8s.PolicyInterface
+	policiesMutex   sync.Mutex // serializes runtime policy changes
+	// labels are the static labels added to every event, nil if none
+	labels map[string]string
 
 	// Ksymbols needed to be kept alive in table.
 	// This does not mean they are required for tracker to function.
@@ -130,10 +144,29 @@ func (t *Tracker) Stats() *metrics.Stats {
 	return &t.stats
 }
 
+// Pipeline returns the metrics of the events pipeline
+func (t *Tracker) Pipeline() *metrics.PipelineStats {
+	return &t.pipeline
+}
+
 func (t *Tracker) Engine() *engine.Engine {
 	return t.sigEngine
 }
 
+func (t *Tracker) Streams() *streams.StreamsManager {
+	return t.streamsManager
+}
+
+// ProcessTree returns the process tree, or nil if it is disabled
+func (t *Tracker) ProcessTree() *proctree.ProcessTree {
+	return t.processTree
+}
+
+// Containers returns the containers of the host, known from their cgroups
+func (t *Tracker) Containers() *containers.Containers {
+	return t.containers
+}
+
 // GetCaptureEventsList sets events used to capture data.
 func GetCaptureEventsList(cfg config.Config) map[events.ID]events.EventState {
 	captureEvents := make(map[events.ID]events.EventState)
@@ -167,6 +200,25 @@ func GetCaptureEventsList(cfg config.Config) map[events.ID]events.EventState {
 	return captureEvents
 }
 
+// eventState returns the state of the given event. Unlike direct accesses to eventsState,
+// it is safe to call while the policies are replaced at runtime.
+func (t *Tracker) eventState(eventID events.ID) events.EventState {
+	t.eventsStateMutex.RLock()
+	defer t.eventsStateMutex.RUnlock()
+
+	return t.eventsState[eventID]
+}
+
+// isEventTraced returns whether the given event is being traced. Unlike direct accesses to
+// eventsState, it is safe to call while the policies are replaced at runtime.
+func (t *Tracker) isEventTraced(eventID events.ID) bool {
+	t.eventsStateMutex.RLock()
+	defer t.eventsStateMutex.RUnlock()
+
+	_, ok := t.eventsState[eventID]
+	return ok
+}
+
 func (t *Tracker) addEventState(eventID events.ID, chosenState events.EventState) {
 	currentState := t.eventsState[eventID]
 	currentState.Submit |= chosenState.Submit
@@ -233,11 +285,21 @@ func New(cfg config.Config) (*Tracker, error) {
 		capturedFiles:   make(map[string]int64),
 		eventsState:     make(map[events.ID]events.EventState),
 		eventSignatures: make(map[events.ID]bool),
-		streamsManager:  streams.NewStreamsManager(),
+		streamsManager:  streams.NewStreamsManager(cfg.StreamReplaySize),
 		policyManager:   policy.NewPolicyManager(cfg.Policies),
+		policyDocuments: cfg.PolicyDocuments,
 		requiredKsyms:   []string{},
 	}
 
+	if cfg.Policies != nil {
+		policy.Snapshots().Store(cfg.Policies)
+	}
+
+	// events queued on disk are stored with the names of their matched policies
+	if cache, ok := cfg.Cache.(interface{ SetPolicyNames(queue.PolicyNames) }); ok {
+		cache.SetPolicyNames(t.policyManager)
+	}
+
 	// In the future Tracker Config will be changed in runtime, and will demand a proper
 	// object to manage it. config.Config is currently a transient object that should be
 	// used only to create the Tracker instance.
@@ -401,6 +463,26 @@ func New(cfg config.Config) (*Tracker, error) {
 	return t, nil
 }
 
+// eventLabels returns the static labels added to every event: the node labels, if
+// enabled, and the user labels, which take precedence
+func eventLabels(cfg *config.OutputConfig) map[string]string {
+	if cfg == nil || (!cfg.NodeLabels && len(cfg.Labels) == 0) {
+		return nil
+	}
+
+	labels := make(map[string]string)
+	if cfg.NodeLabels {
+		for key, value := range environment.NodeLabels() {
+			labels[key] = value
+		}
+	}
+	for key, value := range cfg.Labels {
+		labels[key] = value
+	}
+
+	return labels
+}
+
 // Init initialize tracker instance and it's various subsystems, potentially
 // performing external system operations to initialize them.
 // NOTE: any initialization logic, especially one that causes side effects
@@ -434,6 +516,10 @@ func (t *Tracker) Init(ctx gocontext.Context) error {
 		logger.Debugw("Initializing buckets cache", "error", errfmt.WrapError(err))
 	}
 
+	// Initialize the static event labels
+
+	t.labels = eventLabels(t.config.Output)
+
 	// Initialize Process Tree (if enabled)
 
 	if t.config.ProcTree.Source != proctree.SourceNone {
@@ -566,6 +652,8 @@ func (t *Tracker) Init(ctx gocontext.Context) error {
 		return errfmt.Errorf("error opening out directory: %v", err)
 	}
 
+	t.ruleActions = newRuleActions(ctx, t.OutDir, &t.stats)
+
 	// Initialize network capture (all needed pcap files)
 
 	t.netCapturePcap, err = pcaps.New(t.config.Capture.Net, t.OutDir)
@@ -599,6 +687,7 @@ func (t *Tracker) Init(ctx gocontext.Context) error {
 		if err != nil {
 			return errfmt.WrapError(err)
 		}
+		t.eventsSorter.SetReceivedCounter(&t.pipeline.Stage(metrics.SortStage).Received)
 	}
 
 	// Initialize events pool
@@ -676,7 +765,7 @@ func (t *Tracker) initTailCall(tailCall events.TailCall) error {
 // derived and the corresponding function to derive into that Event.
 func (t *Tracker) initDerivationTable() error {
 	shouldSubmit := func(id events.ID) func() bool {
-		return func() bool { return t.eventsState[id].Submit > 0 }
+		return func() bool { return t.eventState(id).Submit > 0 }
 	}
 	symbolsCollisions := derive.SymbolsCollision(t.contSymbolsLoader, t.policyManager)
 
@@ -1407,6 +1496,18 @@ func (t *Tracker) initBPF() error {
 		return errfmt.Errorf("error initializing events perf map: %v", err)
 	}
 
+	eventsLostMap, err := t.bpfModule.GetMap("events_lost")
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+	cpus, err := environment.GetCPUAmount()
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+	t.pipeline.SetLostPerCPU(func() []uint64 {
+		return lostEventsPerCPU(eventsLostMap, cpus)
+	})
+
 	if t.config.BlobPerfBufferSize > 0 {
 		t.fileCapturesChannel = make(chan []byte, 1000)
 		t.lostCapturesChannel = make(chan uint64)
@@ -1576,6 +1677,20 @@ func updateCaptureMapFile(fileDir *os.File, filePath string, capturedFiles map[s
 	return nil
 }
 
+// lostEventsPerCPU reads the events lost in the submission of each cpu
+func lostEventsPerCPU(eventsLostMap *bpf.BPFMap, cpus int) []uint64 {
+	lost := make([]uint64, cpus)
+	for cpu := uint32(0); cpu < uint32(cpus); cpu++ {
+		value, err := eventsLostMap.GetValue(unsafe.Pointer(&cpu))
+		if err != nil || len(value) < 8 {
+			continue
+		}
+		lost[cpu] = binary.LittleEndian.Uint64(value)
+	}
+
+	return lost
+}
+
 // Close cleans up created resources
 func (t *Tracker) Close() {
 	// clean up (unsubscribe) all streams connected if tracker is done
@@ -1606,6 +1721,12 @@ func (t *Tracker) Close() {
 	if err := t.cgroups.Destroy(); err != nil {
 		logger.Errorw("Cgroups destroy", "error", err)
 	}
+	// flush the events queued on disk (if the cache is persistent)
+	if cache, ok := t.config.Cache.(io.Closer); ok {
+		if err := cache.Close(); err != nil {
+			logger.Errorw("Closing events cache", "error", err)
+		}
+	}
 
 	// set 'running' to false and close 'done' channel only after attempting to close all resources
 	t.running.Store(false)
@@ -1731,7 +1852,7 @@ func (t *Tracker) invokeInitEvents(out chan *trace.Event) {
 
 	// Initial namespace events
 
-	matchedPolicies = policiesMatch(t.eventsState[events.InitNamespaces])
+	matchedPolicies = policiesMatch(t.eventState(events.InitNamespaces))
 	if matchedPolicies > 0 {
 		systemInfoEvent := events.InitNamespacesEvent()
 		setMatchedPolicies(&systemInfoEvent, matchedPolicies, t.policyManager)
@@ -1741,7 +1862,7 @@ func (t *Tracker) invokeInitEvents(out chan *trace.Event) {
 
 	// Initial existing containers events (1 event per container)
 
-	matchedPolicies = policiesMatch(t.eventsState[events.ExistingContainer])
+	matchedPolicies = policiesMatch(t.eventState(events.ExistingContainer))
 	if matchedPolicies > 0 {
 		existingContainerEvents := events.ExistingContainersEvents(t.containers, t.config.NoContainersEnrich)
 		for i := range existingContainerEvents {
@@ -1754,7 +1875,7 @@ func (t *Tracker) invokeInitEvents(out chan *trace.Event) {
 
 	// Ftrace hook event
 
-	matchedPolicies = policiesMatch(t.eventsState[events.FtraceHook])
+	matchedPolicies = policiesMatch(t.eventState(events.FtraceHook))
 	if matchedPolicies > 0 {
 		ftraceBaseEvent := events.GetFtraceBaseEvent()
 		setMatchedPolicies(ftraceBaseEvent, matchedPolicies, t.policyManager)
@@ -1791,8 +1912,7 @@ func (t *Tracker) netEnabled() bool {
 // triggerSeqOpsIntegrityCheck is used by a Uprobe to trigger an eBPF program
 // that prints the seq ops pointers
 func (t *Tracker) triggerSeqOpsIntegrityCheck(event trace.Event) {
-	_, ok := t.eventsState[events.HookedSeqOps]
-	if !ok {
+	if !t.isEventTraced(events.HookedSeqOps) {
 		return
 	}
 	var seqOpsPointers [len(derive.NetSeqOps)]uint64
@@ -1820,7 +1940,7 @@ func (t *Tracker) triggerSeqOpsIntegrityCheckCall(
 // triggerMemDump is used by a Uprobe to trigger an eBPF program
 // that prints the first bytes of requested symbols or addresses
 func (t *Tracker) triggerMemDump(event trace.Event) []error {
-	if _, ok := t.eventsState[events.PrintMemDump]; !ok {
+	if !t.isEventTraced(events.PrintMemDump) {
 		return nil
 	}
 
@@ -1955,12 +2075,12 @@ func (t *Tracker) triggerMemDumpCall(address uint64, length uint64, eventHandle
 }
 
 // SubscribeAll returns a stream subscribed to all policies
-func (t *Tracker) SubscribeAll() *streams.Stream {
-	return t.subscribe(policy.PolicyAll)
+func (t *Tracker) SubscribeAll(config streams.Config) (*streams.Stream, error) {
+	return t.subscribe(policy.PolicyAll, config)
 }
 
 // Subscribe returns a stream subscribed to selected policies
-func (t *Tracker) Subscribe(policyNames []string) (*streams.Stream, error) {
+func (t *Tracker) Subscribe(policyNames []string, config streams.Config) (*streams.Stream, error) {
 	var policyMask uint64
 
 	for _, policyName := range policyNames {
@@ -1971,13 +2091,15 @@ func (t *Tracker) Subscribe(policyNames []string) (*streams.Stream, error) {
 		utils.SetBit(&policyMask, uint(p.ID))
 	}
 
-	return t.subscribe(policyMask), nil
+	return t.subscribe(policyMask, config)
 }
 
-func (t *Tracker) subscribe(policyMask uint64) *streams.Stream {
+func (t *Tracker) subscribe(policyMask uint64, config streams.Config) (*streams.Stream, error) {
 	// TODO: the channel size matches the pipeline channel size,
 	// but we should make it configurable in the future.
-	return t.streamsManager.Subscribe(policyMask, 10000)
+	config.BufferSize = 10000
+
+	return t.streamsManager.Subscribe(policyMask, config)
 }
 
 // Unsubscribe unsubscribes stream
@@ -1985,10 +2107,13 @@ func (t *Tracker) Unsubscribe(s *streams.Stream) {
 	t.streamsManager.Unsubscribe(s)
 }
 
+// ErrEventNotFound is wrapped by the errors of the events not found
+var ErrEventNotFound = errors.New("event not found")
+
 func (t *Tracker) EnableEvent(eventName string) error {
 	id, found := events.Core.GetDefinitionIDByName(eventName)
 	if !found {
-		return errfmt.Errorf("error event not found: %s", eventName)
+		return fmt.Errorf("%w: %s", ErrEventNotFound, eventName)
 	}
 
 	t.policyManager.EnableEvent(id)
@@ -1999,7 +2124,7 @@ func (t *Tracker) EnableEvent(eventName string) error {
 func (t *Tracker) DisableEvent(eventName string) error {
 	id, found := events.Core.GetDefinitionIDByName(eventName)
 	if !found {
-		return errfmt.Errorf("error event not found: %s", eventName)
+		return fmt.Errorf("%w: %s", ErrEventNotFound, eventName)
 	}
 
 	t.policyManager.DisableEvent(id)
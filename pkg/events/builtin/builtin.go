@@ -0,0 +1,52 @@
+// Package builtin describes the builtin events of tracker, for the programs and packages
+// that can't depend on the events package, which requires eBPF. The descriptions are
+// generated from the events package, run "make builtin-events" after changing the events;
+// "make check-builtin-events" verifies them along with the protobuf translation table.
+package builtin
+
+import "github.com/khulnasoft-lab/tracker/types/trace"
+
+// MaxBuiltinID is the last ID of the builtin events, the IDs above it belong to the
+// signature events
+const MaxBuiltinID = 10000 - 1
+
+// Event describes a builtin event. Params is shared between the lookups and must not be
+// modified.
+type Event struct {
+	ID      int
+	Name    string
+	Syscall bool
+	Params  []trace.ArgMeta
+}
+
+// Lookup looks up a builtin event by name. The signature events are not builtin, they are
+// only known where the signatures are loaded.
+func Lookup(name string) (Event, bool) {
+	event, ok := definitions[name]
+	if !ok {
+		return Event{}, false
+	}
+	event.ID = ids[name]
+	event.Name = name
+
+	return event, true
+}
+
+// names are the names of the builtin events by ID
+var names = func() map[int]string {
+	names := make(map[int]string, len(ids))
+	for name, id := range ids {
+		names[id] = name
+	}
+	return names
+}()
+
+// LookupID looks up a builtin event by ID
+func LookupID(id int) (Event, bool) {
+	name, ok := names[id]
+	if !ok {
+		return Event{}, false
+	}
+
+	return Lookup(name)
+}
@@ -0,0 +1,51 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/khulnasoft-lab/tracker/pkg/events"
+	"github.com/khulnasoft-lab/tracker/types/trace"
+)
+
+// TestBuiltinEvents checks that the generated events match the events of tracker on this
+// architecture, run "make builtin-events" to update them
+func TestBuiltinEvents(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, int(events.MaxBuiltinID), MaxBuiltinID)
+
+	definitions := events.Core.GetDefinitions()
+	assert.Len(t, ids, len(definitions))
+	for _, definition := range definitions {
+		event, ok := Lookup(definition.GetName())
+		require.True(t, ok, definition.GetName())
+
+		params := definition.GetParams()
+		if params == nil {
+			params = []trace.ArgMeta{}
+		}
+		assert.Equal(t, Event{
+			ID:      int(definition.GetID()),
+			Name:    definition.GetName(),
+			Syscall: definition.IsSyscall(),
+			Params:  params,
+		}, event, definition.GetName())
+
+		byID, ok := LookupID(int(definition.GetID()))
+		require.True(t, ok, definition.GetName())
+		assert.Equal(t, event, byID)
+	}
+}
+
+func TestLookupUnknown(t *testing.T) {
+	t.Parallel()
+
+	_, ok := Lookup("not_an_event")
+	assert.False(t, ok)
+
+	_, ok = LookupID(MaxBuiltinID + 1)
+	assert.False(t, ok)
+}
@@ -0,0 +1,582 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package builtin
+
+import "github.com/khulnasoft-lab/tracker/types/trace"
+
+var definitions = map[string]Event{
+	"__kernel_write":                 {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "dev_t", Name: "dev"}, {Type: "unsigned long", Name: "inode"}, {Type: "size_t", Name: "count"}, {Type: "off_t", Name: "pos"}}},
+	"accept":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "sockfd"}, {Type: "struct sockaddr*", Name: "addr"}, {Type: "int*", Name: "addrlen"}}},
+	"accept4":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "sockfd"}, {Type: "struct sockaddr*", Name: "addr"}, {Type: "int*", Name: "addrlen"}, {Type: "int", Name: "flags"}}},
+	"access":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "int", Name: "mode"}}},
+	"acct":                           {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "filename"}}},
+	"add_key":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "type"}, {Type: "const char*", Name: "description"}, {Type: "const void*", Name: "payload"}, {Type: "size_t", Name: "plen"}, {Type: "key_serial_t", Name: "keyring"}}},
+	"adjtimex":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "struct timex*", Name: "buf"}}},
+	"afs":                            {Syscall: true, Params: []trace.ArgMeta{}},
+	"afs_syscall":                    {Syscall: true, Params: []trace.ArgMeta{}},
+	"alarm":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "unsigned int", Name: "seconds"}}},
+	"arch_prctl":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "option"}, {Type: "unsigned long", Name: "addr"}}},
+	"bdflush":                        {Syscall: true, Params: []trace.ArgMeta{}},
+	"bind":                           {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "sockfd"}, {Type: "struct sockaddr*", Name: "addr"}, {Type: "int", Name: "addrlen"}}},
+	"bpf":                            {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "cmd"}, {Type: "union bpf_attr*", Name: "attr"}, {Type: "unsigned int", Name: "size"}}},
+	"bpf_attach":                     {Syscall: false, Params: []trace.ArgMeta{{Type: "int", Name: "prog_type"}, {Type: "const char*", Name: "prog_name"}, {Type: "u32", Name: "prog_id"}, {Type: "unsigned long[]", Name: "prog_helpers"}, {Type: "const char*", Name: "symbol_name"}, {Type: "u64", Name: "symbol_addr"}, {Type: "int", Name: "attach_type"}}},
+	"break":                          {Syscall: true, Params: []trace.ArgMeta{}},
+	"brk":                            {Syscall: true, Params: []trace.ArgMeta{{Type: "void*", Name: "addr"}}},
+	"call_usermodehelper":            {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "const char*const*", Name: "argv"}, {Type: "const char*const*", Name: "envp"}, {Type: "int", Name: "wait"}}},
+	"cap_capable":                    {Syscall: false, Params: []trace.ArgMeta{{Type: "int", Name: "cap"}}},
+	"capget":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "cap_user_header_t", Name: "hdrp"}, {Type: "cap_user_data_t", Name: "datap"}}},
+	"capset":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "cap_user_header_t", Name: "hdrp"}, {Type: "const cap_user_data_t", Name: "datap"}}},
+	"capture_bpf":                    {Syscall: false, Params: []trace.ArgMeta{}},
+	"capture_exec":                   {Syscall: false, Params: []trace.ArgMeta{}},
+	"capture_file_read":              {Syscall: false, Params: []trace.ArgMeta{}},
+	"capture_file_write":             {Syscall: false, Params: []trace.ArgMeta{}},
+	"capture_mem":                    {Syscall: false, Params: []trace.ArgMeta{}},
+	"capture_module":                 {Syscall: false, Params: []trace.ArgMeta{}},
+	"capture_net_packet":             {Syscall: false, Params: []trace.ArgMeta{}},
+	"cgroup_attach_task":             {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "cgroup_path"}, {Type: "const char*", Name: "comm"}, {Type: "pid_t", Name: "pid"}}},
+	"cgroup_mkdir":                   {Syscall: false, Params: []trace.ArgMeta{{Type: "u64", Name: "cgroup_id"}, {Type: "const char*", Name: "cgroup_path"}, {Type: "u32", Name: "hierarchy_id"}}},
+	"cgroup_rmdir":                   {Syscall: false, Params: []trace.ArgMeta{{Type: "u64", Name: "cgroup_id"}, {Type: "const char*", Name: "cgroup_path"}, {Type: "u32", Name: "hierarchy_id"}}},
+	"chdir":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "path"}}},
+	"chmod":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "mode_t", Name: "mode"}}},
+	"chown":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "uid_t", Name: "owner"}, {Type: "gid_t", Name: "group"}}},
+	"chown16":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "old_uid_t", Name: "owner"}, {Type: "old_gid_t", Name: "group"}}},
+	"chroot":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "path"}}},
+	"clock_adjtime":                  {Syscall: true, Params: []trace.ArgMeta{{Type: "const clockid_t", Name: "clk_id"}, {Type: "struct timex*", Name: "buf"}}},
+	"clock_adjtime64":                {Syscall: true, Params: []trace.ArgMeta{}},
+	"clock_getres":                   {Syscall: true, Params: []trace.ArgMeta{{Type: "const clockid_t", Name: "clockid"}, {Type: "struct timespec*", Name: "res"}}},
+	"clock_getres_time32":            {Syscall: true, Params: []trace.ArgMeta{{Type: "clockid_t", Name: "which_clock"}, {Type: "struct old_timespec32*", Name: "tp"}}},
+	"clock_gettime":                  {Syscall: true, Params: []trace.ArgMeta{{Type: "const clockid_t", Name: "clockid"}, {Type: "struct timespec*", Name: "tp"}}},
+	"clock_gettime32":                {Syscall: true, Params: []trace.ArgMeta{{Type: "clockid_t", Name: "which_clock"}, {Type: "struct old_timespec32*", Name: "tp"}}},
+	"clock_nanosleep":                {Syscall: true, Params: []trace.ArgMeta{{Type: "const clockid_t", Name: "clockid"}, {Type: "int", Name: "flags"}, {Type: "const struct timespec*", Name: "request"}, {Type: "struct timespec*", Name: "remain"}}},
+	"clock_nanosleep_time32":         {Syscall: true, Params: []trace.ArgMeta{{Type: "clockid_t", Name: "which_clock"}, {Type: "int", Name: "flags"}, {Type: "struct old_timespec32*", Name: "rqtp"}, {Type: "struct old_timespec32*", Name: "rmtp"}}},
+	"clock_settime":                  {Syscall: true, Params: []trace.ArgMeta{{Type: "const clockid_t", Name: "clockid"}, {Type: "const struct timespec*", Name: "tp"}}},
+	"clock_settime32":                {Syscall: true, Params: []trace.ArgMeta{{Type: "clockid_t", Name: "which_clock"}, {Type: "struct old_timespec32*", Name: "tp"}}},
+	"clone":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "unsigned long", Name: "flags"}, {Type: "void*", Name: "stack"}, {Type: "int*", Name: "parent_tid"}, {Type: "int*", Name: "child_tid"}, {Type: "unsigned long", Name: "tls"}}},
+	"clone3":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "struct clone_args*", Name: "cl_args"}, {Type: "size_t", Name: "size"}}},
+	"close":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}}},
+	"close_range":                    {Syscall: true, Params: []trace.ArgMeta{{Type: "unsigned int", Name: "first"}, {Type: "unsigned int", Name: "last"}}},
+	"commit_creds":                   {Syscall: false, Params: []trace.ArgMeta{{Type: "slim_cred_t", Name: "old_cred"}, {Type: "slim_cred_t", Name: "new_cred"}}},
+	"connect":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "sockfd"}, {Type: "struct sockaddr*", Name: "addr"}, {Type: "int", Name: "addrlen"}}},
+	"container_create":               {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "runtime"}, {Type: "const char*", Name: "container_id"}, {Type: "unsigned long", Name: "ctime"}, {Type: "const char*", Name: "container_image"}, {Type: "const char*", Name: "container_image_digest"}, {Type: "const char*", Name: "container_name"}, {Type: "const char*", Name: "pod_name"}, {Type: "const char*", Name: "pod_namespace"}, {Type: "const char*", Name: "pod_uid"}, {Type: "bool", Name: "pod_sandbox"}}},
+	"container_remove":               {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "runtime"}, {Type: "const char*", Name: "container_id"}}},
+	"copy_file_range":                {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd_in"}, {Type: "off_t*", Name: "off_in"}, {Type: "int", Name: "fd_out"}, {Type: "off_t*", Name: "off_out"}, {Type: "size_t", Name: "len"}, {Type: "unsigned int", Name: "flags"}}},
+	"creat":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "mode_t", Name: "mode"}}},
+	"create_module":                  {Syscall: true, Params: []trace.ArgMeta{}},
+	"debugfs_create_dir":             {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "name"}, {Type: "const char*", Name: "path"}}},
+	"debugfs_create_file":            {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "file_name"}, {Type: "const char*", Name: "path"}, {Type: "mode_t", Name: "mode"}, {Type: "void*", Name: "proc_ops_addr"}}},
+	"delete_module":                  {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "name"}, {Type: "int", Name: "flags"}}},
+	"device_add":                     {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "name"}, {Type: "const char*", Name: "parent_name"}}},
+	"dirty_pipe_splice":              {Syscall: false, Params: []trace.ArgMeta{{Type: "unsigned long", Name: "inode_in"}, {Type: "umode_t", Name: "in_file_type"}, {Type: "const char*", Name: "in_file_path"}, {Type: "loff_t", Name: "exposed_data_start_offset"}, {Type: "size_t", Name: "exposed_data_len"}, {Type: "unsigned long", Name: "inode_out"}, {Type: "unsigned int", Name: "out_pipe_last_buffer_flags"}}},
+	"do_exit":                        {Syscall: false, Params: []trace.ArgMeta{}},
+	"do_init_module":                 {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "name"}, {Type: "const char*", Name: "version"}, {Type: "const char*", Name: "src_version"}}},
+	"do_mmap":                        {Syscall: false, Params: []trace.ArgMeta{{Type: "void*", Name: "addr"}, {Type: "const char*", Name: "pathname"}, {Type: "unsigned int", Name: "flags"}, {Type: "dev_t", Name: "dev"}, {Type: "unsigned long", Name: "inode"}, {Type: "unsigned long", Name: "ctime"}, {Type: "unsigned long", Name: "pgoff"}, {Type: "unsigned long", Name: "len"}, {Type: "unsigned long", Name: "prot"}, {Type: "unsigned long", Name: "mmap_flags"}}},
+	"do_sigaction":                   {Syscall: false, Params: []trace.ArgMeta{{Type: "int", Name: "sig"}, {Type: "bool", Name: "is_sa_initialized"}, {Type: "unsigned long", Name: "sa_flags"}, {Type: "unsigned long", Name: "sa_mask"}, {Type: "u8", Name: "sa_handle_method"}, {Type: "void*", Name: "sa_handler"}, {Type: "bool", Name: "is_old_sa_initialized"}, {Type: "unsigned long", Name: "old_sa_flags"}, {Type: "unsigned long", Name: "old_sa_mask"}, {Type: "u8", Name: "old_sa_handle_method"}, {Type: "void*", Name: "old_sa_handler"}}},
+	"do_truncate":                    {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "unsigned long", Name: "inode"}, {Type: "dev_t", Name: "dev"}, {Type: "u64", Name: "length"}}},
+	"dup":                            {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "oldfd"}}},
+	"dup2":                           {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "oldfd"}, {Type: "int", Name: "newfd"}}},
+	"dup3":                           {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "oldfd"}, {Type: "int", Name: "newfd"}, {Type: "int", Name: "flags"}}},
+	"epoll_create":                   {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "size"}}},
+	"epoll_create1":                  {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "flags"}}},
+	"epoll_ctl":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "epfd"}, {Type: "int", Name: "op"}, {Type: "int", Name: "fd"}, {Type: "struct epoll_event*", Name: "event"}}},
+	"epoll_ctl_old":                  {Syscall: true, Params: []trace.ArgMeta{}},
+	"epoll_pwait":                    {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "epfd"}, {Type: "struct epoll_event*", Name: "events"}, {Type: "int", Name: "maxevents"}, {Type: "int", Name: "timeout"}, {Type: "const sigset_t*", Name: "sigmask"}, {Type: "size_t", Name: "sigsetsize"}}},
+	"epoll_pwait2":                   {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "struct epoll_event*", Name: "events"}, {Type: "int", Name: "maxevents"}, {Type: "const struct timespec*", Name: "timeout"}, {Type: "const sigset_t*", Name: "sigset"}}},
+	"epoll_wait":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "epfd"}, {Type: "struct epoll_event*", Name: "events"}, {Type: "int", Name: "maxevents"}, {Type: "int", Name: "timeout"}}},
+	"epoll_wait_old":                 {Syscall: true, Params: []trace.ArgMeta{}},
+	"eventfd":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "unsigned int", Name: "initval"}, {Type: "int", Name: "flags"}}},
+	"eventfd2":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "unsigned int", Name: "initval"}, {Type: "int", Name: "flags"}}},
+	"exec_test":                      {Syscall: false, Params: []trace.ArgMeta{}},
+	"execute_finished":               {Syscall: false, Params: []trace.ArgMeta{}},
+	"execve":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "const char*const*", Name: "argv"}, {Type: "const char*const*", Name: "envp"}}},
+	"execveat":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "dirfd"}, {Type: "const char*", Name: "pathname"}, {Type: "const char*const*", Name: "argv"}, {Type: "const char*const*", Name: "envp"}, {Type: "int", Name: "flags"}}},
+	"existing_container":             {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "runtime"}, {Type: "const char*", Name: "container_id"}, {Type: "unsigned long", Name: "ctime"}, {Type: "const char*", Name: "container_image"}, {Type: "const char*", Name: "container_image_digest"}, {Type: "const char*", Name: "container_name"}, {Type: "const char*", Name: "pod_name"}, {Type: "const char*", Name: "pod_namespace"}, {Type: "const char*", Name: "pod_uid"}, {Type: "bool", Name: "pod_sandbox"}}},
+	"exit":                           {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "status"}}},
+	"exit_group":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "status"}}},
+	"faccessat":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "dirfd"}, {Type: "const char*", Name: "pathname"}, {Type: "int", Name: "mode"}, {Type: "int", Name: "flags"}}},
+	"faccessat2":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "const char*", Name: "path"}, {Type: "int", Name: "mode"}, {Type: "int", Name: "flag"}}},
+	"fadvise64":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "off_t", Name: "offset"}, {Type: "size_t", Name: "len"}, {Type: "int", Name: "advice"}}},
+	"fadvise64_64":                   {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "loff_t", Name: "offset"}, {Type: "loff_t", Name: "len"}, {Type: "int", Name: "advice"}}},
+	"failed_attach":                  {Syscall: false, Params: []trace.ArgMeta{}},
+	"fallocate":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "int", Name: "mode"}, {Type: "off_t", Name: "offset"}, {Type: "off_t", Name: "len"}}},
+	"fanotify_init":                  {Syscall: true, Params: []trace.ArgMeta{{Type: "unsigned int", Name: "flags"}, {Type: "unsigned int", Name: "event_f_flags"}}},
+	"fanotify_mark":                  {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fanotify_fd"}, {Type: "unsigned int", Name: "flags"}, {Type: "u64", Name: "mask"}, {Type: "int", Name: "dirfd"}, {Type: "const char*", Name: "pathname"}}},
+	"fchdir":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}}},
+	"fchmod":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "mode_t", Name: "mode"}}},
+	"fchmodat":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "dirfd"}, {Type: "const char*", Name: "pathname"}, {Type: "mode_t", Name: "mode"}, {Type: "int", Name: "flags"}}},
+	"fchown":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "uid_t", Name: "owner"}, {Type: "gid_t", Name: "group"}}},
+	"fchown16":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "unsigned int", Name: "fd"}, {Type: "old_uid_t", Name: "user"}, {Type: "old_gid_t", Name: "group"}}},
+	"fchownat":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "dirfd"}, {Type: "const char*", Name: "pathname"}, {Type: "uid_t", Name: "owner"}, {Type: "gid_t", Name: "group"}, {Type: "int", Name: "flags"}}},
+	"fcntl":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "int", Name: "cmd"}, {Type: "unsigned long", Name: "arg"}}},
+	"fcntl64":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "int", Name: "cmd"}, {Type: "unsigned long", Name: "arg"}}},
+	"fdatasync":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}}},
+	"fgetxattr":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "const char*", Name: "name"}, {Type: "void*", Name: "value"}, {Type: "size_t", Name: "size"}}},
+	"file_modification":              {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "file_path"}, {Type: "dev_t", Name: "dev"}, {Type: "unsigned long", Name: "inode"}, {Type: "unsigned long", Name: "old_ctime"}, {Type: "unsigned long", Name: "new_ctime"}}},
+	"finit_module":                   {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "const char*", Name: "param_values"}, {Type: "int", Name: "flags"}}},
+	"flistxattr":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "char*", Name: "list"}, {Type: "size_t", Name: "size"}}},
+	"flock":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "int", Name: "operation"}}},
+	"fork":                           {Syscall: true, Params: []trace.ArgMeta{}},
+	"fremovexattr":                   {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "const char*", Name: "name"}}},
+	"fsconfig":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "int*", Name: "fs_fd"}, {Type: "unsigned int", Name: "cmd"}, {Type: "const char*", Name: "key"}, {Type: "const void*", Name: "value"}, {Type: "int", Name: "aux"}}},
+	"fsetxattr":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "const char*", Name: "name"}, {Type: "const void*", Name: "value"}, {Type: "size_t", Name: "size"}, {Type: "int", Name: "flags"}}},
+	"fsmount":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fsfd"}, {Type: "unsigned int", Name: "flags"}, {Type: "unsigned int", Name: "ms_flags"}}},
+	"fsopen":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "fsname"}, {Type: "unsigned int", Name: "flags"}}},
+	"fspick":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "dirfd"}, {Type: "const char*", Name: "pathname"}, {Type: "unsigned int", Name: "flags"}}},
+	"fstat":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "struct stat*", Name: "statbuf"}}},
+	"fstat64":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "struct stat64*", Name: "statbuf"}}},
+	"fstatfs":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "struct statfs*", Name: "buf"}}},
+	"fstatfs64":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "size_t", Name: "sz"}, {Type: "struct statfs64*", Name: "buf"}}},
+	"fsync":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}}},
+	"ftime":                          {Syscall: true, Params: []trace.ArgMeta{}},
+	"ftrace_hook":                    {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "symbol"}, {Type: "const char*", Name: "trampoline"}, {Type: "const char*", Name: "callback"}, {Type: "off_t", Name: "callback_offset"}, {Type: "const char*", Name: "callback_owner"}, {Type: "const char*", Name: "flags"}, {Type: "unsigned long", Name: "count"}}},
+	"ftruncate":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "off_t", Name: "length"}}},
+	"ftruncate64":                    {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "off_t", Name: "length"}}},
+	"futex":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "int*", Name: "uaddr"}, {Type: "int", Name: "futex_op"}, {Type: "int", Name: "val"}, {Type: "const struct timespec*", Name: "timeout"}, {Type: "int*", Name: "uaddr2"}, {Type: "int", Name: "val3"}}},
+	"futex_time32":                   {Syscall: true, Params: []trace.ArgMeta{{Type: "u32*", Name: "uaddr"}, {Type: "int", Name: "op"}, {Type: "u32", Name: "val"}, {Type: "struct old_timespec32*", Name: "utime"}, {Type: "u32*", Name: "uaddr2"}, {Type: "u32", Name: "val3"}}},
+	"futimesat":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "dirfd"}, {Type: "const char*", Name: "pathname"}, {Type: "struct timeval*", Name: "times"}}},
+	"get_kernel_syms":                {Syscall: true, Params: []trace.ArgMeta{}},
+	"get_mempolicy":                  {Syscall: true, Params: []trace.ArgMeta{{Type: "int*", Name: "mode"}, {Type: "unsigned long*", Name: "nodemask"}, {Type: "unsigned long", Name: "maxnode"}, {Type: "void*", Name: "addr"}, {Type: "unsigned long", Name: "flags"}}},
+	"get_robust_list":                {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "pid"}, {Type: "struct robust_list_head**", Name: "head_ptr"}, {Type: "size_t*", Name: "len_ptr"}}},
+	"get_thread_area":                {Syscall: true, Params: []trace.ArgMeta{{Type: "struct user_desc*", Name: "u_info"}}},
+	"getcpu":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "unsigned int*", Name: "cpu"}, {Type: "unsigned int*", Name: "node"}, {Type: "struct getcpu_cache*", Name: "tcache"}}},
+	"getcwd":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "char*", Name: "buf"}, {Type: "size_t", Name: "size"}}},
+	"getdents":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "struct linux_dirent*", Name: "dirp"}, {Type: "unsigned int", Name: "count"}}},
+	"getdents64":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "unsigned int", Name: "fd"}, {Type: "struct linux_dirent64*", Name: "dirp"}, {Type: "unsigned int", Name: "count"}}},
+	"getegid":                        {Syscall: true, Params: []trace.ArgMeta{}},
+	"getegid16":                      {Syscall: true, Params: []trace.ArgMeta{}},
+	"geteuid":                        {Syscall: true, Params: []trace.ArgMeta{}},
+	"geteuid16":                      {Syscall: true, Params: []trace.ArgMeta{}},
+	"getgid":                         {Syscall: true, Params: []trace.ArgMeta{}},
+	"getgid16":                       {Syscall: true, Params: []trace.ArgMeta{}},
+	"getgroups":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "size"}, {Type: "gid_t*", Name: "list"}}},
+	"getgroups16":                    {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "size"}, {Type: "old_gid_t*", Name: "list"}}},
+	"getitimer":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "which"}, {Type: "struct itimerval*", Name: "curr_value"}}},
+	"getpeername":                    {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "sockfd"}, {Type: "struct sockaddr*", Name: "addr"}, {Type: "int*", Name: "addrlen"}}},
+	"getpgid":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "pid_t", Name: "pid"}}},
+	"getpgrp":                        {Syscall: true, Params: []trace.ArgMeta{}},
+	"getpid":                         {Syscall: true, Params: []trace.ArgMeta{}},
+	"getpmsg":                        {Syscall: true, Params: []trace.ArgMeta{}},
+	"getppid":                        {Syscall: true, Params: []trace.ArgMeta{}},
+	"getpriority":                    {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "which"}, {Type: "int", Name: "who"}}},
+	"getrandom":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "void*", Name: "buf"}, {Type: "size_t", Name: "buflen"}, {Type: "unsigned int", Name: "flags"}}},
+	"getresgid":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "gid_t*", Name: "rgid"}, {Type: "gid_t*", Name: "egid"}, {Type: "gid_t*", Name: "sgid"}}},
+	"getresgid16":                    {Syscall: true, Params: []trace.ArgMeta{{Type: "old_gid_t*", Name: "rgid"}, {Type: "old_gid_t*", Name: "egid"}, {Type: "old_gid_t*", Name: "sgid"}}},
+	"getresuid":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "uid_t*", Name: "ruid"}, {Type: "uid_t*", Name: "euid"}, {Type: "uid_t*", Name: "suid"}}},
+	"getresuid16":                    {Syscall: true, Params: []trace.ArgMeta{{Type: "old_uid_t*", Name: "ruid"}, {Type: "old_uid_t*", Name: "euid"}, {Type: "old_uid_t*", Name: "suid"}}},
+	"getrlimit":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "resource"}, {Type: "struct rlimit*", Name: "rlim"}}},
+	"getrusage":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "who"}, {Type: "struct rusage*", Name: "usage"}}},
+	"getsid":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "pid_t", Name: "pid"}}},
+	"getsockname":                    {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "sockfd"}, {Type: "struct sockaddr*", Name: "addr"}, {Type: "int*", Name: "addrlen"}}},
+	"getsockopt":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "sockfd"}, {Type: "int", Name: "level"}, {Type: "int", Name: "optname"}, {Type: "void*", Name: "optval"}, {Type: "int*", Name: "optlen"}}},
+	"gettid":                         {Syscall: true, Params: []trace.ArgMeta{}},
+	"gettimeofday":                   {Syscall: true, Params: []trace.ArgMeta{{Type: "struct timeval*", Name: "tv"}, {Type: "struct timezone*", Name: "tz"}}},
+	"getuid":                         {Syscall: true, Params: []trace.ArgMeta{}},
+	"getuid16":                       {Syscall: true, Params: []trace.ArgMeta{}},
+	"getxattr":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "path"}, {Type: "const char*", Name: "name"}, {Type: "void*", Name: "value"}, {Type: "size_t", Name: "size"}}},
+	"gtty":                           {Syscall: true, Params: []trace.ArgMeta{}},
+	"hidden_inodes":                  {Syscall: false, Params: []trace.ArgMeta{{Type: "char*", Name: "hidden_process"}}},
+	"hidden_kernel_module":           {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "address"}, {Type: "const char*", Name: "name"}, {Type: "const char*", Name: "srcversion"}}},
+	"hidden_kernel_module_seeker":    {Syscall: false, Params: []trace.ArgMeta{{Type: "unsigned long", Name: "address"}, {Type: "bytes", Name: "name"}, {Type: "unsigned int", Name: "flags"}, {Type: "bytes", Name: "srcversion"}}},
+	"hooked_proc_fops":               {Syscall: false, Params: []trace.ArgMeta{{Type: "[]trace.HookedSymbolData", Name: "hooked_fops_pointers"}}},
+	"hooked_seq_ops":                 {Syscall: false, Params: []trace.ArgMeta{{Type: "map[string]trace.HookedSymbolData", Name: "hooked_seq_ops"}}},
+	"hooked_syscall":                 {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "syscall"}, {Type: "const char*", Name: "address"}, {Type: "const char*", Name: "function"}, {Type: "const char*", Name: "owner"}}},
+	"idle":                           {Syscall: true, Params: []trace.ArgMeta{}},
+	"init_module":                    {Syscall: true, Params: []trace.ArgMeta{{Type: "void*", Name: "module_image"}, {Type: "unsigned long", Name: "len"}, {Type: "const char*", Name: "param_values"}}},
+	"init_namespaces":                {Syscall: false, Params: []trace.ArgMeta{{Type: "u32", Name: "cgroup"}, {Type: "u32", Name: "ipc"}, {Type: "u32", Name: "mnt"}, {Type: "u32", Name: "net"}, {Type: "u32", Name: "pid"}, {Type: "u32", Name: "pid_for_children"}, {Type: "u32", Name: "time"}, {Type: "u32", Name: "time_for_children"}, {Type: "u32", Name: "user"}, {Type: "u32", Name: "uts"}}},
+	"inotify_add_watch":              {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "const char*", Name: "pathname"}, {Type: "u32", Name: "mask"}}},
+	"inotify_init":                   {Syscall: true, Params: []trace.ArgMeta{}},
+	"inotify_init1":                  {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "flags"}}},
+	"inotify_rm_watch":               {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "int", Name: "wd"}}},
+	"inotify_watch":                  {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "unsigned long", Name: "inode"}, {Type: "dev_t", Name: "dev"}}},
+	"io_cancel":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "io_context_t", Name: "ctx_id"}, {Type: "struct iocb*", Name: "iocb"}, {Type: "struct io_event*", Name: "result"}}},
+	"io_destroy":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "io_context_t", Name: "ctx_id"}}},
+	"io_getevents":                   {Syscall: true, Params: []trace.ArgMeta{{Type: "io_context_t", Name: "ctx_id"}, {Type: "long", Name: "min_nr"}, {Type: "long", Name: "nr"}, {Type: "struct io_event*", Name: "events"}, {Type: "struct timespec*", Name: "timeout"}}},
+	"io_pgetevents":                  {Syscall: true, Params: []trace.ArgMeta{{Type: "aio_context_t", Name: "ctx_id"}, {Type: "long", Name: "min_nr"}, {Type: "long", Name: "nr"}, {Type: "struct io_event*", Name: "events"}, {Type: "struct timespec*", Name: "timeout"}, {Type: "const struct __aio_sigset*", Name: "usig"}}},
+	"io_pgetevents_time32":           {Syscall: true, Params: []trace.ArgMeta{}},
+	"io_setup":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "unsigned int", Name: "nr_events"}, {Type: "io_context_t*", Name: "ctx_idp"}}},
+	"io_submit":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "io_context_t", Name: "ctx_id"}, {Type: "long", Name: "nr"}, {Type: "struct iocb**", Name: "iocbpp"}}},
+	"io_uring_enter":                 {Syscall: true, Params: []trace.ArgMeta{{Type: "unsigned int", Name: "fd"}, {Type: "unsigned int", Name: "to_submit"}, {Type: "unsigned int", Name: "min_complete"}, {Type: "unsigned int", Name: "flags"}, {Type: "sigset_t*", Name: "sig"}}},
+	"io_uring_register":              {Syscall: true, Params: []trace.ArgMeta{{Type: "unsigned int", Name: "fd"}, {Type: "unsigned int", Name: "opcode"}, {Type: "void*", Name: "arg"}, {Type: "unsigned int", Name: "nr_args"}}},
+	"io_uring_setup":                 {Syscall: true, Params: []trace.ArgMeta{{Type: "unsigned int", Name: "entries"}, {Type: "struct io_uring_params*", Name: "p"}}},
+	"ioctl":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "unsigned long", Name: "request"}, {Type: "unsigned long", Name: "arg"}}},
+	"ioperm":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "unsigned long", Name: "from"}, {Type: "unsigned long", Name: "num"}, {Type: "int", Name: "turn_on"}}},
+	"iopl":                           {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "level"}}},
+	"ioprio_get":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "which"}, {Type: "int", Name: "who"}}},
+	"ioprio_set":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "which"}, {Type: "int", Name: "who"}, {Type: "int", Name: "ioprio"}}},
+	"ipc":                            {Syscall: true, Params: []trace.ArgMeta{{Type: "unsigned int", Name: "call"}, {Type: "int", Name: "first"}, {Type: "unsigned long", Name: "second"}, {Type: "unsigned long", Name: "third"}, {Type: "void*", Name: "ptr"}, {Type: "long", Name: "fifth"}}},
+	"kallsyms_lookup_name":           {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "symbol_name"}, {Type: "void*", Name: "symbol_address"}}},
+	"kcmp":                           {Syscall: true, Params: []trace.ArgMeta{{Type: "pid_t", Name: "pid1"}, {Type: "pid_t", Name: "pid2"}, {Type: "int", Name: "type"}, {Type: "unsigned long", Name: "idx1"}, {Type: "unsigned long", Name: "idx2"}}},
+	"kexec_file_load":                {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "kernel_fd"}, {Type: "int", Name: "initrd_fd"}, {Type: "unsigned long", Name: "cmdline_len"}, {Type: "const char*", Name: "cmdline"}, {Type: "unsigned long", Name: "flags"}}},
+	"kexec_load":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "unsigned long", Name: "entry"}, {Type: "unsigned long", Name: "nr_segments"}, {Type: "struct kexec_segment*", Name: "segments"}, {Type: "unsigned long", Name: "flags"}}},
+	"keyctl":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "operation"}, {Type: "unsigned long", Name: "arg2"}, {Type: "unsigned long", Name: "arg3"}, {Type: "unsigned long", Name: "arg4"}, {Type: "unsigned long", Name: "arg5"}}},
+	"kill":                           {Syscall: true, Params: []trace.ArgMeta{{Type: "pid_t", Name: "pid"}, {Type: "int", Name: "sig"}}},
+	"kprobe_attach":                  {Syscall: false, Params: []trace.ArgMeta{{Type: "char*", Name: "symbol_name"}, {Type: "void*", Name: "pre_handler_addr"}, {Type: "void*", Name: "post_handler_addr"}}},
+	"landlock_add_rule":              {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "ruleset_fd"}, {Type: "landlock_rule_type", Name: "rule_type"}, {Type: "void*", Name: "rule_attr"}, {Type: "u32", Name: "flags"}}},
+	"landlock_create_ruleset":        {Syscall: true, Params: []trace.ArgMeta{{Type: "struct landlock_ruleset_attr*", Name: "attr"}, {Type: "size_t", Name: "size"}, {Type: "u32", Name: "flags"}}},
+	"landlock_restrict_self":         {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "ruleset_fd"}, {Type: "u32", Name: "flags"}}},
+	"lchown":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "uid_t", Name: "owner"}, {Type: "gid_t", Name: "group"}}},
+	"lchown16":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "old_uid_t", Name: "owner"}, {Type: "old_gid_t", Name: "group"}}},
+	"lgetxattr":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "path"}, {Type: "const char*", Name: "name"}, {Type: "void*", Name: "value"}, {Type: "size_t", Name: "size"}}},
+	"link":                           {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "oldpath"}, {Type: "const char*", Name: "newpath"}}},
+	"linkat":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "olddirfd"}, {Type: "const char*", Name: "oldpath"}, {Type: "int", Name: "newdirfd"}, {Type: "const char*", Name: "newpath"}, {Type: "unsigned int", Name: "flags"}}},
+	"listen":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "sockfd"}, {Type: "int", Name: "backlog"}}},
+	"listxattr":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "path"}, {Type: "char*", Name: "list"}, {Type: "size_t", Name: "size"}}},
+	"llistxattr":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "path"}, {Type: "char*", Name: "list"}, {Type: "size_t", Name: "size"}}},
+	"llseek":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "unsigned int", Name: "fd"}, {Type: "unsigned long", Name: "offset_high"}, {Type: "unsigned long", Name: "offset_low"}, {Type: "loff_t*", Name: "result"}, {Type: "unsigned int", Name: "whence"}}},
+	"load_elf_phdrs":                 {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "dev_t", Name: "dev"}, {Type: "unsigned long", Name: "inode"}}},
+	"lock":                           {Syscall: true, Params: []trace.ArgMeta{}},
+	"lookup_dcookie":                 {Syscall: true, Params: []trace.ArgMeta{{Type: "u64", Name: "cookie"}, {Type: "char*", Name: "buffer"}, {Type: "size_t", Name: "len"}}},
+	"lremovexattr":                   {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "path"}, {Type: "const char*", Name: "name"}}},
+	"lseek":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "off_t", Name: "offset"}, {Type: "unsigned int", Name: "whence"}}},
+	"lsetxattr":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "path"}, {Type: "const char*", Name: "name"}, {Type: "const void*", Name: "value"}, {Type: "size_t", Name: "size"}, {Type: "int", Name: "flags"}}},
+	"lstat":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "struct stat*", Name: "statbuf"}}},
+	"lstat64":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "struct stat64*", Name: "statbuf"}}},
+	"madvise":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "void*", Name: "addr"}, {Type: "size_t", Name: "length"}, {Type: "int", Name: "advice"}}},
+	"magic_write":                    {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "bytes", Name: "bytes"}, {Type: "dev_t", Name: "dev"}, {Type: "unsigned long", Name: "inode"}}},
+	"mbind":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "void*", Name: "addr"}, {Type: "unsigned long", Name: "len"}, {Type: "int", Name: "mode"}, {Type: "const unsigned long*", Name: "nodemask"}, {Type: "unsigned long", Name: "maxnode"}, {Type: "unsigned int", Name: "flags"}}},
+	"mem_prot_alert":                 {Syscall: false, Params: []trace.ArgMeta{{Type: "u32", Name: "alert"}, {Type: "void*", Name: "addr"}, {Type: "size_t", Name: "len"}, {Type: "int", Name: "prot"}, {Type: "int", Name: "prev_prot"}, {Type: "const char*", Name: "pathname"}, {Type: "dev_t", Name: "dev"}, {Type: "unsigned long", Name: "inode"}, {Type: "u64", Name: "ctime"}}},
+	"membarrier":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "cmd"}, {Type: "int", Name: "flags"}}},
+	"memfd_create":                   {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "name"}, {Type: "unsigned int", Name: "flags"}}},
+	"memfd_secret":                   {Syscall: true, Params: []trace.ArgMeta{{Type: "unsigned int", Name: "flags"}}},
+	"migrate_pages":                  {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "pid"}, {Type: "unsigned long", Name: "maxnode"}, {Type: "const unsigned long*", Name: "old_nodes"}, {Type: "const unsigned long*", Name: "new_nodes"}}},
+	"mincore":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "void*", Name: "addr"}, {Type: "size_t", Name: "length"}, {Type: "unsigned char*", Name: "vec"}}},
+	"missing_ksymbol":                {Syscall: false, Params: []trace.ArgMeta{}},
+	"mkdir":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "mode_t", Name: "mode"}}},
+	"mkdirat":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "dirfd"}, {Type: "const char*", Name: "pathname"}, {Type: "mode_t", Name: "mode"}}},
+	"mknod":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "mode_t", Name: "mode"}, {Type: "dev_t", Name: "dev"}}},
+	"mknodat":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "dirfd"}, {Type: "const char*", Name: "pathname"}, {Type: "mode_t", Name: "mode"}, {Type: "dev_t", Name: "dev"}}},
+	"mlock":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "const void*", Name: "addr"}, {Type: "size_t", Name: "len"}}},
+	"mlock2":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "const void*", Name: "addr"}, {Type: "size_t", Name: "len"}, {Type: "int", Name: "flags"}}},
+	"mlockall":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "flags"}}},
+	"mmap":                           {Syscall: true, Params: []trace.ArgMeta{{Type: "void*", Name: "addr"}, {Type: "size_t", Name: "length"}, {Type: "int", Name: "prot"}, {Type: "int", Name: "flags"}, {Type: "int", Name: "fd"}, {Type: "off_t", Name: "off"}}},
+	"mmap2":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "unsigned long", Name: "addr"}, {Type: "unsigned long", Name: "length"}, {Type: "unsigned long", Name: "prot"}, {Type: "unsigned long", Name: "flags"}, {Type: "unsigned long", Name: "fd"}, {Type: "unsigned long", Name: "pgoffset"}}},
+	"modify_ldt":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "func"}, {Type: "void*", Name: "ptr"}, {Type: "unsigned long", Name: "bytecount"}}},
+	"module_free":                    {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "name"}, {Type: "const char*", Name: "version"}, {Type: "const char*", Name: "src_version"}}},
+	"module_load":                    {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "name"}, {Type: "const char*", Name: "version"}, {Type: "const char*", Name: "src_version"}}},
+	"mount":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "source"}, {Type: "const char*", Name: "target"}, {Type: "const char*", Name: "filesystemtype"}, {Type: "unsigned long", Name: "mountflags"}, {Type: "const void*", Name: "data"}}},
+	"mount_setattr":                  {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "dfd"}, {Type: "char*", Name: "path"}, {Type: "unsigned int", Name: "flags"}, {Type: "struct mount_attr*", Name: "uattr"}, {Type: "size_t", Name: "usize"}}},
+	"move_mount":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "from_dfd"}, {Type: "const char*", Name: "from_path"}, {Type: "int", Name: "to_dfd"}, {Type: "const char*", Name: "to_path"}, {Type: "unsigned int", Name: "flags"}}},
+	"move_pages":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "pid"}, {Type: "unsigned long", Name: "count"}, {Type: "const void**", Name: "pages"}, {Type: "const int*", Name: "nodes"}, {Type: "int*", Name: "status"}, {Type: "int", Name: "flags"}}},
+	"mprotect":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "void*", Name: "addr"}, {Type: "size_t", Name: "len"}, {Type: "int", Name: "prot"}}},
+	"mpx":                            {Syscall: true, Params: []trace.ArgMeta{}},
+	"mq_getsetattr":                  {Syscall: true, Params: []trace.ArgMeta{{Type: "mqd_t", Name: "mqdes"}, {Type: "const struct mq_attr*", Name: "newattr"}, {Type: "struct mq_attr*", Name: "oldattr"}}},
+	"mq_notify":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "mqd_t", Name: "mqdes"}, {Type: "const struct sigevent*", Name: "sevp"}}},
+	"mq_open":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "name"}, {Type: "int", Name: "oflag"}, {Type: "mode_t", Name: "mode"}, {Type: "struct mq_attr*", Name: "attr"}}},
+	"mq_timedreceive":                {Syscall: true, Params: []trace.ArgMeta{{Type: "mqd_t", Name: "mqdes"}, {Type: "char*", Name: "msg_ptr"}, {Type: "size_t", Name: "msg_len"}, {Type: "unsigned int*", Name: "msg_prio"}, {Type: "const struct timespec*", Name: "abs_timeout"}}},
+	"mq_timedreceive_time32":         {Syscall: true, Params: []trace.ArgMeta{{Type: "mqd_t", Name: "mqdes"}, {Type: "char*", Name: "u_msg_ptr"}, {Type: "unsigned int", Name: "msg_len"}, {Type: "unsigned int*", Name: "u_msg_prio"}, {Type: "struct old_timespec32*", Name: "u_abs_timeout"}}},
+	"mq_timedsend":                   {Syscall: true, Params: []trace.ArgMeta{{Type: "mqd_t", Name: "mqdes"}, {Type: "const char*", Name: "msg_ptr"}, {Type: "size_t", Name: "msg_len"}, {Type: "unsigned int", Name: "msg_prio"}, {Type: "const struct timespec*", Name: "abs_timeout"}}},
+	"mq_timedsend_time32":            {Syscall: true, Params: []trace.ArgMeta{{Type: "mqd_t", Name: "mqdes"}, {Type: "char*", Name: "u_msg_ptr"}, {Type: "unsigned int", Name: "msg_len"}, {Type: "unsigned int", Name: "msg_prio"}, {Type: "struct old_timespec32*", Name: "u_abs_timeout"}}},
+	"mq_unlink":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "name"}}},
+	"mremap":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "void*", Name: "old_address"}, {Type: "size_t", Name: "old_size"}, {Type: "size_t", Name: "new_size"}, {Type: "int", Name: "flags"}, {Type: "void*", Name: "new_address"}}},
+	"msgctl":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "msqid"}, {Type: "int", Name: "cmd"}, {Type: "struct msqid_ds*", Name: "buf"}}},
+	"msgget":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "key_t", Name: "key"}, {Type: "int", Name: "msgflg"}}},
+	"msgrcv":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "msqid"}, {Type: "struct msgbuf*", Name: "msgp"}, {Type: "size_t", Name: "msgsz"}, {Type: "long", Name: "msgtyp"}, {Type: "int", Name: "msgflg"}}},
+	"msgsnd":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "msqid"}, {Type: "struct msgbuf*", Name: "msgp"}, {Type: "size_t", Name: "msgsz"}, {Type: "int", Name: "msgflg"}}},
+	"msync":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "void*", Name: "addr"}, {Type: "size_t", Name: "length"}, {Type: "int", Name: "flags"}}},
+	"munlock":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "const void*", Name: "addr"}, {Type: "size_t", Name: "len"}}},
+	"munlockall":                     {Syscall: true, Params: []trace.ArgMeta{}},
+	"munmap":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "void*", Name: "addr"}, {Type: "size_t", Name: "length"}}},
+	"name_to_handle_at":              {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "dirfd"}, {Type: "const char*", Name: "pathname"}, {Type: "struct file_handle*", Name: "handle"}, {Type: "int*", Name: "mount_id"}, {Type: "int", Name: "flags"}}},
+	"nanosleep":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "const struct timespec*", Name: "req"}, {Type: "struct timespec*", Name: "rem"}}},
+	"net_flow_tcp_begin":             {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "conn_direction"}, {Type: "const char*", Name: "src"}, {Type: "const char*", Name: "dst"}, {Type: "u16", Name: "src_port"}, {Type: "u16", Name: "dst_port"}, {Type: "const char **", Name: "src_dns"}, {Type: "const char **", Name: "dst_dns"}}},
+	"net_flow_tcp_end":               {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "conn_direction"}, {Type: "const char*", Name: "src"}, {Type: "const char*", Name: "dst"}, {Type: "u16", Name: "src_port"}, {Type: "u16", Name: "dst_port"}, {Type: "const char **", Name: "src_dns"}, {Type: "const char **", Name: "dst_dns"}}},
+	"net_packet_base":                {Syscall: false, Params: []trace.ArgMeta{}},
+	"net_packet_capture":             {Syscall: false, Params: []trace.ArgMeta{{Type: "bytes", Name: "payload"}}},
+	"net_packet_dns":                 {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "src"}, {Type: "const char*", Name: "dst"}, {Type: "u16", Name: "src_port"}, {Type: "u16", Name: "dst_port"}, {Type: "trace.PacketMetadata", Name: "metadata"}, {Type: "trace.ProtoDNS", Name: "proto_dns"}}},
+	"net_packet_dns_base":            {Syscall: false, Params: []trace.ArgMeta{{Type: "bytes", Name: "payload"}}},
+	"net_packet_dns_request":         {Syscall: false, Params: []trace.ArgMeta{{Type: "trace.PktMeta", Name: "metadata"}, {Type: "[]trace.DnsQueryData", Name: "dns_questions"}}},
+	"net_packet_dns_response":        {Syscall: false, Params: []trace.ArgMeta{{Type: "trace.PktMeta", Name: "metadata"}, {Type: "[]trace.DnsResponseData", Name: "dns_response"}}},
+	"net_packet_flow_base":           {Syscall: false, Params: []trace.ArgMeta{{Type: "bytes", Name: "payload"}}},
+	"net_packet_http":                {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "src"}, {Type: "const char*", Name: "dst"}, {Type: "u16", Name: "src_port"}, {Type: "u16", Name: "dst_port"}, {Type: "trace.PacketMetadata", Name: "metadata"}, {Type: "trace.ProtoHTTP", Name: "proto_http"}}},
+	"net_packet_http_base":           {Syscall: false, Params: []trace.ArgMeta{{Type: "bytes", Name: "payload"}}},
+	"net_packet_http_request":        {Syscall: false, Params: []trace.ArgMeta{{Type: "trace.PktMeta", Name: "metadata"}, {Type: "trace.ProtoHTTPRequest", Name: "http_request"}}},
+	"net_packet_http_response":       {Syscall: false, Params: []trace.ArgMeta{{Type: "trace.PktMeta", Name: "metadata"}, {Type: "trace.ProtoHTTPResponse", Name: "http_response"}}},
+	"net_packet_icmp":                {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "src"}, {Type: "const char*", Name: "dst"}, {Type: "trace.PacketMetadata", Name: "metadata"}, {Type: "trace.ProtoICMP", Name: "proto_icmp"}}},
+	"net_packet_icmp_base":           {Syscall: false, Params: []trace.ArgMeta{{Type: "bytes", Name: "payload"}}},
+	"net_packet_icmpv6":              {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "src"}, {Type: "const char*", Name: "dst"}, {Type: "trace.PacketMetadata", Name: "metadata"}, {Type: "trace.ProtoICMPv6", Name: "proto_icmpv6"}}},
+	"net_packet_icmpv6_base":         {Syscall: false, Params: []trace.ArgMeta{{Type: "bytes", Name: "payload"}}},
+	"net_packet_ip_base":             {Syscall: false, Params: []trace.ArgMeta{{Type: "bytes", Name: "payload"}}},
+	"net_packet_ipv4":                {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "src"}, {Type: "const char*", Name: "dst"}, {Type: "trace.PacketMetadata", Name: "metadata"}, {Type: "trace.ProtoIPv4", Name: "proto_ipv4"}}},
+	"net_packet_ipv6":                {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "src"}, {Type: "const char*", Name: "dst"}, {Type: "trace.PacketMetadata", Name: "metadata"}, {Type: "trace.ProtoIPv6", Name: "proto_ipv6"}}},
+	"net_packet_raw":                 {Syscall: false, Params: []trace.ArgMeta{{Type: "bytes", Name: "data"}}},
+	"net_packet_tcp":                 {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "src"}, {Type: "const char*", Name: "dst"}, {Type: "u16", Name: "src_port"}, {Type: "u16", Name: "dst_port"}, {Type: "trace.PacketMetadata", Name: "metadata"}, {Type: "trace.ProtoTCP", Name: "proto_tcp"}}},
+	"net_packet_tcp_base":            {Syscall: false, Params: []trace.ArgMeta{{Type: "bytes", Name: "payload"}}},
+	"net_packet_udp":                 {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "src"}, {Type: "const char*", Name: "dst"}, {Type: "u16", Name: "src_port"}, {Type: "u16", Name: "dst_port"}, {Type: "trace.PacketMetadata", Name: "metadata"}, {Type: "trace.ProtoUDP", Name: "proto_udp"}}},
+	"net_packet_udp_base":            {Syscall: false, Params: []trace.ArgMeta{{Type: "bytes", Name: "payload"}}},
+	"net_tcp_connect":                {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "dst"}, {Type: "int", Name: "dst_port"}, {Type: "const char **", Name: "dst_dns"}}},
+	"newfstatat":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "dirfd"}, {Type: "const char*", Name: "pathname"}, {Type: "struct stat*", Name: "statbuf"}, {Type: "int", Name: "flags"}}},
+	"nfsservctl":                     {Syscall: true, Params: []trace.ArgMeta{}},
+	"nice":                           {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "inc"}}},
+	"old_getrlimit":                  {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "resource"}, {Type: "struct rlimit*", Name: "rlim"}}},
+	"old_select":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "nfds"}, {Type: "fd_set*", Name: "readfds"}, {Type: "fd_set*", Name: "writefds"}, {Type: "fd_set*", Name: "exceptfds"}, {Type: "struct timeval*", Name: "timeout"}}},
+	"oldfstat":                       {Syscall: true, Params: []trace.ArgMeta{}},
+	"oldlstat":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "struct stat*", Name: "statbuf"}}},
+	"oldolduname":                    {Syscall: true, Params: []trace.ArgMeta{{Type: "struct oldold_utsname*", Name: "name"}}},
+	"oldstat":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "char*", Name: "filename"}, {Type: "struct __old_kernel_stat*", Name: "statbuf"}}},
+	"olduname":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "struct utsname*", Name: "buf"}}},
+	"open":                           {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "int", Name: "flags"}, {Type: "mode_t", Name: "mode"}}},
+	"open_by_handle_at":              {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "mount_fd"}, {Type: "struct file_handle*", Name: "handle"}, {Type: "int", Name: "flags"}}},
+	"open_tree":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "dfd"}, {Type: "const char*", Name: "filename"}, {Type: "unsigned int", Name: "flags"}}},
+	"openat":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "dirfd"}, {Type: "const char*", Name: "pathname"}, {Type: "int", Name: "flags"}, {Type: "mode_t", Name: "mode"}}},
+	"openat2":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "dirfd"}, {Type: "const char*", Name: "pathname"}, {Type: "struct open_how*", Name: "how"}, {Type: "size_t", Name: "size"}}},
+	"pause":                          {Syscall: true, Params: []trace.ArgMeta{}},
+	"perf_event_open":                {Syscall: true, Params: []trace.ArgMeta{{Type: "struct perf_event_attr*", Name: "attr"}, {Type: "pid_t", Name: "pid"}, {Type: "int", Name: "cpu"}, {Type: "int", Name: "group_fd"}, {Type: "unsigned long", Name: "flags"}}},
+	"personality":                    {Syscall: true, Params: []trace.ArgMeta{{Type: "unsigned long", Name: "persona"}}},
+	"pidfd_getfd":                    {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "pidfd"}, {Type: "int", Name: "targetfd"}, {Type: "unsigned int", Name: "flags"}}},
+	"pidfd_open":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "pid_t", Name: "pid"}, {Type: "unsigned int", Name: "flags"}}},
+	"pidfd_send_signal":              {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "pidfd"}, {Type: "int", Name: "sig"}, {Type: "siginfo_t*", Name: "info"}, {Type: "unsigned int", Name: "flags"}}},
+	"pipe":                           {Syscall: true, Params: []trace.ArgMeta{{Type: "int[2]", Name: "pipefd"}}},
+	"pipe2":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "int[2]", Name: "pipefd"}, {Type: "int", Name: "flags"}}},
+	"pivot_root":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "new_root"}, {Type: "const char*", Name: "put_old"}}},
+	"pkey_alloc":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "unsigned int", Name: "flags"}, {Type: "unsigned long", Name: "access_rights"}}},
+	"pkey_free":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "pkey"}}},
+	"pkey_mprotect":                  {Syscall: true, Params: []trace.ArgMeta{{Type: "void*", Name: "addr"}, {Type: "size_t", Name: "len"}, {Type: "int", Name: "prot"}, {Type: "int", Name: "pkey"}}},
+	"poll":                           {Syscall: true, Params: []trace.ArgMeta{{Type: "struct pollfd*", Name: "fds"}, {Type: "unsigned int", Name: "nfds"}, {Type: "int", Name: "timeout"}}},
+	"ppoll":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "struct pollfd*", Name: "fds"}, {Type: "unsigned int", Name: "nfds"}, {Type: "struct timespec*", Name: "tmo_p"}, {Type: "const sigset_t*", Name: "sigmask"}, {Type: "size_t", Name: "sigsetsize"}}},
+	"ppoll_time32":                   {Syscall: true, Params: []trace.ArgMeta{{Type: "struct pollfd*", Name: "ufds"}, {Type: "unsigned int", Name: "nfds"}, {Type: "struct old_timespec32*", Name: "tsp"}, {Type: "sigset_t*", Name: "sigmask"}, {Type: "size_t", Name: "sigsetsize"}}},
+	"prctl":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "option"}, {Type: "unsigned long", Name: "arg2"}, {Type: "unsigned long", Name: "arg3"}, {Type: "unsigned long", Name: "arg4"}, {Type: "unsigned long", Name: "arg5"}}},
+	"pread64":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "void*", Name: "buf"}, {Type: "size_t", Name: "count"}, {Type: "off_t", Name: "offset"}}},
+	"preadv":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "const struct iovec*", Name: "iov"}, {Type: "unsigned long", Name: "iovcnt"}, {Type: "unsigned long", Name: "pos_l"}, {Type: "unsigned long", Name: "pos_h"}}},
+	"preadv2":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "const struct iovec*", Name: "iov"}, {Type: "unsigned long", Name: "iovcnt"}, {Type: "unsigned long", Name: "pos_l"}, {Type: "unsigned long", Name: "pos_h"}, {Type: "int", Name: "flags"}}},
+	"print_mem_dump":                 {Syscall: false, Params: []trace.ArgMeta{{Type: "bytes", Name: "bytes"}, {Type: "void*", Name: "address"}, {Type: "u64", Name: "length"}, {Type: "u64", Name: "caller_context_id"}, {Type: "char*", Name: "arch"}, {Type: "char*", Name: "symbol_name"}, {Type: "char*", Name: "symbol_owner"}}},
+	"print_net_seq_ops":              {Syscall: false, Params: []trace.ArgMeta{{Type: "unsigned long[]", Name: "net_seq_ops"}, {Type: "unsigned long", Name: "caller_context_id"}}},
+	"prlimit64":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "pid_t", Name: "pid"}, {Type: "int", Name: "resource"}, {Type: "const struct rlimit64*", Name: "new_limit"}, {Type: "struct rlimit64*", Name: "old_limit"}}},
+	"proc_create":                    {Syscall: false, Params: []trace.ArgMeta{{Type: "char*", Name: "name"}, {Type: "void*", Name: "proc_ops_addr"}}},
+	"process_execute_failed":         {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "path"}, {Type: "const char*", Name: "binary.path"}, {Type: "dev_t", Name: "binary.device_id"}, {Type: "unsigned long", Name: "binary.inode_number"}, {Type: "unsigned long", Name: "binary.ctime"}, {Type: "umode_t", Name: "binary.inode_mode"}, {Type: "const char*", Name: "interpreter_path"}, {Type: "umode_t", Name: "stdin_type"}, {Type: "char*", Name: "stdin_path"}, {Type: "int", Name: "kernel_invoked"}, {Type: "const char*const*", Name: "binary.arguments"}, {Type: "const char*const*", Name: "environment"}}},
+	"process_madvise":                {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "pidfd"}, {Type: "void*", Name: "addr"}, {Type: "size_t", Name: "length"}, {Type: "int", Name: "advice"}, {Type: "unsigned long", Name: "flags"}}},
+	"process_mrelease":               {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "pidfd"}, {Type: "unsigned int", Name: "flags"}}},
+	"process_vm_readv":               {Syscall: true, Params: []trace.ArgMeta{{Type: "pid_t", Name: "pid"}, {Type: "const struct iovec*", Name: "local_iov"}, {Type: "unsigned long", Name: "liovcnt"}, {Type: "const struct iovec*", Name: "remote_iov"}, {Type: "unsigned long", Name: "riovcnt"}, {Type: "unsigned long", Name: "flags"}}},
+	"process_vm_writev":              {Syscall: true, Params: []trace.ArgMeta{{Type: "pid_t", Name: "pid"}, {Type: "const struct iovec*", Name: "local_iov"}, {Type: "unsigned long", Name: "liovcnt"}, {Type: "const struct iovec*", Name: "remote_iov"}, {Type: "unsigned long", Name: "riovcnt"}, {Type: "unsigned long", Name: "flags"}}},
+	"prof":                           {Syscall: true, Params: []trace.ArgMeta{}},
+	"profil":                         {Syscall: true, Params: []trace.ArgMeta{}},
+	"pselect6":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "nfds"}, {Type: "fd_set*", Name: "readfds"}, {Type: "fd_set*", Name: "writefds"}, {Type: "fd_set*", Name: "exceptfds"}, {Type: "struct timespec*", Name: "timeout"}, {Type: "void*", Name: "sigmask"}}},
+	"pselect6_time32":                {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "n"}, {Type: "fd_set*", Name: "inp"}, {Type: "fd_set*", Name: "outp"}, {Type: "fd_set*", Name: "exp"}, {Type: "struct old_timespec32*", Name: "tsp"}, {Type: "void*", Name: "sig"}}},
+	"ptrace":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "long", Name: "request"}, {Type: "pid_t", Name: "pid"}, {Type: "void*", Name: "addr"}, {Type: "void*", Name: "data"}}},
+	"putpmsg":                        {Syscall: true, Params: []trace.ArgMeta{}},
+	"pwrite64":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "const void*", Name: "buf"}, {Type: "size_t", Name: "count"}, {Type: "off_t", Name: "offset"}}},
+	"pwritev":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "const struct iovec*", Name: "iov"}, {Type: "unsigned long", Name: "iovcnt"}, {Type: "unsigned long", Name: "pos_l"}, {Type: "unsigned long", Name: "pos_h"}}},
+	"pwritev2":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "const struct iovec*", Name: "iov"}, {Type: "unsigned long", Name: "iovcnt"}, {Type: "unsigned long", Name: "pos_l"}, {Type: "unsigned long", Name: "pos_h"}, {Type: "int", Name: "flags"}}},
+	"query_module":                   {Syscall: true, Params: []trace.ArgMeta{}},
+	"quotactl":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "cmd"}, {Type: "const char*", Name: "special"}, {Type: "int", Name: "id"}, {Type: "void*", Name: "addr"}}},
+	"quotactl_fd":                    {Syscall: true, Params: []trace.ArgMeta{{Type: "unsigned int", Name: "fd"}, {Type: "unsigned int", Name: "cmd"}, {Type: "qid_t", Name: "id"}, {Type: "void *", Name: "addr"}}},
+	"read":                           {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "void*", Name: "buf"}, {Type: "size_t", Name: "count"}}},
+	"readahead":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "off_t", Name: "offset"}, {Type: "size_t", Name: "count"}}},
+	"readdir":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "unsigned int", Name: "fd"}, {Type: "struct old_linux_dirent*", Name: "dirp"}, {Type: "unsigned int", Name: "count"}}},
+	"readlink":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "char*", Name: "buf"}, {Type: "size_t", Name: "bufsiz"}}},
+	"readlinkat":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "dirfd"}, {Type: "const char*", Name: "pathname"}, {Type: "char*", Name: "buf"}, {Type: "int", Name: "bufsiz"}}},
+	"readv":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "const struct iovec*", Name: "iov"}, {Type: "int", Name: "iovcnt"}}},
+	"reboot":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "magic"}, {Type: "int", Name: "magic2"}, {Type: "int", Name: "cmd"}, {Type: "void*", Name: "arg"}}},
+	"recvfrom":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "sockfd"}, {Type: "void*", Name: "buf"}, {Type: "size_t", Name: "len"}, {Type: "int", Name: "flags"}, {Type: "struct sockaddr*", Name: "src_addr"}, {Type: "int*", Name: "addrlen"}}},
+	"recvmmsg":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "sockfd"}, {Type: "struct mmsghdr*", Name: "msgvec"}, {Type: "unsigned int", Name: "vlen"}, {Type: "int", Name: "flags"}, {Type: "struct timespec*", Name: "timeout"}}},
+	"recvmmsg_time32":                {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "struct mmsghdr*", Name: "mmsg"}, {Type: "unsigned int", Name: "vlen"}, {Type: "unsigned int", Name: "flags"}, {Type: "struct old_timespec32*", Name: "timeout"}}},
+	"recvmsg":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "sockfd"}, {Type: "struct msghdr*", Name: "msg"}, {Type: "int", Name: "flags"}}},
+	"register_chrdev":                {Syscall: false, Params: []trace.ArgMeta{{Type: "unsigned int", Name: "requested_major_number"}, {Type: "unsigned int", Name: "granted_major_number"}, {Type: "const char*", Name: "char_device_name"}, {Type: "struct file_operations *", Name: "char_device_fops"}}},
+	"remap_file_pages":               {Syscall: true, Params: []trace.ArgMeta{{Type: "void*", Name: "addr"}, {Type: "size_t", Name: "size"}, {Type: "int", Name: "prot"}, {Type: "size_t", Name: "pgoff"}, {Type: "int", Name: "flags"}}},
+	"removexattr":                    {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "path"}, {Type: "const char*", Name: "name"}}},
+	"rename":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "oldpath"}, {Type: "const char*", Name: "newpath"}}},
+	"renameat":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "olddirfd"}, {Type: "const char*", Name: "oldpath"}, {Type: "int", Name: "newdirfd"}, {Type: "const char*", Name: "newpath"}}},
+	"renameat2":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "olddirfd"}, {Type: "const char*", Name: "oldpath"}, {Type: "int", Name: "newdirfd"}, {Type: "const char*", Name: "newpath"}, {Type: "unsigned int", Name: "flags"}}},
+	"request_key":                    {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "type"}, {Type: "const char*", Name: "description"}, {Type: "const char*", Name: "callout_info"}, {Type: "key_serial_t", Name: "dest_keyring"}}},
+	"restart_syscall":                {Syscall: true, Params: []trace.ArgMeta{}},
+	"rmdir":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}}},
+	"rseq":                           {Syscall: true, Params: []trace.ArgMeta{{Type: "struct rseq*", Name: "rseq"}, {Type: "u32", Name: "rseq_len"}, {Type: "int", Name: "flags"}, {Type: "u32", Name: "sig"}}},
+	"rt_sigaction":                   {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "signum"}, {Type: "const struct sigaction*", Name: "act"}, {Type: "struct sigaction*", Name: "oldact"}, {Type: "size_t", Name: "sigsetsize"}}},
+	"rt_sigpending":                  {Syscall: true, Params: []trace.ArgMeta{{Type: "sigset_t*", Name: "set"}, {Type: "size_t", Name: "sigsetsize"}}},
+	"rt_sigprocmask":                 {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "how"}, {Type: "sigset_t*", Name: "set"}, {Type: "sigset_t*", Name: "oldset"}, {Type: "size_t", Name: "sigsetsize"}}},
+	"rt_sigqueueinfo":                {Syscall: true, Params: []trace.ArgMeta{{Type: "pid_t", Name: "tgid"}, {Type: "int", Name: "sig"}, {Type: "siginfo_t*", Name: "info"}}},
+	"rt_sigreturn":                   {Syscall: true, Params: []trace.ArgMeta{}},
+	"rt_sigsuspend":                  {Syscall: true, Params: []trace.ArgMeta{{Type: "sigset_t*", Name: "mask"}, {Type: "size_t", Name: "sigsetsize"}}},
+	"rt_sigtimedwait":                {Syscall: true, Params: []trace.ArgMeta{{Type: "const sigset_t*", Name: "set"}, {Type: "siginfo_t*", Name: "info"}, {Type: "const struct timespec*", Name: "timeout"}, {Type: "size_t", Name: "sigsetsize"}}},
+	"rt_sigtimedwait_time32":         {Syscall: true, Params: []trace.ArgMeta{{Type: "sigset_t*", Name: "uthese"}, {Type: "siginfo_t*", Name: "uinfo"}, {Type: "struct old_timespec32*", Name: "uts"}, {Type: "size_t", Name: "sigsetsize"}}},
+	"rt_tgsigqueueinfo":              {Syscall: true, Params: []trace.ArgMeta{{Type: "pid_t", Name: "tgid"}, {Type: "pid_t", Name: "tid"}, {Type: "int", Name: "sig"}, {Type: "siginfo_t*", Name: "info"}}},
+	"sched_get_priority_max":         {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "policy"}}},
+	"sched_get_priority_min":         {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "policy"}}},
+	"sched_getaffinity":              {Syscall: true, Params: []trace.ArgMeta{{Type: "pid_t", Name: "pid"}, {Type: "size_t", Name: "cpusetsize"}, {Type: "unsigned long*", Name: "mask"}}},
+	"sched_getattr":                  {Syscall: true, Params: []trace.ArgMeta{{Type: "pid_t", Name: "pid"}, {Type: "struct sched_attr*", Name: "attr"}, {Type: "unsigned int", Name: "size"}, {Type: "unsigned int", Name: "flags"}}},
+	"sched_getparam":                 {Syscall: true, Params: []trace.ArgMeta{{Type: "pid_t", Name: "pid"}, {Type: "struct sched_param*", Name: "param"}}},
+	"sched_getscheduler":             {Syscall: true, Params: []trace.ArgMeta{{Type: "pid_t", Name: "pid"}}},
+	"sched_process_exec":             {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "cmdpath"}, {Type: "const char*", Name: "pathname"}, {Type: "dev_t", Name: "dev"}, {Type: "unsigned long", Name: "inode"}, {Type: "unsigned long", Name: "ctime"}, {Type: "umode_t", Name: "inode_mode"}, {Type: "const char*", Name: "interpreter_pathname"}, {Type: "dev_t", Name: "interpreter_dev"}, {Type: "unsigned long", Name: "interpreter_inode"}, {Type: "unsigned long", Name: "interpreter_ctime"}, {Type: "const char**", Name: "argv"}, {Type: "const char*", Name: "interp"}, {Type: "umode_t", Name: "stdin_type"}, {Type: "char*", Name: "stdin_path"}, {Type: "int", Name: "invoked_from_kernel"}, {Type: "const char**", Name: "env"}}},
+	"sched_process_exit":             {Syscall: false, Params: []trace.ArgMeta{{Type: "long", Name: "exit_code"}, {Type: "bool", Name: "process_group_exit"}}},
+	"sched_process_fork":             {Syscall: false, Params: []trace.ArgMeta{{Type: "int", Name: "parent_tid"}, {Type: "int", Name: "parent_ns_tid"}, {Type: "int", Name: "parent_pid"}, {Type: "int", Name: "parent_ns_pid"}, {Type: "unsigned long", Name: "parent_start_time"}, {Type: "int", Name: "child_tid"}, {Type: "int", Name: "child_ns_tid"}, {Type: "int", Name: "child_pid"}, {Type: "int", Name: "child_ns_pid"}, {Type: "unsigned long", Name: "start_time"}, {Type: "int", Name: "up_parent_tid"}, {Type: "int", Name: "up_parent_ns_tid"}, {Type: "int", Name: "up_parent_pid"}, {Type: "int", Name: "up_parent_ns_pid"}, {Type: "unsigned long", Name: "up_parent_start_time"}, {Type: "int", Name: "leader_tid"}, {Type: "int", Name: "leader_ns_tid"}, {Type: "int", Name: "leader_pid"}, {Type: "int", Name: "leader_ns_pid"}, {Type: "unsigned long", Name: "leader_start_time"}}},
+	"sched_rr_get_interval":          {Syscall: true, Params: []trace.ArgMeta{{Type: "pid_t", Name: "pid"}, {Type: "struct timespec*", Name: "tp"}}},
+	"sched_rr_get_interval_time32":   {Syscall: true, Params: []trace.ArgMeta{{Type: "pid_t", Name: "pid"}, {Type: "struct old_timespec32*", Name: "interval"}}},
+	"sched_setaffinity":              {Syscall: true, Params: []trace.ArgMeta{{Type: "pid_t", Name: "pid"}, {Type: "size_t", Name: "cpusetsize"}, {Type: "unsigned long*", Name: "mask"}}},
+	"sched_setattr":                  {Syscall: true, Params: []trace.ArgMeta{{Type: "pid_t", Name: "pid"}, {Type: "struct sched_attr*", Name: "attr"}, {Type: "unsigned int", Name: "flags"}}},
+	"sched_setparam":                 {Syscall: true, Params: []trace.ArgMeta{{Type: "pid_t", Name: "pid"}, {Type: "struct sched_param*", Name: "param"}}},
+	"sched_setscheduler":             {Syscall: true, Params: []trace.ArgMeta{{Type: "pid_t", Name: "pid"}, {Type: "int", Name: "policy"}, {Type: "struct sched_param*", Name: "param"}}},
+	"sched_switch":                   {Syscall: false, Params: []trace.ArgMeta{{Type: "int", Name: "cpu"}, {Type: "int", Name: "prev_tid"}, {Type: "const char*", Name: "prev_comm"}, {Type: "int", Name: "next_tid"}, {Type: "const char*", Name: "next_comm"}}},
+	"sched_yield":                    {Syscall: true, Params: []trace.ArgMeta{}},
+	"seccomp":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "unsigned int", Name: "operation"}, {Type: "unsigned int", Name: "flags"}, {Type: "const void*", Name: "args"}}},
+	"security":                       {Syscall: true, Params: []trace.ArgMeta{}},
+	"security_bpf":                   {Syscall: false, Params: []trace.ArgMeta{{Type: "int", Name: "cmd"}}},
+	"security_bpf_map":               {Syscall: false, Params: []trace.ArgMeta{{Type: "unsigned int", Name: "map_id"}, {Type: "const char*", Name: "map_name"}}},
+	"security_bpf_prog":              {Syscall: false, Params: []trace.ArgMeta{{Type: "int", Name: "type"}, {Type: "const char*", Name: "name"}, {Type: "unsigned long[]", Name: "helpers"}, {Type: "u32", Name: "id"}, {Type: "bool", Name: "load"}}},
+	"security_bprm_check":            {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "dev_t", Name: "dev"}, {Type: "unsigned long", Name: "inode"}, {Type: "const char*const*", Name: "argv"}, {Type: "const char*const*", Name: "envp"}}},
+	"security_bprm_creds_for_exec":   {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "path"}, {Type: "const char*", Name: "binary.path"}, {Type: "dev_t", Name: "binary.device_id"}, {Type: "unsigned long", Name: "binary.inode_number"}, {Type: "unsigned long", Name: "binary.ctime"}, {Type: "umode_t", Name: "binary.inode_mode"}, {Type: "const char*", Name: "interpreter_path"}, {Type: "umode_t", Name: "stdin_type"}, {Type: "char*", Name: "stdin_path"}, {Type: "int", Name: "kernel_invoked"}, {Type: "const char*const*", Name: "binary.arguments"}, {Type: "const char*const*", Name: "environment"}}},
+	"security_file_mprotect":         {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "int", Name: "prot"}, {Type: "unsigned long", Name: "ctime"}, {Type: "int", Name: "prev_prot"}, {Type: "void*", Name: "addr"}, {Type: "size_t", Name: "len"}, {Type: "int", Name: "pkey"}}},
+	"security_file_open":             {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "int", Name: "flags"}, {Type: "dev_t", Name: "dev"}, {Type: "unsigned long", Name: "inode"}, {Type: "unsigned long", Name: "ctime"}, {Type: "const char*", Name: "syscall_pathname"}}},
+	"security_inode_mknod":           {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "file_name"}, {Type: "umode_t", Name: "mode"}, {Type: "dev_t", Name: "dev"}}},
+	"security_inode_rename":          {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "old_path"}, {Type: "const char*", Name: "new_path"}}},
+	"security_inode_symlink":         {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "linkpath"}, {Type: "const char*", Name: "target"}}},
+	"security_inode_unlink":          {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "unsigned long", Name: "inode"}, {Type: "dev_t", Name: "dev"}, {Type: "u64", Name: "ctime"}}},
+	"security_kernel_post_read_file": {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "long", Name: "size"}, {Type: "int", Name: "type"}}},
+	"security_kernel_read_file":      {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "dev_t", Name: "dev"}, {Type: "unsigned long", Name: "inode"}, {Type: "int", Name: "type"}, {Type: "unsigned long", Name: "ctime"}}},
+	"security_mmap_file":             {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "int", Name: "flags"}, {Type: "dev_t", Name: "dev"}, {Type: "unsigned long", Name: "inode"}, {Type: "unsigned long", Name: "ctime"}, {Type: "unsigned long", Name: "prot"}, {Type: "unsigned long", Name: "mmap_flags"}}},
+	"security_path_notify":           {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "unsigned long", Name: "inode"}, {Type: "dev_t", Name: "dev"}, {Type: "u64", Name: "mask"}, {Type: "unsigned int", Name: "obj_type"}}},
+	"security_sb_mount":              {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "dev_name"}, {Type: "const char*", Name: "path"}, {Type: "const char*", Name: "type"}, {Type: "unsigned long", Name: "flags"}}},
+	"security_socket_accept":         {Syscall: false, Params: []trace.ArgMeta{{Type: "int", Name: "sockfd"}, {Type: "struct sockaddr*", Name: "local_addr"}}},
+	"security_socket_bind":           {Syscall: false, Params: []trace.ArgMeta{{Type: "int", Name: "sockfd"}, {Type: "struct sockaddr*", Name: "local_addr"}}},
+	"security_socket_connect":        {Syscall: false, Params: []trace.ArgMeta{{Type: "int", Name: "sockfd"}, {Type: "int", Name: "type"}, {Type: "struct sockaddr*", Name: "remote_addr"}}},
+	"security_socket_create":         {Syscall: false, Params: []trace.ArgMeta{{Type: "int", Name: "family"}, {Type: "int", Name: "type"}, {Type: "int", Name: "protocol"}, {Type: "int", Name: "kern"}}},
+	"security_socket_listen":         {Syscall: false, Params: []trace.ArgMeta{{Type: "int", Name: "sockfd"}, {Type: "struct sockaddr*", Name: "local_addr"}, {Type: "int", Name: "backlog"}}},
+	"security_socket_setsockopt":     {Syscall: false, Params: []trace.ArgMeta{{Type: "int", Name: "sockfd"}, {Type: "int", Name: "level"}, {Type: "int", Name: "optname"}, {Type: "struct sockaddr*", Name: "local_addr"}}},
+	"security_task_setrlimit":        {Syscall: false, Params: []trace.ArgMeta{{Type: "u32", Name: "target_host_pid"}, {Type: "int", Name: "resource"}, {Type: "u64", Name: "new_rlim_cur"}, {Type: "u64", Name: "new_rlim_max"}}},
+	"select":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "nfds"}, {Type: "fd_set*", Name: "readfds"}, {Type: "fd_set*", Name: "writefds"}, {Type: "fd_set*", Name: "exceptfds"}, {Type: "struct timeval*", Name: "timeout"}}},
+	"semctl":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "semid"}, {Type: "int", Name: "semnum"}, {Type: "int", Name: "cmd"}, {Type: "unsigned long", Name: "arg"}}},
+	"semget":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "key_t", Name: "key"}, {Type: "int", Name: "nsems"}, {Type: "int", Name: "semflg"}}},
+	"semop":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "semid"}, {Type: "struct sembuf*", Name: "sops"}, {Type: "size_t", Name: "nsops"}}},
+	"semtimedop":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "semid"}, {Type: "struct sembuf*", Name: "sops"}, {Type: "size_t", Name: "nsops"}, {Type: "const struct timespec*", Name: "timeout"}}},
+	"sendfile":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "out_fd"}, {Type: "int", Name: "in_fd"}, {Type: "off_t*", Name: "offset"}, {Type: "size_t", Name: "count"}}},
+	"sendfile32":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "out_fd"}, {Type: "int", Name: "in_fd"}, {Type: "off_t*", Name: "offset"}, {Type: "size_t", Name: "count"}}},
+	"sendmmsg":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "sockfd"}, {Type: "struct mmsghdr*", Name: "msgvec"}, {Type: "unsigned int", Name: "vlen"}, {Type: "int", Name: "flags"}}},
+	"sendmsg":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "sockfd"}, {Type: "struct msghdr*", Name: "msg"}, {Type: "int", Name: "flags"}}},
+	"sendto":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "sockfd"}, {Type: "void*", Name: "buf"}, {Type: "size_t", Name: "len"}, {Type: "int", Name: "flags"}, {Type: "struct sockaddr*", Name: "dest_addr"}, {Type: "int", Name: "addrlen"}}},
+	"set_fs_pwd":                     {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "unresolved_path"}, {Type: "const char*", Name: "resolved_path"}}},
+	"set_mempolicy":                  {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "mode"}, {Type: "const unsigned long*", Name: "nodemask"}, {Type: "unsigned long", Name: "maxnode"}}},
+	"set_robust_list":                {Syscall: true, Params: []trace.ArgMeta{{Type: "struct robust_list_head*", Name: "head"}, {Type: "size_t", Name: "len"}}},
+	"set_thread_area":                {Syscall: true, Params: []trace.ArgMeta{{Type: "struct user_desc*", Name: "u_info"}}},
+	"set_tid_address":                {Syscall: true, Params: []trace.ArgMeta{{Type: "int*", Name: "tidptr"}}},
+	"setdomainname":                  {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "name"}, {Type: "size_t", Name: "len"}}},
+	"setfsgid":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "gid_t", Name: "fsgid"}}},
+	"setfsgid16":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "old_gid_t", Name: "fsgid"}}},
+	"setfsuid":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "uid_t", Name: "fsuid"}}},
+	"setfsuid16":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "old_uid_t", Name: "fsuid"}}},
+	"setgid":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "gid_t", Name: "gid"}}},
+	"setgid16":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "old_gid_t", Name: "gid"}}},
+	"setgroups":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "size"}, {Type: "gid_t*", Name: "list"}}},
+	"setgroups16":                    {Syscall: true, Params: []trace.ArgMeta{{Type: "size_t", Name: "size"}, {Type: "const gid_t*", Name: "list"}}},
+	"sethostname":                    {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "name"}, {Type: "size_t", Name: "len"}}},
+	"setitimer":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "which"}, {Type: "struct itimerval*", Name: "new_value"}, {Type: "struct itimerval*", Name: "old_value"}}},
+	"setns":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "int", Name: "nstype"}}},
+	"setpgid":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "pid_t", Name: "pid"}, {Type: "pid_t", Name: "pgid"}}},
+	"setpriority":                    {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "which"}, {Type: "int", Name: "who"}, {Type: "int", Name: "prio"}}},
+	"setregid":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "gid_t", Name: "rgid"}, {Type: "gid_t", Name: "egid"}}},
+	"setregid16":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "old_gid_t", Name: "rgid"}, {Type: "old_gid_t", Name: "egid"}}},
+	"setresgid":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "gid_t", Name: "rgid"}, {Type: "gid_t", Name: "egid"}, {Type: "gid_t", Name: "sgid"}}},
+	"setresgid16":                    {Syscall: true, Params: []trace.ArgMeta{{Type: "old_uid_t", Name: "rgid"}, {Type: "old_uid_t", Name: "euid"}, {Type: "old_uid_t", Name: "suid"}}},
+	"setresuid":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "uid_t", Name: "ruid"}, {Type: "uid_t", Name: "euid"}, {Type: "uid_t", Name: "suid"}}},
+	"setresuid16":                    {Syscall: true, Params: []trace.ArgMeta{{Type: "old_uid_t", Name: "ruid"}, {Type: "old_uid_t", Name: "euid"}, {Type: "old_uid_t", Name: "suid"}}},
+	"setreuid":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "uid_t", Name: "ruid"}, {Type: "uid_t", Name: "euid"}}},
+	"setreuid16":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "old_uid_t", Name: "ruid"}, {Type: "old_uid_t", Name: "euid"}}},
+	"setrlimit":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "resource"}, {Type: "const struct rlimit*", Name: "rlim"}}},
+	"setsid":                         {Syscall: true, Params: []trace.ArgMeta{}},
+	"setsockopt":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "sockfd"}, {Type: "int", Name: "level"}, {Type: "int", Name: "optname"}, {Type: "const void*", Name: "optval"}, {Type: "int", Name: "optlen"}}},
+	"settimeofday":                   {Syscall: true, Params: []trace.ArgMeta{{Type: "const struct timeval*", Name: "tv"}, {Type: "const struct timezone*", Name: "tz"}}},
+	"setuid":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "uid_t", Name: "uid"}}},
+	"setuid16":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "old_old_uid_t", Name: "uid"}}},
+	"setxattr":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "path"}, {Type: "const char*", Name: "name"}, {Type: "const void*", Name: "value"}, {Type: "size_t", Name: "size"}, {Type: "int", Name: "flags"}}},
+	"sgetmask":                       {Syscall: true, Params: []trace.ArgMeta{}},
+	"shared_object_loaded":           {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "int", Name: "flags"}, {Type: "dev_t", Name: "dev"}, {Type: "unsigned long", Name: "inode"}, {Type: "unsigned long", Name: "ctime"}}},
+	"shmat":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "shmid"}, {Type: "const void*", Name: "shmaddr"}, {Type: "int", Name: "shmflg"}}},
+	"shmctl":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "shmid"}, {Type: "int", Name: "cmd"}, {Type: "struct shmid_ds*", Name: "buf"}}},
+	"shmdt":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "const void*", Name: "shmaddr"}}},
+	"shmget":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "key_t", Name: "key"}, {Type: "size_t", Name: "size"}, {Type: "int", Name: "shmflg"}}},
+	"shutdown":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "sockfd"}, {Type: "int", Name: "how"}}},
+	"sigaction":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "sig"}, {Type: "const struct sigaction*", Name: "act"}, {Type: "struct sigaction*", Name: "oact"}}},
+	"sigaltstack":                    {Syscall: true, Params: []trace.ArgMeta{{Type: "const stack_t*", Name: "ss"}, {Type: "stack_t*", Name: "old_ss"}}},
+	"signal":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "signum"}, {Type: "sighandler_t", Name: "handler"}}},
+	"signal_cgroup_mkdir":            {Syscall: false, Params: []trace.ArgMeta{{Type: "u64", Name: "cgroup_id"}, {Type: "const char*", Name: "cgroup_path"}, {Type: "u32", Name: "hierarchy_id"}}},
+	"signal_cgroup_rmdir":            {Syscall: false, Params: []trace.ArgMeta{{Type: "u64", Name: "cgroup_id"}, {Type: "const char*", Name: "cgroup_path"}, {Type: "u32", Name: "hierarchy_id"}}},
+	"signal_sched_process_exec":      {Syscall: false, Params: []trace.ArgMeta{{Type: "u64", Name: "timestamp"}, {Type: "u32", Name: "task_hash"}, {Type: "u32", Name: "parent_hash"}, {Type: "u32", Name: "leader_hash"}, {Type: "const char*", Name: "cmdpath"}, {Type: "const char*", Name: "pathname"}, {Type: "dev_t", Name: "dev"}, {Type: "unsigned long", Name: "inode"}, {Type: "unsigned long", Name: "ctime"}, {Type: "umode_t", Name: "inode_mode"}, {Type: "const char*", Name: "interpreter_pathname"}, {Type: "dev_t", Name: "interpreter_dev"}, {Type: "unsigned long", Name: "interpreter_inode"}, {Type: "unsigned long", Name: "interpreter_ctime"}, {Type: "const char**", Name: "argv"}, {Type: "const char*", Name: "interp"}, {Type: "umode_t", Name: "stdin_type"}, {Type: "char*", Name: "stdin_path"}, {Type: "int", Name: "invoked_from_kernel"}}},
+	"signal_sched_process_exit":      {Syscall: false, Params: []trace.ArgMeta{{Type: "u64", Name: "timestamp"}, {Type: "u32", Name: "task_hash"}, {Type: "u32", Name: "parent_hash"}, {Type: "u32", Name: "leader_hash"}, {Type: "long", Name: "exit_code"}, {Type: "bool", Name: "process_group_exit"}}},
+	"signal_sched_process_fork":      {Syscall: false, Params: []trace.ArgMeta{{Type: "u64", Name: "timestamp"}, {Type: "int", Name: "parent_tid"}, {Type: "int", Name: "parent_ns_tid"}, {Type: "int", Name: "parent_pid"}, {Type: "int", Name: "parent_ns_pid"}, {Type: "unsigned long", Name: "parent_start_time"}, {Type: "int", Name: "child_tid"}, {Type: "int", Name: "child_ns_tid"}, {Type: "int", Name: "child_pid"}, {Type: "int", Name: "child_ns_pid"}, {Type: "unsigned long", Name: "start_time"}, {Type: "int", Name: "up_parent_tid"}, {Type: "int", Name: "up_parent_ns_tid"}, {Type: "int", Name: "up_parent_pid"}, {Type: "int", Name: "up_parent_ns_pid"}, {Type: "unsigned long", Name: "up_parent_start_time"}, {Type: "int", Name: "leader_tid"}, {Type: "int", Name: "leader_ns_tid"}, {Type: "int", Name: "leader_pid"}, {Type: "int", Name: "leader_ns_pid"}, {Type: "unsigned long", Name: "leader_start_time"}}},
+	"signalfd":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "sigset_t*", Name: "mask"}, {Type: "int", Name: "flags"}}},
+	"signalfd4":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "const sigset_t*", Name: "mask"}, {Type: "size_t", Name: "sizemask"}, {Type: "int", Name: "flags"}}},
+	"sigpending":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "sigset_t*", Name: "set"}}},
+	"sigprocmask":                    {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "how"}, {Type: "const sigset_t *restrict", Name: "set"}, {Type: "sigset_t *restrict", Name: "oldset"}}},
+	"sigreturn":                      {Syscall: true, Params: []trace.ArgMeta{}},
+	"sigsuspend":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "const sigset_t*", Name: "mask"}}},
+	"socket":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "domain"}, {Type: "int", Name: "type"}, {Type: "int", Name: "protocol"}}},
+	"socket_accept":                  {Syscall: false, Params: []trace.ArgMeta{{Type: "int", Name: "sockfd"}, {Type: "struct sockaddr*", Name: "local_addr"}, {Type: "struct sockaddr*", Name: "remote_addr"}}},
+	"socket_dup":                     {Syscall: false, Params: []trace.ArgMeta{{Type: "int", Name: "oldfd"}, {Type: "int", Name: "newfd"}, {Type: "struct sockaddr*", Name: "remote_addr"}}},
+	"socketcall":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "call"}, {Type: "unsigned long*", Name: "args"}}},
+	"socketpair":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "domain"}, {Type: "int", Name: "type"}, {Type: "int", Name: "protocol"}, {Type: "int[2]", Name: "sv"}}},
+	"splice":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd_in"}, {Type: "off_t*", Name: "off_in"}, {Type: "int", Name: "fd_out"}, {Type: "off_t*", Name: "off_out"}, {Type: "size_t", Name: "len"}, {Type: "unsigned int", Name: "flags"}}},
+	"ssetmask":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "long", Name: "newmask"}}},
+	"stat":                           {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "struct stat*", Name: "statbuf"}}},
+	"stat64":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "struct stat64*", Name: "statbuf"}}},
+	"statfs":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "path"}, {Type: "struct statfs*", Name: "buf"}}},
+	"statfs64":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "path"}, {Type: "size_t", Name: "sz"}, {Type: "struct statfs64*", Name: "buf"}}},
+	"statx":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "dirfd"}, {Type: "const char*", Name: "pathname"}, {Type: "int", Name: "flags"}, {Type: "unsigned int", Name: "mask"}, {Type: "struct statx*", Name: "statxbuf"}}},
+	"stime":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "const time_t*", Name: "t"}}},
+	"stty":                           {Syscall: true, Params: []trace.ArgMeta{}},
+	"suppressed_events":              {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "event"}, {Type: "const char*", Name: "reason"}, {Type: "unsigned long", Name: "count"}}},
+	"swapoff":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "path"}}},
+	"swapon":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "path"}, {Type: "int", Name: "swapflags"}}},
+	"switch_task_ns":                 {Syscall: false, Params: []trace.ArgMeta{{Type: "pid_t", Name: "pid"}, {Type: "u32", Name: "new_mnt"}, {Type: "u32", Name: "new_pid"}, {Type: "u32", Name: "new_uts"}, {Type: "u32", Name: "new_ipc"}, {Type: "u32", Name: "new_net"}, {Type: "u32", Name: "new_cgroup"}}},
+	"symbols_collision":              {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "loaded_path"}, {Type: "const char*", Name: "collision_path"}, {Type: "const char*const*", Name: "symbols"}}},
+	"symbols_loaded":                 {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "library_path"}, {Type: "const char*const*", Name: "symbols"}, {Type: "const char *", Name: "sha256"}}},
+	"symlink":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "target"}, {Type: "const char*", Name: "linkpath"}}},
+	"symlinkat":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "target"}, {Type: "int", Name: "newdirfd"}, {Type: "const char*", Name: "linkpath"}}},
+	"sync":                           {Syscall: true, Params: []trace.ArgMeta{}},
+	"sync_file_range":                {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "off_t", Name: "offset"}, {Type: "off_t", Name: "nbytes"}, {Type: "unsigned int", Name: "flags"}}},
+	"syncfs":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}}},
+	"sys_enter":                      {Syscall: false, Params: []trace.ArgMeta{{Type: "int", Name: "syscall"}}},
+	"sys_exit":                       {Syscall: false, Params: []trace.ArgMeta{{Type: "int", Name: "syscall"}}},
+	"syscall_table_check":            {Syscall: false, Params: []trace.ArgMeta{{Type: "int", Name: "syscall_id"}, {Type: "unsigned long", Name: "syscall_address"}}},
+	"sysctl":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "struct __sysctl_args*", Name: "args"}}},
+	"sysfs":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "option"}}},
+	"sysinfo":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "struct sysinfo*", Name: "info"}}},
+	"syslog":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "type"}, {Type: "char*", Name: "bufp"}, {Type: "int", Name: "len"}}},
+	"task_rename":                    {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "old_name"}, {Type: "const char*", Name: "new_name"}}},
+	"tee":                            {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd_in"}, {Type: "int", Name: "fd_out"}, {Type: "size_t", Name: "len"}, {Type: "unsigned int", Name: "flags"}}},
+	"tgkill":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "tgid"}, {Type: "int", Name: "tid"}, {Type: "int", Name: "sig"}}},
+	"time":                           {Syscall: true, Params: []trace.ArgMeta{{Type: "time_t*", Name: "tloc"}}},
+	"timer_create":                   {Syscall: true, Params: []trace.ArgMeta{{Type: "const clockid_t", Name: "clockid"}, {Type: "struct sigevent*", Name: "sevp"}, {Type: "timer_t*", Name: "timer_id"}}},
+	"timer_delete":                   {Syscall: true, Params: []trace.ArgMeta{{Type: "timer_t", Name: "timer_id"}}},
+	"timer_getoverrun":               {Syscall: true, Params: []trace.ArgMeta{{Type: "timer_t", Name: "timer_id"}}},
+	"timer_gettime":                  {Syscall: true, Params: []trace.ArgMeta{{Type: "timer_t", Name: "timer_id"}, {Type: "struct itimerspec*", Name: "curr_value"}}},
+	"timer_gettime32":                {Syscall: true, Params: []trace.ArgMeta{{Type: "timer_t", Name: "timer_id"}, {Type: "struct old_itimerspec32*", Name: "setting"}}},
+	"timer_settime":                  {Syscall: true, Params: []trace.ArgMeta{{Type: "timer_t", Name: "timer_id"}, {Type: "int", Name: "flags"}, {Type: "const struct itimerspec*", Name: "new_value"}, {Type: "struct itimerspec*", Name: "old_value"}}},
+	"timer_settime32":                {Syscall: true, Params: []trace.ArgMeta{{Type: "timer_t", Name: "timer_id"}, {Type: "int", Name: "flags"}, {Type: "struct old_itimerspec32*", Name: "new"}, {Type: "struct old_itimerspec32*", Name: "old"}}},
+	"timerfd_create":                 {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "clockid"}, {Type: "int", Name: "flags"}}},
+	"timerfd_gettime":                {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "struct itimerspec*", Name: "curr_value"}}},
+	"timerfd_gettime32":              {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "ufd"}, {Type: "struct old_itimerspec32*", Name: "otmr"}}},
+	"timerfd_settime":                {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "int", Name: "flags"}, {Type: "const struct itimerspec*", Name: "new_value"}, {Type: "struct itimerspec*", Name: "old_value"}}},
+	"timerfd_settime32":              {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "ufd"}, {Type: "int", Name: "flags"}, {Type: "struct old_itimerspec32*", Name: "utmr"}, {Type: "struct old_itimerspec32*", Name: "otmr"}}},
+	"times":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "struct tms*", Name: "buf"}}},
+	"tkill":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "tid"}, {Type: "int", Name: "sig"}}},
+	"truncate":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "path"}, {Type: "off_t", Name: "length"}}},
+	"truncate64":                     {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "path"}, {Type: "off_t", Name: "length"}}},
+	"tuxcall":                        {Syscall: true, Params: []trace.ArgMeta{}},
+	"ulimit":                         {Syscall: true, Params: []trace.ArgMeta{}},
+	"umask":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "mode_t", Name: "mask"}}},
+	"umount":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "target"}}},
+	"umount2":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "target"}, {Type: "int", Name: "flags"}}},
+	"uname":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "struct utsname*", Name: "buf"}}},
+	"unlink":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}}},
+	"unlinkat":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "dirfd"}, {Type: "const char*", Name: "pathname"}, {Type: "int", Name: "flags"}}},
+	"unshare":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "flags"}}},
+	"uselib":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "library"}}},
+	"userfaultfd":                    {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "flags"}}},
+	"ustat":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "dev_t", Name: "dev"}, {Type: "struct ustat*", Name: "ubuf"}}},
+	"utime":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "const char*", Name: "filename"}, {Type: "const struct utimbuf*", Name: "times"}}},
+	"utimensat":                      {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "dirfd"}, {Type: "const char*", Name: "pathname"}, {Type: "struct timespec*", Name: "times"}, {Type: "int", Name: "flags"}}},
+	"utimensat_time32":               {Syscall: true, Params: []trace.ArgMeta{{Type: "unsigned int", Name: "dfd"}, {Type: "char*", Name: "filename"}, {Type: "struct old_timespec32*", Name: "t"}, {Type: "int", Name: "flags"}}},
+	"utimes":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "char*", Name: "filename"}, {Type: "struct timeval*", Name: "times"}}},
+	"vfork":                          {Syscall: true, Params: []trace.ArgMeta{}},
+	"vfs_read":                       {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "dev_t", Name: "dev"}, {Type: "unsigned long", Name: "inode"}, {Type: "size_t", Name: "count"}, {Type: "off_t", Name: "pos"}}},
+	"vfs_readv":                      {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "dev_t", Name: "dev"}, {Type: "unsigned long", Name: "inode"}, {Type: "unsigned long", Name: "vlen"}, {Type: "off_t", Name: "pos"}}},
+	"vfs_utimes":                     {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "dev_t", Name: "dev"}, {Type: "unsigned long", Name: "inode"}, {Type: "u64", Name: "atime"}, {Type: "u64", Name: "mtime"}}},
+	"vfs_write":                      {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "dev_t", Name: "dev"}, {Type: "unsigned long", Name: "inode"}, {Type: "size_t", Name: "count"}, {Type: "off_t", Name: "pos"}}},
+	"vfs_writev":                     {Syscall: false, Params: []trace.ArgMeta{{Type: "const char*", Name: "pathname"}, {Type: "dev_t", Name: "dev"}, {Type: "unsigned long", Name: "inode"}, {Type: "unsigned long", Name: "vlen"}, {Type: "off_t", Name: "pos"}}},
+	"vhangup":                        {Syscall: true, Params: []trace.ArgMeta{}},
+	"vm86":                           {Syscall: true, Params: []trace.ArgMeta{{Type: "unsigned long", Name: "fn"}, {Type: "struct vm86plus_struct*", Name: "v86"}}},
+	"vm86old":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "struct vm86_struct*", Name: "info"}}},
+	"vmsplice":                       {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "const struct iovec*", Name: "iov"}, {Type: "unsigned long", Name: "nr_segs"}, {Type: "unsigned int", Name: "flags"}}},
+	"vserver":                        {Syscall: true, Params: []trace.ArgMeta{}},
+	"wait4":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "pid_t", Name: "pid"}, {Type: "int*", Name: "wstatus"}, {Type: "int", Name: "options"}, {Type: "struct rusage*", Name: "rusage"}}},
+	"waitid":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "idtype"}, {Type: "pid_t", Name: "id"}, {Type: "struct siginfo*", Name: "infop"}, {Type: "int", Name: "options"}, {Type: "struct rusage*", Name: "rusage"}}},
+	"waitpid":                        {Syscall: true, Params: []trace.ArgMeta{{Type: "pid_t", Name: "pid"}, {Type: "int*", Name: "status"}, {Type: "int", Name: "options"}}},
+	"write":                          {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "void*", Name: "buf"}, {Type: "size_t", Name: "count"}}},
+	"writev":                         {Syscall: true, Params: []trace.ArgMeta{{Type: "int", Name: "fd"}, {Type: "const struct iovec*", Name: "iov"}, {Type: "int", Name: "iovcnt"}}},
+}
@@ -0,0 +1,192 @@
+//go:build ignore
+
+// gen writes the definitions of the builtin events to definitions.go, and their IDs on each
+// architecture to ids_<arch>.go. The definitions are taken from the events package, and the
+// IDs, which differ between architectures for the syscall events, from its sources. It
+// needs the build environment of tracker (eBPF), run it with:
+//
+//	make builtin-events
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/khulnasoft-lab/tracker/pkg/events"
+)
+
+const eventsDir = "../"
+
+var archs = []string{"amd64", "arm64"}
+
+func main() {
+	if err := generate(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func generate() error {
+	definitions := events.Core.GetDefinitions()
+	sort.Slice(definitions, func(i, j int) bool {
+		return definitions[i].GetName() < definitions[j].GetName()
+	})
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package builtin\n\n")
+	buf.WriteString("import \"github.com/khulnasoft-lab/tracker/types/trace\"\n\n")
+	buf.WriteString("var definitions = map[string]Event{\n")
+	for _, definition := range definitions {
+		fmt.Fprintf(&buf, "\t%q: {Syscall: %t, Params: []trace.ArgMeta{", definition.GetName(), definition.IsSyscall())
+		for i, param := range definition.GetParams() {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			fmt.Fprintf(&buf, "{Type: %q, Name: %q}", param.Type, param.Name)
+		}
+		buf.WriteString("}},\n")
+	}
+	buf.WriteString("}\n")
+	if err := writeSource("definitions.go", buf.Bytes()); err != nil {
+		return err
+	}
+
+	idNames, err := coreEventIDNames()
+	if err != nil {
+		return err
+	}
+
+	for _, arch := range archs {
+		consts, err := archConsts(arch)
+		if err != nil {
+			return err
+		}
+
+		buf.Reset()
+		buf.WriteString("// Code generated by gen.go; DO NOT EDIT.\n\n")
+		buf.WriteString("package builtin\n\n")
+		buf.WriteString("var ids = map[string]int{\n")
+		for _, definition := range definitions {
+			// the events defined out of the core events have the same ID on all architectures
+			id := int(definition.GetID())
+			if idName, ok := idNames[definition.GetName()]; ok {
+				value, ok := consts[idName]
+				if !ok {
+					return fmt.Errorf("event %s: ID %s is not defined on %s", definition.GetName(), idName, arch)
+				}
+				id = value
+			}
+			fmt.Fprintf(&buf, "\t%q: %d,\n", definition.GetName(), id)
+		}
+		buf.WriteString("}\n")
+		if err := writeSource("ids_"+arch+".go", buf.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// coreEventIDNames returns the names of the ID constants of the core events, by event name,
+// from the CoreEvents map literal
+func coreEventIDNames() (map[string]string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), filepath.Join(eventsDir, "core.go"), nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	idNames := make(map[string]string)
+	ast.Inspect(file, func(n ast.Node) bool {
+		kv, ok := n.(*ast.KeyValueExpr)
+		if !ok {
+			return true
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		definition, ok := kv.Value.(*ast.CompositeLit)
+		if !ok {
+			return true
+		}
+		for _, elt := range definition.Elts {
+			field, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				continue
+			}
+			if fieldName, ok := field.Key.(*ast.Ident); !ok || fieldName.Name != "name" {
+				continue
+			}
+			if lit, ok := field.Value.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+				name, err := strconv.Unquote(lit.Value)
+				if err == nil {
+					idNames[name] = key.Name
+				}
+			}
+		}
+		return true
+	})
+	if len(idNames) == 0 {
+		return nil, errors.New("no core events found")
+	}
+
+	return idNames, nil
+}
+
+// archConsts evaluates the ID constants of the events package on an architecture. Only the
+// files declaring the constants are type checked, the errors of the other declarations are
+// ignored.
+func archConsts(arch string) (map[string]int, error) {
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, name := range []string{"core.go", "core_" + arch + ".go"} {
+		file, err := parser.ParseFile(fset, filepath.Join(eventsDir, name), nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+
+	conf := types.Config{
+		Importer: importer.Default(),
+		Error:    func(error) {},
+	}
+	pkg, _ := conf.Check("events", fset, files, nil)
+
+	consts := make(map[string]int)
+	for _, name := range pkg.Scope().Names() {
+		c, ok := pkg.Scope().Lookup(name).(*types.Const)
+		if !ok || c.Type().String() != "events.ID" {
+			continue
+		}
+		value, ok := constant.Int64Val(c.Val())
+		if !ok {
+			continue
+		}
+		consts[name] = int(value)
+	}
+
+	return consts, nil
+}
+
+func writeSource(path string, src []byte) error {
+	src, err := format.Source(src)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, src, 0644)
+}
@@ -0,0 +1,580 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package builtin
+
+var ids = map[string]int{
+	"__kernel_write":                 751,
+	"accept":                         43,
+	"accept4":                        288,
+	"access":                         21,
+	"acct":                           163,
+	"add_key":                        248,
+	"adjtimex":                       159,
+	"afs":                            183,
+	"afs_syscall":                    481,
+	"alarm":                          37,
+	"arch_prctl":                     158,
+	"bdflush":                        480,
+	"bind":                           49,
+	"bpf":                            321,
+	"bpf_attach":                     770,
+	"break":                          451,
+	"brk":                            12,
+	"call_usermodehelper":            754,
+	"cap_capable":                    719,
+	"capget":                         125,
+	"capset":                         126,
+	"capture_bpf":                    4006,
+	"capture_exec":                   4001,
+	"capture_file_read":              4007,
+	"capture_file_write":             4000,
+	"capture_mem":                    4003,
+	"capture_module":                 4002,
+	"capture_net_packet":             4005,
+	"cgroup_attach_task":             728,
+	"cgroup_mkdir":                   729,
+	"cgroup_rmdir":                   730,
+	"chdir":                          80,
+	"chmod":                          90,
+	"chown":                          92,
+	"chown16":                        506,
+	"chroot":                         161,
+	"clock_adjtime":                  305,
+	"clock_adjtime64":                518,
+	"clock_getres":                   229,
+	"clock_getres_time32":            519,
+	"clock_gettime":                  228,
+	"clock_gettime32":                516,
+	"clock_nanosleep":                230,
+	"clock_nanosleep_time32":         520,
+	"clock_settime":                  227,
+	"clock_settime32":                517,
+	"clone":                          56,
+	"clone3":                         435,
+	"close":                          3,
+	"close_range":                    436,
+	"commit_creds":                   725,
+	"connect":                        42,
+	"container_create":               2018,
+	"container_remove":               2019,
+	"copy_file_range":                326,
+	"creat":                          85,
+	"create_module":                  174,
+	"debugfs_create_dir":             758,
+	"debugfs_create_file":            756,
+	"delete_module":                  176,
+	"device_add":                     759,
+	"dirty_pipe_splice":              755,
+	"do_exit":                        718,
+	"do_init_module":                 762,
+	"do_mmap":                        772,
+	"do_sigaction":                   769,
+	"do_truncate":                    775,
+	"dup":                            32,
+	"dup2":                           33,
+	"dup3":                           292,
+	"epoll_create":                   213,
+	"epoll_create1":                  291,
+	"epoll_ctl":                      233,
+	"epoll_ctl_old":                  214,
+	"epoll_pwait":                    281,
+	"epoll_pwait2":                   441,
+	"epoll_wait":                     232,
+	"epoll_wait_old":                 215,
+	"eventfd":                        284,
+	"eventfd2":                       290,
+	"exec_test":                      8000,
+	"execute_finished":               785,
+	"execve":                         59,
+	"execveat":                       322,
+	"existing_container":             2020,
+	"exit":                           60,
+	"exit_group":                     231,
+	"faccessat":                      269,
+	"faccessat2":                     439,
+	"fadvise64":                      221,
+	"fadvise64_64":                   515,
+	"failed_attach":                  8002,
+	"fallocate":                      285,
+	"fanotify_init":                  300,
+	"fanotify_mark":                  301,
+	"fchdir":                         81,
+	"fchmod":                         91,
+	"fchmodat":                       268,
+	"fchown":                         93,
+	"fchown16":                       501,
+	"fchownat":                       260,
+	"fcntl":                          72,
+	"fcntl64":                        511,
+	"fdatasync":                      75,
+	"fgetxattr":                      193,
+	"file_modification":              776,
+	"finit_module":                   313,
+	"flistxattr":                     196,
+	"flock":                          73,
+	"fork":                           57,
+	"fremovexattr":                   199,
+	"fsconfig":                       431,
+	"fsetxattr":                      190,
+	"fsmount":                        432,
+	"fsopen":                         430,
+	"fspick":                         433,
+	"fstat":                          5,
+	"fstat64":                        491,
+	"fstatfs":                        138,
+	"fstatfs64":                      514,
+	"fsync":                          74,
+	"ftime":                          458,
+	"ftrace_hook":                    2026,
+	"ftruncate":                      77,
+	"ftruncate64":                    488,
+	"futex":                          202,
+	"futex_time32":                   533,
+	"futimesat":                      261,
+	"get_kernel_syms":                177,
+	"get_mempolicy":                  239,
+	"get_robust_list":                274,
+	"get_thread_area":                211,
+	"getcpu":                         309,
+	"getcwd":                         79,
+	"getdents":                       78,
+	"getdents64":                     217,
+	"getegid":                        108,
+	"getegid16":                      496,
+	"geteuid":                        107,
+	"geteuid16":                      495,
+	"getgid":                         104,
+	"getgid16":                       494,
+	"getgroups":                      115,
+	"getgroups16":                    499,
+	"getitimer":                      36,
+	"getpeername":                    52,
+	"getpgid":                        121,
+	"getpgrp":                        111,
+	"getpid":                         39,
+	"getpmsg":                        181,
+	"getppid":                        110,
+	"getpriority":                    140,
+	"getrandom":                      318,
+	"getresgid":                      120,
+	"getresgid16":                    505,
+	"getresuid":                      118,
+	"getresuid16":                    503,
+	"getrlimit":                      97,
+	"getrusage":                      98,
+	"getsid":                         124,
+	"getsockname":                    51,
+	"getsockopt":                     55,
+	"gettid":                         186,
+	"gettimeofday":                   96,
+	"getuid":                         102,
+	"getuid16":                       493,
+	"getxattr":                       191,
+	"gtty":                           456,
+	"hidden_inodes":                  750,
+	"hidden_kernel_module":           2025,
+	"hidden_kernel_module_seeker":    782,
+	"hooked_proc_fops":               765,
+	"hooked_seq_ops":                 2022,
+	"hooked_syscall":                 2021,
+	"idle":                           475,
+	"init_module":                    175,
+	"init_namespaces":                2017,
+	"inotify_add_watch":              254,
+	"inotify_init":                   253,
+	"inotify_init1":                  294,
+	"inotify_rm_watch":               255,
+	"inotify_watch":                  777,
+	"io_cancel":                      210,
+	"io_destroy":                     207,
+	"io_getevents":                   208,
+	"io_pgetevents":                  333,
+	"io_pgetevents_time32":           528,
+	"io_setup":                       206,
+	"io_submit":                      209,
+	"io_uring_enter":                 426,
+	"io_uring_register":              427,
+	"io_uring_setup":                 425,
+	"ioctl":                          16,
+	"ioperm":                         173,
+	"iopl":                           172,
+	"ioprio_get":                     252,
+	"ioprio_set":                     251,
+	"ipc":                            477,
+	"kallsyms_lookup_name":           771,
+	"kcmp":                           312,
+	"kexec_file_load":                320,
+	"kexec_load":                     246,
+	"keyctl":                         250,
+	"kill":                           62,
+	"kprobe_attach":                  753,
+	"landlock_add_rule":              445,
+	"landlock_create_ruleset":        444,
+	"landlock_restrict_self":         446,
+	"lchown":                         94,
+	"lchown16":                       492,
+	"lgetxattr":                      192,
+	"link":                           86,
+	"linkat":                         265,
+	"listen":                         50,
+	"listxattr":                      194,
+	"llistxattr":                     195,
+	"llseek":                         482,
+	"load_elf_phdrs":                 764,
+	"lock":                           461,
+	"lookup_dcookie":                 212,
+	"lremovexattr":                   198,
+	"lseek":                          8,
+	"lsetxattr":                      189,
+	"lstat":                          6,
+	"lstat64":                        490,
+	"madvise":                        28,
+	"magic_write":                    727,
+	"mbind":                          237,
+	"mem_prot_alert":                 724,
+	"membarrier":                     324,
+	"memfd_create":                   319,
+	"memfd_secret":                   447,
+	"migrate_pages":                  256,
+	"mincore":                        27,
+	"missing_ksymbol":                8001,
+	"mkdir":                          83,
+	"mkdirat":                        258,
+	"mknod":                          133,
+	"mknodat":                        259,
+	"mlock":                          149,
+	"mlock2":                         325,
+	"mlockall":                       151,
+	"mmap":                           9,
+	"mmap2":                          486,
+	"modify_ldt":                     154,
+	"module_free":                    784,
+	"module_load":                    783,
+	"mount":                          165,
+	"mount_setattr":                  442,
+	"move_mount":                     429,
+	"move_pages":                     279,
+	"mprotect":                       10,
+	"mpx":                            462,
+	"mq_getsetattr":                  245,
+	"mq_notify":                      244,
+	"mq_open":                        240,
+	"mq_timedreceive":                243,
+	"mq_timedreceive_time32":         531,
+	"mq_timedsend":                   242,
+	"mq_timedsend_time32":            530,
+	"mq_unlink":                      241,
+	"mremap":                         25,
+	"msgctl":                         71,
+	"msgget":                         68,
+	"msgrcv":                         70,
+	"msgsnd":                         69,
+	"msync":                          26,
+	"munlock":                        150,
+	"munlockall":                     152,
+	"munmap":                         11,
+	"name_to_handle_at":              303,
+	"nanosleep":                      35,
+	"net_flow_tcp_begin":             2013,
+	"net_flow_tcp_end":               2014,
+	"net_packet_base":                700,
+	"net_packet_capture":             709,
+	"net_packet_dns":                 2006,
+	"net_packet_dns_base":            707,
+	"net_packet_dns_request":         2007,
+	"net_packet_dns_response":        2008,
+	"net_packet_flow_base":           710,
+	"net_packet_http":                2009,
+	"net_packet_http_base":           708,
+	"net_packet_http_request":        2010,
+	"net_packet_http_response":       2011,
+	"net_packet_icmp":                2004,
+	"net_packet_icmp_base":           705,
+	"net_packet_icmpv6":              2005,
+	"net_packet_icmpv6_base":         706,
+	"net_packet_ip_base":             702,
+	"net_packet_ipv4":                2000,
+	"net_packet_ipv6":                2001,
+	"net_packet_raw":                 701,
+	"net_packet_tcp":                 2002,
+	"net_packet_tcp_base":            703,
+	"net_packet_udp":                 2003,
+	"net_packet_udp_base":            704,
+	"net_tcp_connect":                2016,
+	"newfstatat":                     262,
+	"nfsservctl":                     180,
+	"nice":                           457,
+	"old_getrlimit":                  485,
+	"old_select":                     483,
+	"oldfstat":                       450,
+	"oldlstat":                       470,
+	"oldolduname":                    464,
+	"oldstat":                        452,
+	"olduname":                       474,
+	"open":                           2,
+	"open_by_handle_at":              304,
+	"open_tree":                      428,
+	"openat":                         257,
+	"openat2":                        437,
+	"pause":                          34,
+	"perf_event_open":                298,
+	"personality":                    135,
+	"pidfd_getfd":                    438,
+	"pidfd_open":                     434,
+	"pidfd_send_signal":              424,
+	"pipe":                           22,
+	"pipe2":                          293,
+	"pivot_root":                     155,
+	"pkey_alloc":                     330,
+	"pkey_free":                      331,
+	"pkey_mprotect":                  329,
+	"poll":                           7,
+	"ppoll":                          271,
+	"ppoll_time32":                   527,
+	"prctl":                          157,
+	"pread64":                        17,
+	"preadv":                         295,
+	"preadv2":                        327,
+	"print_mem_dump":                 773,
+	"print_net_seq_ops":              766,
+	"prlimit64":                      302,
+	"proc_create":                    752,
+	"process_execute_failed":         779,
+	"process_madvise":                440,
+	"process_mrelease":               448,
+	"process_vm_readv":               310,
+	"process_vm_writev":              311,
+	"prof":                           459,
+	"profil":                         472,
+	"pselect6":                       270,
+	"pselect6_time32":                526,
+	"ptrace":                         101,
+	"putpmsg":                        182,
+	"pwrite64":                       18,
+	"pwritev":                        296,
+	"pwritev2":                       328,
+	"query_module":                   178,
+	"quotactl":                       179,
+	"quotactl_fd":                    443,
+	"read":                           0,
+	"readahead":                      187,
+	"readdir":                        471,
+	"readlink":                       89,
+	"readlinkat":                     267,
+	"readv":                          19,
+	"reboot":                         169,
+	"recvfrom":                       45,
+	"recvmmsg":                       299,
+	"recvmmsg_time32":                529,
+	"recvmsg":                        47,
+	"register_chrdev":                760,
+	"remap_file_pages":               216,
+	"removexattr":                    197,
+	"rename":                         82,
+	"renameat":                       264,
+	"renameat2":                      316,
+	"request_key":                    249,
+	"restart_syscall":                219,
+	"rmdir":                          84,
+	"rseq":                           334,
+	"rt_sigaction":                   13,
+	"rt_sigpending":                  127,
+	"rt_sigprocmask":                 14,
+	"rt_sigqueueinfo":                129,
+	"rt_sigreturn":                   15,
+	"rt_sigsuspend":                  130,
+	"rt_sigtimedwait":                128,
+	"rt_sigtimedwait_time32":         532,
+	"rt_tgsigqueueinfo":              297,
+	"sched_get_priority_max":         146,
+	"sched_get_priority_min":         147,
+	"sched_getaffinity":              204,
+	"sched_getattr":                  315,
+	"sched_getparam":                 143,
+	"sched_getscheduler":             145,
+	"sched_process_exec":             715,
+	"sched_process_exit":             716,
+	"sched_process_fork":             714,
+	"sched_rr_get_interval":          148,
+	"sched_rr_get_interval_time32":   534,
+	"sched_setaffinity":              203,
+	"sched_setattr":                  314,
+	"sched_setparam":                 142,
+	"sched_setscheduler":             144,
+	"sched_switch":                   717,
+	"sched_yield":                    24,
+	"seccomp":                        317,
+	"security":                       185,
+	"security_bpf":                   741,
+	"security_bpf_map":               742,
+	"security_bpf_prog":              778,
+	"security_bprm_check":            731,
+	"security_bprm_creds_for_exec":   786,
+	"security_file_mprotect":         748,
+	"security_file_open":             732,
+	"security_inode_mknod":           744,
+	"security_inode_rename":          768,
+	"security_inode_symlink":         746,
+	"security_inode_unlink":          733,
+	"security_kernel_post_read_file": 745,
+	"security_kernel_read_file":      743,
+	"security_mmap_file":             747,
+	"security_path_notify":           780,
+	"security_sb_mount":              740,
+	"security_socket_accept":         737,
+	"security_socket_bind":           738,
+	"security_socket_connect":        736,
+	"security_socket_create":         734,
+	"security_socket_listen":         735,
+	"security_socket_setsockopt":     739,
+	"security_task_setrlimit":        787,
+	"select":                         23,
+	"semctl":                         66,
+	"semget":                         64,
+	"semop":                          65,
+	"semtimedop":                     220,
+	"sendfile":                       40,
+	"sendfile32":                     512,
+	"sendmmsg":                       307,
+	"sendmsg":                        46,
+	"sendto":                         44,
+	"set_fs_pwd":                     781,
+	"set_mempolicy":                  238,
+	"set_robust_list":                273,
+	"set_thread_area":                205,
+	"set_tid_address":                218,
+	"setdomainname":                  171,
+	"setfsgid":                       123,
+	"setfsgid16":                     510,
+	"setfsuid":                       122,
+	"setfsuid16":                     509,
+	"setgid":                         106,
+	"setgid16":                       508,
+	"setgroups":                      116,
+	"setgroups16":                    500,
+	"sethostname":                    170,
+	"setitimer":                      38,
+	"setns":                          308,
+	"setpgid":                        109,
+	"setpriority":                    141,
+	"setregid":                       114,
+	"setregid16":                     498,
+	"setresgid":                      119,
+	"setresgid16":                    504,
+	"setresuid":                      117,
+	"setresuid16":                    502,
+	"setreuid":                       113,
+	"setreuid16":                     497,
+	"setrlimit":                      160,
+	"setsid":                         112,
+	"setsockopt":                     54,
+	"settimeofday":                   164,
+	"setuid":                         105,
+	"setuid16":                       507,
+	"setxattr":                       188,
+	"sgetmask":                       466,
+	"shared_object_loaded":           761,
+	"shmat":                          30,
+	"shmctl":                         31,
+	"shmdt":                          67,
+	"shmget":                         29,
+	"shutdown":                       48,
+	"sigaction":                      465,
+	"sigaltstack":                    131,
+	"signal":                         460,
+	"signal_cgroup_mkdir":            5000,
+	"signal_cgroup_rmdir":            5001,
+	"signal_sched_process_exec":      5003,
+	"signal_sched_process_exit":      5004,
+	"signal_sched_process_fork":      5002,
+	"signalfd":                       282,
+	"signalfd4":                      289,
+	"sigpending":                     469,
+	"sigprocmask":                    479,
+	"sigreturn":                      478,
+	"sigsuspend":                     468,
+	"socket":                         41,
+	"socket_accept":                  763,
+	"socket_dup":                     749,
+	"socketcall":                     473,
+	"socketpair":                     53,
+	"splice":                         275,
+	"ssetmask":                       467,
+	"stat":                           4,
+	"stat64":                         489,
+	"statfs":                         137,
+	"statfs64":                       513,
+	"statx":                          332,
+	"stime":                          454,
+	"stty":                           455,
+	"suppressed_events":              2027,
+	"swapoff":                        168,
+	"swapon":                         167,
+	"switch_task_ns":                 726,
+	"symbols_collision":              2024,
+	"symbols_loaded":                 2023,
+	"symlink":                        88,
+	"symlinkat":                      266,
+	"sync":                           162,
+	"sync_file_range":                277,
+	"syncfs":                         306,
+	"sys_enter":                      712,
+	"sys_exit":                       713,
+	"syscall_table_check":            757,
+	"sysctl":                         156,
+	"sysfs":                          139,
+	"sysinfo":                        99,
+	"syslog":                         103,
+	"task_rename":                    767,
+	"tee":                            276,
+	"tgkill":                         234,
+	"time":                           201,
+	"timer_create":                   222,
+	"timer_delete":                   226,
+	"timer_getoverrun":               225,
+	"timer_gettime":                  224,
+	"timer_gettime32":                521,
+	"timer_settime":                  223,
+	"timer_settime32":                522,
+	"timerfd_create":                 283,
+	"timerfd_gettime":                287,
+	"timerfd_gettime32":              523,
+	"timerfd_settime":                286,
+	"timerfd_settime32":              524,
+	"times":                          100,
+	"tkill":                          200,
+	"truncate":                       76,
+	"truncate64":                     487,
+	"tuxcall":                        184,
+	"ulimit":                         463,
+	"umask":                          95,
+	"umount":                         453,
+	"umount2":                        166,
+	"uname":                          63,
+	"unlink":                         87,
+	"unlinkat":                       263,
+	"unshare":                        272,
+	"uselib":                         134,
+	"userfaultfd":                    323,
+	"ustat":                          136,
+	"utime":                          132,
+	"utimensat":                      280,
+	"utimensat_time32":               525,
+	"utimes":                         235,
+	"vfork":                          58,
+	"vfs_read":                       722,
+	"vfs_readv":                      723,
+	"vfs_utimes":                     774,
+	"vfs_write":                      720,
+	"vfs_writev":                     721,
+	"vhangup":                        153,
+	"vm86":                           484,
+	"vm86old":                        476,
+	"vmsplice":                       278,
+	"vserver":                        236,
+	"wait4":                          61,
+	"waitid":                         247,
+	"waitpid":                        449,
+	"write":                          1,
+	"writev":                         20,
+}
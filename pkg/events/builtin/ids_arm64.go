@@ -0,0 +1,580 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package builtin
+
+var ids = map[string]int{
+	"__kernel_write":                 751,
+	"accept":                         202,
+	"accept4":                        242,
+	"access":                         9004,
+	"acct":                           89,
+	"add_key":                        217,
+	"adjtimex":                       171,
+	"afs":                            9041,
+	"afs_syscall":                    9088,
+	"alarm":                          9009,
+	"arch_prctl":                     9032,
+	"bdflush":                        9087,
+	"bind":                           200,
+	"bpf":                            280,
+	"bpf_attach":                     770,
+	"break":                          9059,
+	"brk":                            214,
+	"call_usermodehelper":            754,
+	"cap_capable":                    719,
+	"capget":                         90,
+	"capset":                         91,
+	"capture_bpf":                    4006,
+	"capture_exec":                   4001,
+	"capture_file_read":              4007,
+	"capture_file_write":             4000,
+	"capture_mem":                    4003,
+	"capture_module":                 4002,
+	"capture_net_packet":             4005,
+	"cgroup_attach_task":             728,
+	"cgroup_mkdir":                   729,
+	"cgroup_rmdir":                   730,
+	"chdir":                          49,
+	"chmod":                          9021,
+	"chown":                          9022,
+	"chown16":                        9113,
+	"chroot":                         51,
+	"clock_adjtime":                  266,
+	"clock_adjtime64":                405,
+	"clock_getres":                   114,
+	"clock_getres_time32":            9125,
+	"clock_gettime":                  113,
+	"clock_gettime32":                9123,
+	"clock_nanosleep":                115,
+	"clock_nanosleep_time32":         9126,
+	"clock_settime":                  112,
+	"clock_settime32":                9124,
+	"clone":                          220,
+	"clone3":                         435,
+	"close":                          57,
+	"close_range":                    436,
+	"commit_creds":                   725,
+	"connect":                        203,
+	"container_create":               2018,
+	"container_remove":               2019,
+	"copy_file_range":                285,
+	"creat":                          9016,
+	"create_module":                  9036,
+	"debugfs_create_dir":             758,
+	"debugfs_create_file":            756,
+	"delete_module":                  106,
+	"device_add":                     759,
+	"dirty_pipe_splice":              755,
+	"do_exit":                        718,
+	"do_init_module":                 762,
+	"do_mmap":                        772,
+	"do_sigaction":                   769,
+	"do_truncate":                    775,
+	"dup":                            23,
+	"dup2":                           9007,
+	"dup3":                           24,
+	"epoll_create":                   9047,
+	"epoll_create1":                  20,
+	"epoll_ctl":                      21,
+	"epoll_ctl_old":                  9048,
+	"epoll_pwait":                    22,
+	"epoll_pwait2":                   441,
+	"epoll_wait":                     9050,
+	"epoll_wait_old":                 9049,
+	"eventfd":                        9056,
+	"eventfd2":                       19,
+	"exec_test":                      8000,
+	"execute_finished":               785,
+	"execve":                         221,
+	"execveat":                       281,
+	"existing_container":             2020,
+	"exit":                           93,
+	"exit_group":                     94,
+	"faccessat":                      48,
+	"faccessat2":                     439,
+	"fadvise64":                      223,
+	"fadvise64_64":                   9122,
+	"failed_attach":                  8002,
+	"fallocate":                      47,
+	"fanotify_init":                  262,
+	"fanotify_mark":                  263,
+	"fchdir":                         50,
+	"fchmod":                         52,
+	"fchmodat":                       53,
+	"fchown":                         55,
+	"fchown16":                       9108,
+	"fchownat":                       54,
+	"fcntl":                          25,
+	"fcntl64":                        9118,
+	"fdatasync":                      83,
+	"fgetxattr":                      10,
+	"file_modification":              776,
+	"finit_module":                   273,
+	"flistxattr":                     13,
+	"flock":                          32,
+	"fork":                           9010,
+	"fremovexattr":                   16,
+	"fsconfig":                       431,
+	"fsetxattr":                      7,
+	"fsmount":                        432,
+	"fsopen":                         430,
+	"fspick":                         433,
+	"fstat":                          80,
+	"fstat64":                        9098,
+	"fstatfs":                        44,
+	"fstatfs64":                      9121,
+	"fsync":                          82,
+	"ftime":                          9065,
+	"ftrace_hook":                    2026,
+	"ftruncate":                      46,
+	"ftruncate64":                    9095,
+	"futex":                          98,
+	"futex_time32":                   9139,
+	"futimesat":                      9054,
+	"get_kernel_syms":                9037,
+	"get_mempolicy":                  236,
+	"get_robust_list":                100,
+	"get_thread_area":                9046,
+	"getcpu":                         168,
+	"getcwd":                         17,
+	"getdents":                       9012,
+	"getdents64":                     61,
+	"getegid":                        177,
+	"getegid16":                      9103,
+	"geteuid":                        175,
+	"geteuid16":                      9102,
+	"getgid":                         176,
+	"getgid16":                       9101,
+	"getgroups":                      158,
+	"getgroups16":                    9106,
+	"getitimer":                      102,
+	"getpeername":                    205,
+	"getpgid":                        155,
+	"getpgrp":                        9024,
+	"getpid":                         172,
+	"getpmsg":                        9039,
+	"getppid":                        173,
+	"getpriority":                    141,
+	"getrandom":                      278,
+	"getresgid":                      150,
+	"getresgid16":                    9112,
+	"getresuid":                      148,
+	"getresuid16":                    9110,
+	"getrlimit":                      163,
+	"getrusage":                      165,
+	"getsid":                         156,
+	"getsockname":                    204,
+	"getsockopt":                     209,
+	"gettid":                         178,
+	"gettimeofday":                   169,
+	"getuid":                         174,
+	"getuid16":                       9100,
+	"getxattr":                       8,
+	"gtty":                           9063,
+	"hidden_inodes":                  750,
+	"hidden_kernel_module":           2025,
+	"hidden_kernel_module_seeker":    782,
+	"hooked_proc_fops":               765,
+	"hooked_seq_ops":                 2022,
+	"hooked_syscall":                 2021,
+	"idle":                           9082,
+	"init_module":                    105,
+	"init_namespaces":                2017,
+	"inotify_add_watch":              27,
+	"inotify_init":                   9053,
+	"inotify_init1":                  26,
+	"inotify_rm_watch":               28,
+	"inotify_watch":                  777,
+	"io_cancel":                      3,
+	"io_destroy":                     1,
+	"io_getevents":                   4,
+	"io_pgetevents":                  292,
+	"io_pgetevents_time32":           9134,
+	"io_setup":                       0,
+	"io_submit":                      2,
+	"io_uring_enter":                 426,
+	"io_uring_register":              427,
+	"io_uring_setup":                 425,
+	"ioctl":                          29,
+	"ioperm":                         9035,
+	"iopl":                           9034,
+	"ioprio_get":                     31,
+	"ioprio_set":                     30,
+	"ipc":                            9084,
+	"kallsyms_lookup_name":           771,
+	"kcmp":                           272,
+	"kexec_file_load":                294,
+	"kexec_load":                     104,
+	"keyctl":                         219,
+	"kill":                           129,
+	"kprobe_attach":                  753,
+	"landlock_add_rule":              445,
+	"landlock_create_ruleset":        444,
+	"landlock_restrict_self":         446,
+	"lchown":                         9023,
+	"lchown16":                       9099,
+	"lgetxattr":                      9,
+	"link":                           9017,
+	"linkat":                         37,
+	"listen":                         201,
+	"listxattr":                      11,
+	"llistxattr":                     12,
+	"llseek":                         9089,
+	"load_elf_phdrs":                 764,
+	"lock":                           9068,
+	"lookup_dcookie":                 18,
+	"lremovexattr":                   15,
+	"lseek":                          62,
+	"lsetxattr":                      6,
+	"lstat":                          9002,
+	"lstat64":                        9097,
+	"madvise":                        233,
+	"magic_write":                    727,
+	"mbind":                          235,
+	"mem_prot_alert":                 724,
+	"membarrier":                     283,
+	"memfd_create":                   279,
+	"memfd_secret":                   447,
+	"migrate_pages":                  238,
+	"mincore":                        232,
+	"missing_ksymbol":                8001,
+	"mkdir":                          9014,
+	"mkdirat":                        34,
+	"mknod":                          9026,
+	"mknodat":                        33,
+	"mlock":                          228,
+	"mlock2":                         284,
+	"mlockall":                       230,
+	"mmap":                           222,
+	"mmap2":                          9093,
+	"modify_ldt":                     9030,
+	"module_free":                    784,
+	"module_load":                    783,
+	"mount":                          40,
+	"mount_setattr":                  442,
+	"move_mount":                     429,
+	"move_pages":                     239,
+	"mprotect":                       226,
+	"mpx":                            9069,
+	"mq_getsetattr":                  185,
+	"mq_notify":                      184,
+	"mq_open":                        180,
+	"mq_timedreceive":                183,
+	"mq_timedreceive_time32":         9137,
+	"mq_timedsend":                   182,
+	"mq_timedsend_time32":            9136,
+	"mq_unlink":                      181,
+	"mremap":                         216,
+	"msgctl":                         187,
+	"msgget":                         186,
+	"msgrcv":                         188,
+	"msgsnd":                         189,
+	"msync":                          227,
+	"munlock":                        229,
+	"munlockall":                     231,
+	"munmap":                         215,
+	"name_to_handle_at":              264,
+	"nanosleep":                      101,
+	"net_flow_tcp_begin":             2013,
+	"net_flow_tcp_end":               2014,
+	"net_packet_base":                700,
+	"net_packet_capture":             709,
+	"net_packet_dns":                 2006,
+	"net_packet_dns_base":            707,
+	"net_packet_dns_request":         2007,
+	"net_packet_dns_response":        2008,
+	"net_packet_flow_base":           710,
+	"net_packet_http":                2009,
+	"net_packet_http_base":           708,
+	"net_packet_http_request":        2010,
+	"net_packet_http_response":       2011,
+	"net_packet_icmp":                2004,
+	"net_packet_icmp_base":           705,
+	"net_packet_icmpv6":              2005,
+	"net_packet_icmpv6_base":         706,
+	"net_packet_ip_base":             702,
+	"net_packet_ipv4":                2000,
+	"net_packet_ipv6":                2001,
+	"net_packet_raw":                 701,
+	"net_packet_tcp":                 2002,
+	"net_packet_tcp_base":            703,
+	"net_packet_udp":                 2003,
+	"net_packet_udp_base":            704,
+	"net_tcp_connect":                2016,
+	"newfstatat":                     79,
+	"nfsservctl":                     42,
+	"nice":                           9064,
+	"old_getrlimit":                  9092,
+	"old_select":                     9090,
+	"oldfstat":                       9058,
+	"oldlstat":                       9077,
+	"oldolduname":                    9071,
+	"oldstat":                        9060,
+	"olduname":                       9081,
+	"open":                           9000,
+	"open_by_handle_at":              265,
+	"open_tree":                      428,
+	"openat":                         56,
+	"openat2":                        437,
+	"pause":                          9008,
+	"perf_event_open":                241,
+	"personality":                    92,
+	"pidfd_getfd":                    438,
+	"pidfd_open":                     434,
+	"pidfd_send_signal":              424,
+	"pipe":                           9005,
+	"pipe2":                          59,
+	"pivot_root":                     41,
+	"pkey_alloc":                     289,
+	"pkey_free":                      290,
+	"pkey_mprotect":                  288,
+	"poll":                           9003,
+	"ppoll":                          73,
+	"ppoll_time32":                   9133,
+	"prctl":                          167,
+	"pread64":                        67,
+	"preadv":                         69,
+	"preadv2":                        286,
+	"print_mem_dump":                 773,
+	"print_net_seq_ops":              766,
+	"prlimit64":                      261,
+	"proc_create":                    752,
+	"process_execute_failed":         779,
+	"process_madvise":                440,
+	"process_mrelease":               448,
+	"process_vm_readv":               270,
+	"process_vm_writev":              271,
+	"prof":                           9066,
+	"profil":                         9079,
+	"pselect6":                       72,
+	"pselect6_time32":                9132,
+	"ptrace":                         117,
+	"putpmsg":                        9040,
+	"pwrite64":                       68,
+	"pwritev":                        70,
+	"pwritev2":                       287,
+	"query_module":                   9038,
+	"quotactl":                       60,
+	"quotactl_fd":                    443,
+	"read":                           63,
+	"readahead":                      213,
+	"readdir":                        9078,
+	"readlink":                       9020,
+	"readlinkat":                     78,
+	"readv":                          65,
+	"reboot":                         142,
+	"recvfrom":                       207,
+	"recvmmsg":                       243,
+	"recvmmsg_time32":                9135,
+	"recvmsg":                        212,
+	"register_chrdev":                760,
+	"remap_file_pages":               234,
+	"removexattr":                    14,
+	"rename":                         9013,
+	"renameat":                       38,
+	"renameat2":                      276,
+	"request_key":                    218,
+	"restart_syscall":                128,
+	"rmdir":                          9015,
+	"rseq":                           293,
+	"rt_sigaction":                   134,
+	"rt_sigpending":                  136,
+	"rt_sigprocmask":                 135,
+	"rt_sigqueueinfo":                138,
+	"rt_sigreturn":                   139,
+	"rt_sigsuspend":                  133,
+	"rt_sigtimedwait":                137,
+	"rt_sigtimedwait_time32":         9138,
+	"rt_tgsigqueueinfo":              240,
+	"sched_get_priority_max":         125,
+	"sched_get_priority_min":         126,
+	"sched_getaffinity":              123,
+	"sched_getattr":                  275,
+	"sched_getparam":                 121,
+	"sched_getscheduler":             120,
+	"sched_process_exec":             715,
+	"sched_process_exit":             716,
+	"sched_process_fork":             714,
+	"sched_rr_get_interval":          127,
+	"sched_rr_get_interval_time32":   9140,
+	"sched_setaffinity":              122,
+	"sched_setattr":                  274,
+	"sched_setparam":                 118,
+	"sched_setscheduler":             119,
+	"sched_switch":                   717,
+	"sched_yield":                    124,
+	"seccomp":                        277,
+	"security":                       9043,
+	"security_bpf":                   741,
+	"security_bpf_map":               742,
+	"security_bpf_prog":              778,
+	"security_bprm_check":            731,
+	"security_bprm_creds_for_exec":   786,
+	"security_file_mprotect":         748,
+	"security_file_open":             732,
+	"security_inode_mknod":           744,
+	"security_inode_rename":          768,
+	"security_inode_symlink":         746,
+	"security_inode_unlink":          733,
+	"security_kernel_post_read_file": 745,
+	"security_kernel_read_file":      743,
+	"security_mmap_file":             747,
+	"security_path_notify":           780,
+	"security_sb_mount":              740,
+	"security_socket_accept":         737,
+	"security_socket_bind":           738,
+	"security_socket_connect":        736,
+	"security_socket_create":         734,
+	"security_socket_listen":         735,
+	"security_socket_setsockopt":     739,
+	"security_task_setrlimit":        787,
+	"select":                         9006,
+	"semctl":                         191,
+	"semget":                         190,
+	"semop":                          193,
+	"semtimedop":                     192,
+	"sendfile":                       71,
+	"sendfile32":                     9119,
+	"sendmmsg":                       269,
+	"sendmsg":                        211,
+	"sendto":                         206,
+	"set_fs_pwd":                     781,
+	"set_mempolicy":                  237,
+	"set_robust_list":                99,
+	"set_thread_area":                9045,
+	"set_tid_address":                96,
+	"setdomainname":                  162,
+	"setfsgid":                       152,
+	"setfsgid16":                     9117,
+	"setfsuid":                       151,
+	"setfsuid16":                     9116,
+	"setgid":                         144,
+	"setgid16":                       9115,
+	"setgroups":                      159,
+	"setgroups16":                    9107,
+	"sethostname":                    161,
+	"setitimer":                      103,
+	"setns":                          268,
+	"setpgid":                        154,
+	"setpriority":                    140,
+	"setregid":                       143,
+	"setregid16":                     9105,
+	"setresgid":                      149,
+	"setresgid16":                    9111,
+	"setresuid":                      147,
+	"setresuid16":                    9109,
+	"setreuid":                       145,
+	"setreuid16":                     9104,
+	"setrlimit":                      164,
+	"setsid":                         157,
+	"setsockopt":                     208,
+	"settimeofday":                   170,
+	"setuid":                         146,
+	"setuid16":                       9114,
+	"setxattr":                       5,
+	"sgetmask":                       9073,
+	"shared_object_loaded":           761,
+	"shmat":                          196,
+	"shmctl":                         195,
+	"shmdt":                          197,
+	"shmget":                         194,
+	"shutdown":                       210,
+	"sigaction":                      9072,
+	"sigaltstack":                    132,
+	"signal":                         9067,
+	"signal_cgroup_mkdir":            5000,
+	"signal_cgroup_rmdir":            5001,
+	"signal_sched_process_exec":      5003,
+	"signal_sched_process_exit":      5004,
+	"signal_sched_process_fork":      5002,
+	"signalfd":                       9055,
+	"signalfd4":                      74,
+	"sigpending":                     9076,
+	"sigprocmask":                    9086,
+	"sigreturn":                      9085,
+	"sigsuspend":                     9075,
+	"socket":                         198,
+	"socket_accept":                  763,
+	"socket_dup":                     749,
+	"socketcall":                     9080,
+	"socketpair":                     199,
+	"splice":                         76,
+	"ssetmask":                       9074,
+	"stat":                           9001,
+	"stat64":                         9096,
+	"statfs":                         43,
+	"statfs64":                       9120,
+	"statx":                          291,
+	"stime":                          9061,
+	"stty":                           9062,
+	"suppressed_events":              2027,
+	"swapoff":                        225,
+	"swapon":                         224,
+	"switch_task_ns":                 726,
+	"symbols_collision":              2024,
+	"symbols_loaded":                 2023,
+	"symlink":                        9019,
+	"symlinkat":                      36,
+	"sync":                           81,
+	"sync_file_range":                84,
+	"syncfs":                         267,
+	"sys_enter":                      712,
+	"sys_exit":                       713,
+	"syscall_table_check":            757,
+	"sysctl":                         9031,
+	"sysfs":                          9029,
+	"sysinfo":                        179,
+	"syslog":                         116,
+	"task_rename":                    767,
+	"tee":                            77,
+	"tgkill":                         131,
+	"time":                           9044,
+	"timer_create":                   107,
+	"timer_delete":                   111,
+	"timer_getoverrun":               109,
+	"timer_gettime":                  108,
+	"timer_gettime32":                9127,
+	"timer_settime":                  110,
+	"timer_settime32":                9128,
+	"timerfd_create":                 85,
+	"timerfd_gettime":                87,
+	"timerfd_gettime32":              9129,
+	"timerfd_settime":                86,
+	"timerfd_settime32":              9130,
+	"times":                          153,
+	"tkill":                          130,
+	"truncate":                       45,
+	"truncate64":                     9094,
+	"tuxcall":                        9042,
+	"ulimit":                         9070,
+	"umask":                          166,
+	"umount":                         9033,
+	"umount2":                        39,
+	"uname":                          160,
+	"unlink":                         9018,
+	"unlinkat":                       35,
+	"unshare":                        97,
+	"uselib":                         9027,
+	"userfaultfd":                    282,
+	"ustat":                          9028,
+	"utime":                          9025,
+	"utimensat":                      88,
+	"utimensat_time32":               9131,
+	"utimes":                         9051,
+	"vfork":                          9011,
+	"vfs_read":                       722,
+	"vfs_readv":                      723,
+	"vfs_utimes":                     774,
+	"vfs_write":                      720,
+	"vfs_writev":                     721,
+	"vhangup":                        58,
+	"vm86":                           9091,
+	"vm86old":                        9083,
+	"vmsplice":                       75,
+	"vserver":                        9052,
+	"wait4":                          260,
+	"waitid":                         95,
+	"waitpid":                        9057,
+	"write":                          64,
+	"writev":                         66,
+}
@@ -144,6 +144,7 @@ const (
 	SymbolsCollision
 	HiddenKernelModule
 	FtraceHook
+	SuppressedEvents
 	MaxUserSpace
 )
 
@@ -13012,6 +13013,18 @@ var CoreEvents = map[ID]Definition{
 			{Type: "unsigned long", Name: "count"},
 		},
 	},
+	SuppressedEvents: {
+		id:      SuppressedEvents,
+		id32Bit: Sys32Undefined,
+		name:    "suppressed_events",
+		version: NewVersion(1, 0, 0),
+		sets:    []string{},
+		params: []trace.ArgMeta{
+			{Type: "const char*", Name: "event"},
+			{Type: "const char*", Name: "reason"},
+			{Type: "unsigned long", Name: "count"},
+		},
+	},
 	SecurityPathNotify: {
 		id:      SecurityPathNotify,
 		id32Bit: Sys32Undefined,
@@ -0,0 +1,61 @@
+package parse
+
+import (
+	"encoding/json"
+	"math"
+
+	"github.com/khulnasoft-lab/tracker/types/trace"
+)
+
+// FindingSeverity returns the severity of an event created from a signature. The severity
+// may be of any numeric kind, depending on where the signature comes from (go signatures
+// set an int, rego and json decoded events a float64 or a json.Number).
+func FindingSeverity(event trace.Event) (int, bool) {
+	if event.Metadata == nil {
+		return 0, false
+	}
+
+	switch severity := event.Metadata.Properties["Severity"].(type) {
+	case int:
+		return severity, true
+	case int8:
+		return int(severity), true
+	case int16:
+		return int(severity), true
+	case int32:
+		return int(severity), true
+	case int64:
+		return int(severity), true
+	case uint:
+		return int(severity), true
+	case uint8:
+		return int(severity), true
+	case uint16:
+		return int(severity), true
+	case uint32:
+		return int(severity), true
+	case uint64:
+		return int(severity), true
+	case float32:
+		return floatSeverity(float64(severity))
+	case float64:
+		return floatSeverity(severity)
+	case json.Number:
+		value, err := severity.Float64()
+		if err != nil {
+			return 0, false
+		}
+		return floatSeverity(value)
+	}
+
+	return 0, false
+}
+
+// floatSeverity returns a severity given as a float, if it is an integral number
+func floatSeverity(severity float64) (int, bool) {
+	if severity != math.Trunc(severity) || math.IsInf(severity, 0) {
+		return 0, false
+	}
+
+	return int(severity), true
+}
@@ -0,0 +1,53 @@
+package parse
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/khulnasoft-lab/tracker/types/trace"
+)
+
+func TestFindingSeverity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		severity         any
+		expectedSeverity int
+		expectedOk       bool
+	}{
+		{name: "int", severity: 3, expectedSeverity: 3, expectedOk: true},
+		{name: "int64", severity: int64(2), expectedSeverity: 2, expectedOk: true},
+		{name: "uint8", severity: uint8(1), expectedSeverity: 1, expectedOk: true},
+		{name: "float64", severity: float64(4), expectedSeverity: 4, expectedOk: true},
+		{name: "float32", severity: float32(2), expectedSeverity: 2, expectedOk: true},
+		{name: "json number", severity: json.Number("3"), expectedSeverity: 3, expectedOk: true},
+		{name: "fractional float", severity: 2.5},
+		{name: "invalid json number", severity: json.Number("high")},
+		{name: "string", severity: "3"},
+		{name: "missing", severity: nil},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			properties := map[string]interface{}{}
+			if tc.severity != nil {
+				properties["Severity"] = tc.severity
+			}
+			event := trace.Event{Metadata: &trace.Metadata{Properties: properties}}
+
+			severity, ok := FindingSeverity(event)
+			assert.Equal(t, tc.expectedOk, ok)
+			assert.Equal(t, tc.expectedSeverity, severity)
+		})
+	}
+
+	_, ok := FindingSeverity(trace.Event{})
+	assert.False(t, ok)
+}
@@ -1,6 +1,6 @@
 // package queue defines the interface and and implementation of a queue for events storage.
-// the interface is defined by EventQueue while the (currently only) implementation is defined by
-// eventQueueMem.
+// the interface is defined by EventQueue while the implementations are defined by eventQueueMem
+// (in memory) and eventQueueDisk (on disk, surviving restarts).
 package queue
 
 import (
@@ -14,3 +14,11 @@ type EventQueue interface {
 	Enqueue(*trace.Event)
 	Dequeue() *trace.Event
 }
+
+// PolicyNames translates the matched policies bitmaps of the events to the policies
+// names, and back. Queues storing events across restarts store the names, as the
+// bitmaps of the next run might not have the same policies at the same positions.
+type PolicyNames interface {
+	MatchedNames(matched uint64) []string
+	MatchedBitmap(names []string) uint64
+}
@@ -0,0 +1,566 @@
+package queue
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/khulnasoft-lab/tracker/pkg/errfmt"
+	"github.com/khulnasoft-lab/tracker/pkg/logger"
+	"github.com/khulnasoft-lab/tracker/types/trace"
+)
+
+const (
+	diskSegmentPrefix      = "segment."
+	diskOffsetFile         = "offset"
+	diskRecordHeaderSize   = 4                // little endian uint32 length of the record
+	diskMaxSegmentSize     = 64 * 1024 * 1024 // bytes
+	diskMinSegmentSize     = 1024 * 1024      // bytes
+	diskOffsetSyncEvents   = 1024             // dequeued events between read offset syncs
+	diskDefaultCacheSizeMB = 1024
+)
+
+func init() {
+	// event arguments values, as decoded from the eBPF buffers, other than the gob basics
+	gob.Register(map[string]string{})
+	gob.Register([2]int32{})
+	gob.Register(trace.SlimCred{})
+}
+
+// this usecase implements EventQueue interface with a disk stored queue (FIFO)
+//
+// Events are appended to segment files in the queue directory, and read back in order.
+// Each segment is a gob stream (so types are only described once per segment), framed
+// in length prefixed records. Fully read segments are removed, and the read offset is
+// synced to the directory from time to time, so the queued events survive a restart
+// (some of the events read before the restart might be read again). Once the policies
+// are set, the events are stored with the names of their matched policies, instead of
+// the bitmaps, and matched again by name when read.
+type eventQueueDisk struct {
+	mutex       *sync.Mutex
+	cond        *sync.Cond
+	dir         string
+	policies    PolicyNames
+	maxSize     int64 // max size of the queued events, in bytes
+	segmentSize int64 // size of the segments, in bytes
+	size        int64 // size of the queued events, in bytes
+	count       int   // number of queued events
+	writeSeq    uint64
+	writeFile   *os.File
+	writer      *bufio.Writer
+	writeOffset int64
+	encBuf      bytes.Buffer
+	encoder     *gob.Encoder // gob stream of the write segment
+	readSeq     uint64
+	readFile    *os.File // read unbuffered, as the write segment grows underneath
+	readOffset  int64
+	decBuf      bytes.Buffer
+	decoder     *gob.Decoder // gob stream of the read segment
+	dequeued    int          // dequeued events since the last read offset sync
+	closed      bool
+	verbose     string
+}
+
+func NewEventQueueDisk(dir string, queueSizeMb int) (CacheConfig, error) {
+	if queueSizeMb <= 0 {
+		queueSizeMb = diskDefaultCacheSizeMB
+	}
+
+	q := &eventQueueDisk{
+		dir:     dir,
+		maxSize: int64(queueSizeMb) * 1024 * 1024,
+	}
+	q.segmentSize = q.maxSize / 8
+	if q.segmentSize > diskMaxSegmentSize {
+		q.segmentSize = diskMaxSegmentSize
+	}
+	if q.segmentSize < diskMinSegmentSize {
+		q.segmentSize = diskMinSegmentSize
+	}
+
+	q.mutex = new(sync.Mutex)
+	q.cond = sync.NewCond(q.mutex)
+
+	if err := q.open(); err != nil {
+		_ = q.closeFiles()
+		return nil, errfmt.WrapError(err)
+	}
+
+	q.verbose = fmt.Sprintf("On-Disk Event Queue (Dir = %s, Size = %d MB)", dir, queueSizeMb)
+	return q, nil
+}
+
+func (q *eventQueueDisk) String() string {
+	return q.verbose
+}
+
+// SetPolicyNames sets the policies translating the events matched policies bitmaps
+func (q *eventQueueDisk) SetPolicyNames(policies PolicyNames) {
+	q.cond.L.Lock()
+	q.policies = policies
+	q.cond.L.Unlock()
+}
+
+// Enqueue pushes an event into the queue (may block until queue is available)
+func (q *eventQueueDisk) Enqueue(evt *trace.Event) {
+	q.cond.L.Lock()
+	// enqueue waits for de-queuing if cache is full
+	for q.count > 0 && q.size >= q.maxSize && !q.closed {
+		q.cond.Wait()
+	}
+	if q.closed {
+		q.cond.L.Unlock()
+		return
+	}
+
+	size, err := q.write(evt)
+	if err != nil {
+		q.cond.L.Unlock()
+		logger.Errorw("Writing event to the disk queue", "error", err)
+		return
+	}
+	q.count++
+	q.size += size
+	q.cond.L.Unlock()
+	q.cond.Signal() // unblock dequeue if needed
+}
+
+// Dequeue pops an event from the queue
+func (q *eventQueueDisk) Dequeue() *trace.Event {
+	q.cond.L.Lock()
+
+	// dequeue waits for en-queueing if cache is empty
+	for q.count == 0 && !q.closed {
+		q.cond.Wait()
+	}
+	if q.closed {
+		q.cond.L.Unlock()
+		return nil
+	}
+
+	event, size, err := q.read()
+	if err != nil {
+		// the queue files were changed underneath: start over with the events enqueued next
+		logger.Errorw("Reading event from the disk queue, dropping the queued events", "error", err)
+		q.reset()
+		q.cond.L.Unlock()
+		q.cond.Signal()
+		return nil
+	}
+	if q.policies != nil {
+		event.MatchedPoliciesKernel = q.policies.MatchedBitmap(event.MatchedPolicies)
+		event.MatchedPolicies = []string{}
+	}
+	q.count--
+	q.size -= size
+	q.cond.L.Unlock()
+	q.cond.Signal() // unblock enqueue if needed
+
+	return event
+}
+
+// Close flushes the queued events and the read offset to disk
+func (q *eventQueueDisk) Close() error {
+	q.cond.L.Lock()
+	defer q.cond.Broadcast() // unblock enqueue and dequeue
+	defer q.cond.L.Unlock()
+
+	if q.closed {
+		return nil
+	}
+	q.closed = true
+
+	var errs []error
+	if err := q.writer.Flush(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := q.syncOffset(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := q.closeFiles(); err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return errfmt.Errorf("closing disk queue: %v", errs)
+	}
+
+	return nil
+}
+
+// open recovers the queued events from the queue directory, if any
+func (q *eventQueueDisk) open() error {
+	if err := os.MkdirAll(q.dir, 0700); err != nil {
+		return err
+	}
+
+	seqs, err := q.segments()
+	if err != nil {
+		return err
+	}
+	q.readSeq, q.readOffset = q.loadOffset()
+
+	// forget about read segments, or about the read offset if its segment is gone
+	first := 0
+	for first < len(seqs) && seqs[first] < q.readSeq {
+		if err := os.Remove(q.segmentPath(seqs[first])); err != nil {
+			return err
+		}
+		first++
+	}
+	seqs = seqs[first:]
+	if len(seqs) == 0 {
+		// nothing queued: start over in a new segment
+		q.readSeq, q.readOffset = q.readSeq+1, 0
+		if err := q.createWriteSegment(q.readSeq); err != nil {
+			return err
+		}
+		return q.openReadSegment()
+	}
+	if seqs[0] != q.readSeq {
+		q.readSeq, q.readOffset = seqs[0], 0
+	}
+
+	// count the queued events, dropping incomplete records (tracker stopped while writing)
+	for _, seq := range seqs {
+		start := int64(0)
+		if seq == q.readSeq {
+			start = q.readOffset
+		}
+		count, end, err := scanDiskSegment(q.segmentPath(seq), start)
+		if err != nil {
+			return err
+		}
+		q.count += count
+		q.size += end - start
+	}
+
+	// each segment is a gob stream, so events are never appended to an existing one
+	q.writeSeq = seqs[len(seqs)-1]
+	if err := q.createWriteSegment(q.writeSeq + 1); err != nil {
+		return err
+	}
+
+	return q.openReadSegment()
+}
+
+// reset drops all the queued events, starting over in a new segment
+func (q *eventQueueDisk) reset() {
+	_ = q.writer.Flush()
+	_ = q.closeFiles()
+
+	seqs, _ := q.segments()
+	for _, seq := range seqs {
+		_ = os.Remove(q.segmentPath(seq))
+	}
+
+	q.readSeq, q.readOffset = q.writeSeq+1, 0
+	q.count, q.size = 0, 0
+
+	err := q.createWriteSegment(q.writeSeq + 1)
+	if err == nil {
+		err = q.openReadSegment()
+	}
+	if err == nil {
+		err = q.syncOffset()
+	}
+	if err != nil {
+		logger.Errorw("Resetting the disk queue", "error", err)
+		q.closed = true
+	}
+}
+
+// write appends an event to the write segment, returning the size of its record
+func (q *eventQueueDisk) write(evt *trace.Event) (int64, error) {
+	if q.writeOffset >= q.segmentSize {
+		if err := q.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	if q.policies != nil {
+		stored := *evt
+		stored.MatchedPolicies = q.policies.MatchedNames(evt.MatchedPoliciesKernel)
+		stored.MatchedPoliciesKernel = 0
+		stored.MatchedPoliciesUser = 0
+		evt = &stored
+	}
+
+	q.encBuf.Reset()
+	q.encBuf.Write(make([]byte, diskRecordHeaderSize))
+	if err := q.encoder.Encode(evt); err != nil {
+		// the gob stream might be broken: go on in a new one
+		if rotateErr := q.rotate(); rotateErr != nil {
+			return 0, rotateErr
+		}
+		return 0, err
+	}
+	record := q.encBuf.Bytes()
+	binary.LittleEndian.PutUint32(record, uint32(len(record)-diskRecordHeaderSize))
+
+	n, err := q.writer.Write(record)
+	q.writeOffset += int64(n)
+
+	return int64(n), err
+}
+
+// rotate starts a new write segment
+func (q *eventQueueDisk) rotate() error {
+	if err := q.writer.Flush(); err != nil {
+		return err
+	}
+	if err := q.writeFile.Close(); err != nil {
+		return err
+	}
+
+	return q.createWriteSegment(q.writeSeq + 1)
+}
+
+func (q *eventQueueDisk) createWriteSegment(seq uint64) error {
+	var err error
+	q.writeFile, err = os.OpenFile(q.segmentPath(seq), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	q.writeSeq = seq
+	q.writeOffset = 0
+	if q.writer == nil {
+		q.writer = bufio.NewWriter(q.writeFile)
+	} else {
+		q.writer.Reset(q.writeFile)
+	}
+	q.encoder = gob.NewEncoder(&q.encBuf)
+
+	return nil
+}
+
+// read returns the next event of the read segments, and the size of its record
+func (q *eventQueueDisk) read() (*trace.Event, int64, error) {
+	for {
+		if q.readSeq == q.writeSeq && q.writer.Buffered() > 0 {
+			if err := q.writer.Flush(); err != nil {
+				return nil, 0, err
+			}
+		}
+
+		event, size, err := q.readRecord()
+		if err == io.EOF && q.readSeq < q.writeSeq {
+			// segment fully read: move to the next one
+			if err := q.readFile.Close(); err != nil {
+				return nil, 0, err
+			}
+			if err := os.Remove(q.segmentPath(q.readSeq)); err != nil {
+				return nil, 0, err
+			}
+			q.readSeq++
+			q.readOffset = 0
+			if err := q.openReadSegment(); err != nil {
+				return nil, 0, err
+			}
+			if err := q.syncOffset(); err != nil {
+				return nil, 0, err
+			}
+			continue
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+
+		q.readOffset += size
+		q.dequeued++
+		if q.dequeued >= diskOffsetSyncEvents {
+			if err := q.syncOffset(); err != nil {
+				logger.Errorw("Syncing the disk queue read offset", "error", err)
+			}
+		}
+
+		return event, size, nil
+	}
+}
+
+// readRecord decodes the next record of the read segment
+func (q *eventQueueDisk) readRecord() (*trace.Event, int64, error) {
+	record, err := readDiskRecord(q.readFile)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var event trace.Event
+	q.decBuf.Reset()
+	q.decBuf.Write(record[diskRecordHeaderSize:])
+	if err := q.decoder.Decode(&event); err != nil {
+		return nil, 0, err
+	}
+
+	return &event, int64(len(record)), nil
+}
+
+// openReadSegment opens the read segment, and goes through its gob stream up to the read
+// offset
+func (q *eventQueueDisk) openReadSegment() error {
+	var err error
+	q.readFile, err = os.Open(q.segmentPath(q.readSeq))
+	if err != nil {
+		return err
+	}
+	q.decoder = gob.NewDecoder(&q.decBuf)
+
+	for offset := int64(0); offset < q.readOffset; {
+		_, size, err := q.readRecord()
+		if err != nil {
+			return err
+		}
+		offset += size
+	}
+
+	return nil
+}
+
+func (q *eventQueueDisk) closeFiles() error {
+	var err error
+	if q.writeFile != nil {
+		if e := q.writeFile.Close(); e != nil {
+			err = e
+		}
+	}
+	if q.readFile != nil {
+		if e := q.readFile.Close(); e != nil {
+			err = e
+		}
+	}
+
+	return err
+}
+
+// syncOffset saves the read segment and offset, so the read events aren't queued again
+// after a restart
+func (q *eventQueueDisk) syncOffset() error {
+	q.dequeued = 0
+
+	tmp := filepath.Join(q.dir, diskOffsetFile+".tmp")
+	data := fmt.Sprintf("%d %d\n", q.readSeq, q.readOffset)
+	if err := os.WriteFile(tmp, []byte(data), 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, filepath.Join(q.dir, diskOffsetFile))
+}
+
+// loadOffset returns the saved read segment and offset (or zero values)
+func (q *eventQueueDisk) loadOffset() (uint64, int64) {
+	data, err := os.ReadFile(filepath.Join(q.dir, diskOffsetFile))
+	if err != nil {
+		return 0, 0
+	}
+
+	var seq uint64
+	var offset int64
+	if _, err := fmt.Sscanf(string(data), "%d %d", &seq, &offset); err != nil || offset < 0 {
+		return 0, 0
+	}
+
+	return seq, offset
+}
+
+// segments returns the sequence numbers of the segments in the queue directory, sorted
+func (q *eventQueueDisk) segments() ([]uint64, error) {
+	entries, err := os.ReadDir(q.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var seqs []uint64
+	for _, entry := range entries {
+		name, found := strings.CutPrefix(entry.Name(), diskSegmentPrefix)
+		if !found || entry.IsDir() {
+			continue
+		}
+		seq, err := strconv.ParseUint(name, 10, 64)
+		if err != nil {
+			continue
+		}
+		seqs = append(seqs, seq)
+	}
+	sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+
+	return seqs, nil
+}
+
+func (q *eventQueueDisk) segmentPath(seq uint64) string {
+	return filepath.Join(q.dir, fmt.Sprintf("%s%020d", diskSegmentPrefix, seq))
+}
+
+// scanDiskSegment counts the complete records of a segment from the given offset,
+// truncating the segment after the last one. It returns the count and the end offset.
+func scanDiskSegment(path string, start int64) (int, int64, error) {
+	f, err := os.OpenFile(path, os.O_RDWR, 0600)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			logger.Errorw("Closing file", "error", err)
+		}
+	}()
+
+	info, err := f.Stat()
+	if err != nil {
+		return 0, 0, err
+	}
+	if start > info.Size() {
+		start = info.Size()
+	}
+	if _, err := f.Seek(start, io.SeekStart); err != nil {
+		return 0, 0, err
+	}
+
+	count := 0
+	end := start
+	reader := bufio.NewReader(f)
+	for {
+		record, err := readDiskRecord(reader)
+		if err != nil {
+			break
+		}
+		count++
+		end += int64(len(record))
+	}
+
+	if end < info.Size() {
+		if err := f.Truncate(end); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return count, end, nil
+}
+
+// readDiskRecord reads a whole record (header included), or returns io.EOF if there is none
+func readDiskRecord(reader io.Reader) ([]byte, error) {
+	var header [diskRecordHeaderSize]byte
+	if _, err := io.ReadFull(reader, header[:]); err != nil {
+		return nil, err // io.EOF if there is no record at all
+	}
+
+	length := int(binary.LittleEndian.Uint32(header[:]))
+	if length == 0 || length > diskMaxSegmentSize {
+		return nil, errfmt.Errorf("invalid record length %d", length)
+	}
+
+	record := make([]byte, diskRecordHeaderSize+length)
+	copy(record, header[:])
+	if _, err := io.ReadFull(reader, record[diskRecordHeaderSize:]); err != nil {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	return record, nil
+}
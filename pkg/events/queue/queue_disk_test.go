@@ -0,0 +1,179 @@
+package queue
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/khulnasoft-lab/tracker/types/trace"
+)
+
+func TestDiskEnqueueDequeue(t *testing.T) {
+	t.Parallel()
+
+	q, err := NewEventQueueDisk(t.TempDir(), 1)
+	require.NoError(t, err)
+	defer q.(*eventQueueDisk).Close()
+
+	// small segments, so the queue goes through many of them
+	q.(*eventQueueDisk).segmentSize = 4096
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 5000; i++ {
+			e := q.Dequeue()
+			if !assert.NotNil(t, e) {
+				return
+			}
+			assert.Equal(t, i, e.Timestamp)
+		}
+	}()
+	for i := 0; i < 5000; i++ {
+		e := trace.Event{Timestamp: i}
+		q.Enqueue(&e)
+	}
+	wg.Wait()
+
+	// read segments are removed
+	segments, err := q.(*eventQueueDisk).segments()
+	require.NoError(t, err)
+	assert.Len(t, segments, 1)
+}
+
+func TestDiskEventArguments(t *testing.T) {
+	t.Parallel()
+
+	q, err := NewEventQueueDisk(t.TempDir(), 1)
+	require.NoError(t, err)
+	defer q.(*eventQueueDisk).Close()
+
+	// argument values as decoded from the eBPF buffers
+	event := trace.Event{
+		Timestamp:             1,
+		HostProcessID:         1000,
+		ProcessName:           "cat",
+		EventName:             "security_file_open",
+		MatchedPoliciesKernel: 0b101,
+		MatchedPoliciesUser:   0b100,
+		Container:             trace.Container{ID: "abc"},
+		Args: []trace.Argument{
+			{ArgMeta: trace.ArgMeta{Name: "pathname", Type: "const char*"}, Value: "/etc/passwd"},
+			{ArgMeta: trace.ArgMeta{Name: "flags", Type: "int"}, Value: int32(1)},
+			{ArgMeta: trace.ArgMeta{Name: "dev", Type: "dev_t"}, Value: uint32(45)},
+			{ArgMeta: trace.ArgMeta{Name: "inode", Type: "unsigned long"}, Value: uint64(4026532041)},
+			{ArgMeta: trace.ArgMeta{Name: "addr", Type: "void*"}, Value: uintptr(0xffff)},
+			{ArgMeta: trace.ArgMeta{Name: "argv", Type: "const char**"}, Value: []string{"cat", "/etc/passwd"}},
+			{ArgMeta: trace.ArgMeta{Name: "buf", Type: "bytes"}, Value: []byte{1, 2, 3}},
+			{ArgMeta: trace.ArgMeta{Name: "remote_addr", Type: "struct sockaddr*"}, Value: map[string]string{"sa_family": "AF_INET"}},
+			{ArgMeta: trace.ArgMeta{Name: "cred", Type: "slim_cred_t"}, Value: trace.SlimCred{Uid: 1000}},
+			{ArgMeta: trace.ArgMeta{Name: "fds", Type: "int[2]"}, Value: [2]int32{3, 4}},
+			{ArgMeta: trace.ArgMeta{Name: "empty", Type: "void*"}, Value: nil},
+		},
+	}
+
+	q.Enqueue(&event)
+	got := q.Dequeue()
+	require.NotNil(t, got)
+	assert.Equal(t, event, *got)
+}
+
+func TestDiskRestart(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	q, err := NewEventQueueDisk(dir, 1)
+	require.NoError(t, err)
+	q.(*eventQueueDisk).segmentSize = 4096
+
+	for i := 0; i < 1000; i++ {
+		e := trace.Event{Timestamp: i}
+		q.Enqueue(&e)
+	}
+	for i := 0; i < 400; i++ {
+		assert.Equal(t, i, q.Dequeue().Timestamp)
+	}
+	require.NoError(t, q.(*eventQueueDisk).Close())
+
+	// tracker stopped while writing an event
+	segments, err := q.(*eventQueueDisk).segments()
+	require.NoError(t, err)
+	last, err := os.OpenFile(q.(*eventQueueDisk).segmentPath(segments[len(segments)-1]), os.O_WRONLY|os.O_APPEND, 0600)
+	require.NoError(t, err)
+	_, err = last.Write([]byte{100, 0, 0, 0, 1, 2})
+	require.NoError(t, err)
+	require.NoError(t, last.Close())
+
+	q, err = NewEventQueueDisk(dir, 1)
+	require.NoError(t, err)
+	defer q.(*eventQueueDisk).Close()
+
+	assert.Equal(t, 600, q.(*eventQueueDisk).count)
+	e := trace.Event{Timestamp: 1000}
+	q.Enqueue(&e)
+	for i := 400; i <= 1000; i++ {
+		assert.Equal(t, i, q.Dequeue().Timestamp)
+	}
+
+	_, err = os.Stat(filepath.Join(dir, diskOffsetFile))
+	assert.NoError(t, err)
+}
+
+// testPolicyNames are policies by position in the matched bitmap
+type testPolicyNames []string
+
+func (p testPolicyNames) MatchedNames(matched uint64) []string {
+	names := []string{}
+	for i, name := range p {
+		if matched&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func (p testPolicyNames) MatchedBitmap(names []string) uint64 {
+	var matched uint64
+	for i, name := range p {
+		for _, n := range names {
+			if n == name {
+				matched |= 1 << i
+			}
+		}
+	}
+	return matched
+}
+
+func TestDiskRestartWithOtherPolicies(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	q, err := NewEventQueueDisk(dir, 1)
+	require.NoError(t, err)
+	q.(*eventQueueDisk).SetPolicyNames(testPolicyNames{"a", "b", "c"})
+
+	e := trace.Event{Timestamp: 1, MatchedPoliciesKernel: 0b011} // a and b
+	q.Enqueue(&e)
+	e = trace.Event{Timestamp: 2, MatchedPoliciesKernel: 0b100} // c
+	q.Enqueue(&e)
+	assert.Equal(t, uint64(0b011), q.Dequeue().MatchedPoliciesKernel)
+	require.NoError(t, q.(*eventQueueDisk).Close())
+
+	// the next run has policy a removed, and the other ones at other positions
+	q, err = NewEventQueueDisk(dir, 1)
+	require.NoError(t, err)
+	defer q.(*eventQueueDisk).Close()
+	q.(*eventQueueDisk).SetPolicyNames(testPolicyNames{"c", "b"})
+
+	event := q.Dequeue()
+	assert.Equal(t, 2, event.Timestamp)
+	assert.Equal(t, uint64(0b01), event.MatchedPoliciesKernel)
+	assert.Empty(t, event.MatchedPolicies)
+}
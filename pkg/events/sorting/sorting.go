@@ -105,6 +105,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/khulnasoft-lab/tracker/pkg/counter"
 	"github.com/khulnasoft-lab/tracker/pkg/errfmt"
 	"github.com/khulnasoft-lab/tracker/pkg/logger"
 	"github.com/khulnasoft-lab/tracker/pkg/utils/environment"
@@ -127,6 +128,7 @@ type EventsChronologicalSorter struct {
 	errorChan                        chan<- error
 	eventsPassingInterval            time.Duration
 	intervalsAmountThresholdForDelay int
+	receivedCount                    *counter.Counter
 }
 
 func InitEventSorter() (*EventsChronologicalSorter, error) {
@@ -142,6 +144,11 @@ func InitEventSorter() (*EventsChronologicalSorter, error) {
 	return &newSorter, nil
 }
 
+// SetReceivedCounter sets a counter to be incremented on each event received by the sorter
+func (sorter *EventsChronologicalSorter) SetReceivedCounter(received *counter.Counter) {
+	sorter.receivedCount = received
+}
+
 func (sorter *EventsChronologicalSorter) StartPipeline(ctx gocontext.Context, in <-chan *trace.Event) (
 	chan *trace.Event, chan error) {
 	out := make(chan *trace.Event, 10000)
@@ -166,6 +173,9 @@ func (sorter *EventsChronologicalSorter) Start(in <-chan *trace.Event, out chan<
 				sorter.sendEvents(out, math.MaxInt64)
 				return
 			}
+			if sorter.receivedCount != nil {
+				_ = sorter.receivedCount.Increment()
+			}
 			sorter.addEvent(newEvent)
 		case <-ticker.C:
 			sorter.updateSavedTimestamps()
@@ -12,16 +12,21 @@ type PolicyInterface interface {
 	GetDescription() string
 	GetScope() []string
 	GetDefaultActions() []string
+	GetRateLimit() string
+	GetDedupWindow() string
 	GetRules() []Rule
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
 type Policy struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
 	// tracker policy spec
 	Spec PolicySpec `json:"spec"`
+	// +optional
+	Status PolicyStatus `json:"status,omitempty"`
 }
 
 func (p Policy) GetName() string {
@@ -40,6 +45,14 @@ func (p Policy) GetDefaultActions() []string {
 	return p.Spec.DefaultActions
 }
 
+func (p Policy) GetRateLimit() string {
+	return p.Spec.RateLimit
+}
+
+func (p Policy) GetDedupWindow() string {
+	return p.Spec.DedupWindow
+}
+
 func (p Policy) GetRules() []Rule {
 	return p.Spec.Rules
 }
@@ -49,7 +62,13 @@ type PolicySpec struct {
 	Scope []string `yaml:"scope" json:"scope"`
 	// +optional
 	DefaultActions []string `yaml:"defaultActions" json:"defaultActions"`
-	Rules          []Rule   `yaml:"rules" json:"rules"`
+	// rate limit (e.g. 100/s) of the rules without their own
+	// +optional
+	RateLimit string `yaml:"rateLimit" json:"rateLimit,omitempty"`
+	// dedup window (e.g. 10s) of the rules without their own
+	// +optional
+	DedupWindow string `yaml:"dedupWindow" json:"dedupWindow,omitempty"`
+	Rules       []Rule `yaml:"rules" json:"rules"`
 }
 
 // Rule is the structure of the rule in the policy file
@@ -59,6 +78,65 @@ type Rule struct {
 	Filters []string `yaml:"filters" json:"filters"`
 	// +optional
 	Actions []string `yaml:"actions" json:"actions"`
+	// maximum events per second (e.g. 100/s), the exceeding ones are dropped
+	// +optional
+	RateLimit string `yaml:"rateLimit" json:"rateLimit,omitempty"`
+	// identical events within the window (e.g. 10s) are dropped
+	// +optional
+	DedupWindow string `yaml:"dedupWindow" json:"dedupWindow,omitempty"`
+	// event data compared to tell identical events apart (all if empty), along with
+	// the event and the process
+	// +optional
+	DedupKeys []string `yaml:"dedupKeys" json:"dedupKeys,omitempty"`
+}
+
+// Policy condition types
+const (
+	// PolicyConditionApplied is true when all tracker nodes run the current generation
+	PolicyConditionApplied = "Applied"
+	// PolicyConditionInvalid is true when the current generation was rejected by tracker
+	PolicyConditionInvalid = "Invalid"
+)
+
+// Reasons of the policy conditions and node states
+const (
+	PolicyReasonApplied = "Applied"
+	PolicyReasonPending = "Pending"
+	PolicyReasonValid   = "Valid"
+	PolicyReasonInvalid = "Invalid"
+	PolicyReasonFailed  = "Failed"
+	// PolicyReasonRestartRequired is reported by nodes restarting tracker to apply a
+	// policy using events that were not being traced
+	PolicyReasonRestartRequired = "RestartRequired"
+)
+
+// PolicyStatus is the observed state of the policy
+type PolicyStatus struct {
+	// ObservedGeneration is the last generation of the policy seen by the operator
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions of the policy (Applied and Invalid)
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// Nodes is the state of the policy in each node running tracker, by node name
+	// +optional
+	Nodes map[string]PolicyNodeStatus `json:"nodes,omitempty"`
+}
+
+// PolicyNodeStatus is the state of the policy in a node running tracker
+type PolicyNodeStatus struct {
+	// ObservedGeneration is the last generation of the policy seen by the node
+	ObservedGeneration int64 `json:"observedGeneration"`
+	// AppliedGeneration is the generation of the policy running in the node
+	// +optional
+	AppliedGeneration int64 `json:"appliedGeneration,omitempty"`
+	// Reason is Applied, Invalid, Failed or RestartRequired
+	Reason string `json:"reason"`
+	// Message tells why the observed generation was not applied
+	// +optional
+	Message string `json:"message"`
+	// LastUpdateTime is when the node last updated its state
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
 }
 
 // +kubebuilder:object:root=true
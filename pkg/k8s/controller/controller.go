@@ -2,9 +2,13 @@ package controller
 
 import (
 	"context"
-	"time"
+	"fmt"
+	"sort"
 
 	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -13,9 +17,10 @@ import (
 	"github.com/khulnasoft-lab/tracker/pkg/k8s/apis/tracker.khulnasoft.com/v1beta1"
 )
 
-// PolicyReconciler is the main controller for the Tracker Policy CRD. It is responsible
-// for updating the Tracker DaemonSet whenever a change is detected in a TrackerPolicy
-// object.
+// PolicyReconciler is the main controller for the Tracker Policy CRD. Tracker pods watch
+// the policies and apply them in place, reporting the state of each policy in their node
+// to the policy status. The PolicyReconciler sums up the nodes states into the policy
+// conditions.
 type PolicyReconciler struct {
 	client.Client
 	Scheme           *runtime.Scheme
@@ -24,16 +29,23 @@ type PolicyReconciler struct {
 }
 
 // +kubebuilder:rbac:groups=tracker.khulnasoft.com,resources=policies,verbs=get;list;watch;
-// +kubebuilder:rbac:groups=apps,resources=daemonsets,verbs=get;list;watch;patch;update;
+// +kubebuilder:rbac:groups=tracker.khulnasoft.com,resources=policies/status,verbs=get;patch;update;
+// +kubebuilder:rbac:groups=apps,resources=daemonsets,verbs=get;list;watch;
 
 // Reconcile is where the reconciliation logic resides. Every time a change is detected in
-// a v1beta1.Policy object, this function will be called. It will update the Tracker
-// DaemonSet, so that the Tracker pods will be restarted with the new policy. It does this
-// by adding a timestamp annotation to the pod template, so that the daemonset controller
-// will rollout a new daemonset ("restarting" the daemonset).
+// a v1beta1.Policy object (including the nodes states written by the Tracker pods), this
+// function will be called. It will set the Applied and Invalid conditions of the policy,
+// comparing the number of nodes running its current generation with the number of nodes
+// scheduled to run the Tracker DaemonSet.
 func (r *PolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 
+	var p v1beta1.Policy
+	if err := r.Get(ctx, req.NamespacedName, &p); err != nil {
+		// nothing to be done for deleted policies, tracker pods drop them by themselves
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
 	var ds appsv1.DaemonSet
 
 	key := client.ObjectKey{
@@ -45,23 +57,88 @@ func (r *PolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		return ctrl.Result{}, err
 	}
 
-	if ds.Spec.Template.Annotations == nil {
-		ds.Spec.Template.Annotations = make(map[string]string)
+	status := p.Status.DeepCopy()
+	status.ObservedGeneration = p.Generation
+	setPolicyConditions(status, p.Generation, ds.Status.DesiredNumberScheduled)
+	if equality.Semantic.DeepEqual(*status, p.Status) {
+		return ctrl.Result{}, nil
 	}
 
-	// we use the same strategy done by kubect rollout restart,
-	// adding a timestamp annotation to the pod template,
-	// so that the daemonset controller will rollout a new daemonset
-	ds.Spec.Template.Annotations["tracker-operator-restarted"] = time.Now().String()
-
-	if err := r.Update(ctx, &ds); err != nil {
-		logger.Error(err, "unable to update daemonset")
+	p.Status = *status
+	if err := r.Status().Update(ctx, &p); err != nil {
+		logger.Error(err, "unable to update policy status")
 		return ctrl.Result{}, err
 	}
 
 	return ctrl.Result{}, nil
 }
 
+// setPolicyConditions sets the Applied and Invalid conditions of a policy from the state
+// of the policy in each node.
+func setPolicyConditions(status *v1beta1.PolicyStatus, generation int64, desiredNodes int32) {
+	nodes := make([]string, 0, len(status.Nodes))
+	for node := range status.Nodes {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+
+	var applied int32
+	var invalid, failed, restarting string
+	for _, node := range nodes {
+		n := status.Nodes[node]
+		if n.AppliedGeneration == generation {
+			applied++
+			continue
+		}
+		if n.ObservedGeneration != generation {
+			continue // node didn't get to the current generation yet
+		}
+		switch n.Reason {
+		case v1beta1.PolicyReasonInvalid:
+			invalid = n.Message
+		case v1beta1.PolicyReasonFailed:
+			failed = fmt.Sprintf("node %s: %s", node, n.Message)
+		case v1beta1.PolicyReasonRestartRequired:
+			restarting = fmt.Sprintf("node %s: %s", node, n.Message)
+		}
+	}
+
+	invalidCondition := metav1.Condition{
+		Type:               v1beta1.PolicyConditionInvalid,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: generation,
+		Reason:             v1beta1.PolicyReasonValid,
+	}
+	if invalid != "" {
+		invalidCondition.Status = metav1.ConditionTrue
+		invalidCondition.Reason = v1beta1.PolicyReasonInvalid
+		invalidCondition.Message = invalid
+	}
+	meta.SetStatusCondition(&status.Conditions, invalidCondition)
+
+	appliedCondition := metav1.Condition{
+		Type:               v1beta1.PolicyConditionApplied,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: generation,
+		Reason:             v1beta1.PolicyReasonPending,
+		Message:            fmt.Sprintf("%d/%d nodes applied generation %d", applied, desiredNodes, generation),
+	}
+	switch {
+	case desiredNodes > 0 && applied >= desiredNodes:
+		appliedCondition.Status = metav1.ConditionTrue
+		appliedCondition.Reason = v1beta1.PolicyReasonApplied
+	case invalid != "":
+		appliedCondition.Reason = v1beta1.PolicyReasonInvalid
+	case failed != "":
+		appliedCondition.Reason = v1beta1.PolicyReasonFailed
+		appliedCondition.Message += ", " + failed
+	case restarting != "":
+		appliedCondition.Reason = v1beta1.PolicyReasonRestartRequired
+		appliedCondition.Message += ", " + restarting
+	}
+	meta.SetStatusCondition(&status.Conditions, appliedCondition)
+}
+
 // SetupWithManager is responsible for connecting the PolicyReconciler to the main
 // controller manager. It tells the manager that for changes in v1beta1Policy objects, the
 // PolicyReconciler should be invoked.
@@ -0,0 +1,163 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/khulnasoft-lab/tracker/pkg/k8s/apis/tracker.khulnasoft.com/v1beta1"
+)
+
+func TestPolicyReconciler_Reconcile(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		nodes           map[string]v1beta1.PolicyNodeStatus
+		expectedApplied metav1.ConditionStatus
+		expectedReason  string
+		expectedInvalid metav1.ConditionStatus
+	}{
+		{
+			name:            "no node reported",
+			expectedApplied: metav1.ConditionFalse,
+			expectedReason:  v1beta1.PolicyReasonPending,
+			expectedInvalid: metav1.ConditionFalse,
+		},
+		{
+			name: "some nodes applied",
+			nodes: map[string]v1beta1.PolicyNodeStatus{
+				"node1": {ObservedGeneration: 2, AppliedGeneration: 2, Reason: v1beta1.PolicyReasonApplied},
+				"node2": {ObservedGeneration: 1, AppliedGeneration: 1, Reason: v1beta1.PolicyReasonApplied},
+			},
+			expectedApplied: metav1.ConditionFalse,
+			expectedReason:  v1beta1.PolicyReasonPending,
+			expectedInvalid: metav1.ConditionFalse,
+		},
+		{
+			name: "all nodes applied",
+			nodes: map[string]v1beta1.PolicyNodeStatus{
+				"node1": {ObservedGeneration: 2, AppliedGeneration: 2, Reason: v1beta1.PolicyReasonApplied},
+				"node2": {ObservedGeneration: 2, AppliedGeneration: 2, Reason: v1beta1.PolicyReasonApplied},
+			},
+			expectedApplied: metav1.ConditionTrue,
+			expectedReason:  v1beta1.PolicyReasonApplied,
+			expectedInvalid: metav1.ConditionFalse,
+		},
+		{
+			name: "invalid",
+			nodes: map[string]v1beta1.PolicyNodeStatus{
+				"node1": {ObservedGeneration: 2, AppliedGeneration: 1, Reason: v1beta1.PolicyReasonInvalid, Message: "event foo is not valid"},
+			},
+			expectedApplied: metav1.ConditionFalse,
+			expectedReason:  v1beta1.PolicyReasonInvalid,
+			expectedInvalid: metav1.ConditionTrue,
+		},
+		{
+			name: "failed in a node",
+			nodes: map[string]v1beta1.PolicyNodeStatus{
+				"node1": {ObservedGeneration: 2, AppliedGeneration: 2, Reason: v1beta1.PolicyReasonApplied},
+				"node2": {ObservedGeneration: 2, AppliedGeneration: 1, Reason: v1beta1.PolicyReasonFailed, Message: "event openat is not being traced"},
+			},
+			expectedApplied: metav1.ConditionFalse,
+			expectedReason:  v1beta1.PolicyReasonFailed,
+			expectedInvalid: metav1.ConditionFalse,
+		},
+		{
+			name: "restarting a node",
+			nodes: map[string]v1beta1.PolicyNodeStatus{
+				"node1": {ObservedGeneration: 2, AppliedGeneration: 2, Reason: v1beta1.PolicyReasonApplied},
+				"node2": {ObservedGeneration: 2, AppliedGeneration: 1, Reason: v1beta1.PolicyReasonRestartRequired, Message: "event openat is not being traced"},
+			},
+			expectedApplied: metav1.ConditionFalse,
+			expectedReason:  v1beta1.PolicyReasonRestartRequired,
+			expectedInvalid: metav1.ConditionFalse,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			scheme := runtime.NewScheme()
+			require.NoError(t, clientgoscheme.AddToScheme(scheme))
+			require.NoError(t, v1beta1.AddToScheme(scheme))
+
+			policy := &v1beta1.Policy{
+				ObjectMeta: metav1.ObjectMeta{Name: "policy", Generation: 2},
+				Status:     v1beta1.PolicyStatus{Nodes: tc.nodes},
+			}
+			ds := &appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "tracker-system", Name: "tracker"},
+				Status:     appsv1.DaemonSetStatus{DesiredNumberScheduled: 2},
+			}
+
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(policy, ds).
+				WithStatusSubresource(policy).
+				Build()
+
+			r := &PolicyReconciler{
+				Client:           c,
+				Scheme:           scheme,
+				TrackerNamespace: "tracker-system",
+				TrackerName:      "tracker",
+			}
+
+			req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "policy"}}
+			_, err := r.Reconcile(context.Background(), req)
+			require.NoError(t, err)
+
+			var got v1beta1.Policy
+			require.NoError(t, c.Get(context.Background(), req.NamespacedName, &got))
+
+			assert.Equal(t, int64(2), got.Status.ObservedGeneration)
+
+			applied := meta.FindStatusCondition(got.Status.Conditions, v1beta1.PolicyConditionApplied)
+			require.NotNil(t, applied)
+			assert.Equal(t, tc.expectedApplied, applied.Status)
+			assert.Equal(t, tc.expectedReason, applied.Reason)
+			assert.Equal(t, int64(2), applied.ObservedGeneration)
+
+			invalid := meta.FindStatusCondition(got.Status.Conditions, v1beta1.PolicyConditionInvalid)
+			require.NotNil(t, invalid)
+			assert.Equal(t, tc.expectedInvalid, invalid.Status)
+
+			// conditions are stable, so reconciling again doesn't update the policy
+			_, err = r.Reconcile(context.Background(), req)
+			require.NoError(t, err)
+
+			var again v1beta1.Policy
+			require.NoError(t, c.Get(context.Background(), req.NamespacedName, &again))
+			assert.Equal(t, got.ResourceVersion, again.ResourceVersion)
+		})
+	}
+}
+
+func TestPolicyReconciler_ReconcileDeleted(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1beta1.AddToScheme(scheme))
+
+	r := &PolicyReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Scheme: scheme,
+	}
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "policy"}})
+	assert.NoError(t, err)
+}
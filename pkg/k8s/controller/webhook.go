@@ -0,0 +1,68 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/khulnasoft-lab/tracker/pkg/k8s/apis/tracker.khulnasoft.com/v1beta1"
+	"github.com/khulnasoft-lab/tracker/pkg/policy/v1beta1/validate"
+)
+
+// PolicyValidator is the admission webhook for the Tracker Policy CRD. It rejects the
+// policies that Tracker would refuse to load, before they are stored in the cluster.
+type PolicyValidator struct{}
+
+// +kubebuilder:webhook:path=/validate-tracker-khulnasoft-com-v1beta1-policy,mutating=false,failurePolicy=fail,sideEffects=None,groups=tracker.khulnasoft.com,resources=policies,verbs=create;update,versions=v1beta1,name=vpolicy.tracker.khulnasoft.com,admissionReviewVersions=v1
+
+// ValidateCreate runs the same checks done for policy files on the created policy
+func (v *PolicyValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return validatePolicy(obj)
+}
+
+// ValidateUpdate runs the same checks done for policy files on the updated policy
+func (v *PolicyValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	return validatePolicy(newObj)
+}
+
+// ValidateDelete allows all policies to be deleted
+func (v *PolicyValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// SetupWithManager registers the PolicyValidator in the webhook server of the main
+// controller manager.
+func (v *PolicyValidator) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&v1beta1.Policy{}).
+		WithValidator(v).
+		Complete()
+}
+
+// validatePolicy validates a policy with the builtin events of tracker. The other events
+// may be defined by signatures, which are only loaded by tracker: they are accepted with a
+// warning, and their data is not validated.
+func validatePolicy(obj runtime.Object) (admission.Warnings, error) {
+	p, ok := obj.(*v1beta1.Policy)
+	if !ok {
+		return nil, fmt.Errorf("expected a Policy, got %T", obj)
+	}
+
+	var warnings admission.Warnings
+	lookup := func(name string) (validate.Event, bool) {
+		if event, ok := validate.BuiltinEvent(name); ok {
+			return event, true
+		}
+		warnings = append(warnings, fmt.Sprintf("event %s is not a builtin event, it must be defined by a signature", name))
+		return validate.Event{Dynamic: true}, true
+	}
+
+	if err := validate.Policy(p, lookup); err != nil {
+		return nil, err
+	}
+
+	return warnings, nil
+}
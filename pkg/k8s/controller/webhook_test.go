@@ -0,0 +1,100 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/khulnasoft-lab/tracker/pkg/k8s/apis/tracker.khulnasoft.com/v1beta1"
+)
+
+func TestPolicyValidator(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		spec             v1beta1.PolicySpec
+		expectedError    string
+		expectedWarnings []string
+	}{
+		{
+			name: "valid",
+			spec: v1beta1.PolicySpec{
+				Scope:          []string{"global"},
+				DefaultActions: []string{"log"},
+				Rules: []v1beta1.Rule{
+					{Event: "openat", Filters: []string{"data.pathname=/etc/shadow"}},
+				},
+			},
+		},
+		{
+			name: "signature event",
+			spec: v1beta1.PolicySpec{
+				Scope: []string{"global"},
+				Rules: []v1beta1.Rule{{Event: "anti_debugging", Filters: []string{"data.syscall=ptrace"}}},
+			},
+			expectedWarnings: []string{"event anti_debugging is not a builtin event, it must be defined by a signature"},
+		},
+		{
+			name: "empty event",
+			spec: v1beta1.PolicySpec{
+				Scope: []string{"global"},
+				Rules: []v1beta1.Rule{{Event: ""}},
+			},
+			expectedError: "policy policy, event cannot be empty",
+		},
+		{
+			name: "event data doesn't exist",
+			spec: v1beta1.PolicySpec{
+				Scope: []string{"global"},
+				Rules: []v1beta1.Rule{{Event: "openat", Filters: []string{"data.path=/etc/shadow"}}},
+			},
+			expectedError: "policy policy, event openat does not have data path",
+		},
+		{
+			name: "invalid filter",
+			spec: v1beta1.PolicySpec{
+				Scope: []string{"global"},
+				Rules: []v1beta1.Rule{{Event: "openat", Filters: []string{"data.pathname"}}},
+			},
+			expectedError: "policy policy, invalid filter operator: data.pathname",
+		},
+		{
+			name: "invalid action",
+			spec: v1beta1.PolicySpec{
+				Scope: []string{"global"},
+				Rules: []v1beta1.Rule{{Event: "openat", Actions: []string{"audit"}}},
+			},
+			expectedError: "policy policy, action audit is not valid",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			v := &PolicyValidator{}
+			p := &v1beta1.Policy{
+				ObjectMeta: metav1.ObjectMeta{Name: "policy"},
+				Spec:       tc.spec,
+			}
+
+			createWarnings, createErr := v.ValidateCreate(context.Background(), p)
+			updateWarnings, updateErr := v.ValidateUpdate(context.Background(), p, p)
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, createErr, tc.expectedError)
+				assert.ErrorContains(t, updateErr, tc.expectedError)
+				return
+			}
+			assert.NoError(t, createErr)
+			assert.NoError(t, updateErr)
+			assert.Equal(t, admission.Warnings(tc.expectedWarnings), createWarnings)
+			assert.Equal(t, admission.Warnings(tc.expectedWarnings), updateWarnings)
+		})
+	}
+}
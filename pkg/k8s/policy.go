@@ -2,9 +2,15 @@ package k8s
 
 import (
 	"context"
+	"encoding/json"
+	"sort"
+	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/rest"
 
 	"github.com/khulnasoft-lab/tracker/pkg/k8s/apis/tracker.khulnasoft.com/v1beta1"
@@ -41,6 +47,151 @@ func New() (*Client, error) {
 }
 
 func (c Client) GetPolicy(ctx context.Context) ([]v1beta1.PolicyInterface, error) {
+	result, err := c.listPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make([]v1beta1.PolicyInterface, len(result.Items))
+	for i, item := range result.Items {
+		policies[i] = item
+	}
+
+	return policies, nil
+}
+
+const (
+	watchRetryBackoff  = time.Second
+	watchMaxRetryDelay = time.Minute
+)
+
+// WatchPolicies calls onChange with all the policies in the cluster, sorted by name,
+// every time a policy is added, changed (including its status) or deleted. Failures
+// to list or watch the policies are given to onError and retried with exponential
+// backoff, so it only returns when the context is done.
+func (c Client) WatchPolicies(ctx context.Context, onChange func([]v1beta1.Policy), onError func(error)) error {
+	attempt := 0
+	retry := func(err error) error {
+		onError(err)
+		attempt++
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(min(watchRetryBackoff<<(attempt-1), watchMaxRetryDelay)):
+			return nil
+		}
+	}
+
+	for {
+		list, err := c.listPolicies(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err := retry(err); err != nil {
+				return err
+			}
+			continue
+		}
+
+		policies := make(map[string]v1beta1.Policy, len(list.Items))
+		for _, p := range list.Items {
+			policies[p.Name] = p
+		}
+		onChange(sortedPolicies(policies))
+
+		w, err := c.restClient.
+			Get().
+			Resource("policies").
+			VersionedParams(&metav1.ListOptions{
+				Watch:           true,
+				ResourceVersion: list.ResourceVersion,
+			}, metav1.ParameterCodec).
+			Watch(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err := retry(err); err != nil {
+				return err
+			}
+			continue
+		}
+		attempt = 0
+
+		err = watchPolicies(ctx, w, policies, onChange)
+		w.Stop()
+		if err != nil {
+			return err
+		}
+		// the watch was closed (or expired), list the policies again to resume it
+	}
+}
+
+func watchPolicies(ctx context.Context, w watch.Interface, policies map[string]v1beta1.Policy, onChange func([]v1beta1.Policy)) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case e, ok := <-w.ResultChan():
+			if !ok {
+				return nil
+			}
+			p, ok := e.Object.(*v1beta1.Policy)
+			switch {
+			case e.Type == watch.Error:
+				return nil
+			case !ok:
+				continue
+			case e.Type == watch.Added, e.Type == watch.Modified:
+				policies[p.Name] = *p
+			case e.Type == watch.Deleted:
+				delete(policies, p.Name)
+			default:
+				continue
+			}
+			onChange(sortedPolicies(policies))
+		}
+	}
+}
+
+func sortedPolicies(policies map[string]v1beta1.Policy) []v1beta1.Policy {
+	sorted := make([]v1beta1.Policy, 0, len(policies))
+	for _, p := range policies {
+		sorted = append(sorted, p)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	return sorted
+}
+
+// UpdatePolicyNodeStatus sets the state of the given policy in the given node, leaving
+// the state of the other nodes untouched.
+func (c Client) UpdatePolicyNodeStatus(ctx context.Context, name, node string, status v1beta1.PolicyNodeStatus) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{
+			"nodes": map[string]v1beta1.PolicyNodeStatus{
+				node: status,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.restClient.
+		Patch(types.MergePatchType).
+		Resource("policies").
+		Name(name).
+		SubResource("status").
+		Body(patch).
+		Do(ctx).
+		Error()
+}
+
+func (c Client) listPolicies(ctx context.Context) (*v1beta1.PolicyList, error) {
 	result := v1beta1.PolicyList{}
 
 	err := c.restClient.
@@ -53,10 +204,5 @@ func (c Client) GetPolicy(ctx context.Context) ([]v1beta1.PolicyInterface, error
 		return nil, err
 	}
 
-	policies := make([]v1beta1.PolicyInterface, len(result.Items))
-	for i, item := range result.Items {
-		policies[i] = item
-	}
-
-	return policies, nil
+	return &result, nil
 }
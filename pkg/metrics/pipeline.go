@@ -0,0 +1,220 @@
+package metrics
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/khulnasoft-lab/tracker/pkg/counter"
+)
+
+// Stage is a stage of the events pipeline
+type Stage int
+
+const (
+	DecodeStage Stage = iota
+	CacheStage
+	SortStage
+	ProcessStage
+	EnrichStage
+	DeriveStage
+	EngineStage
+	SinkStage
+	stagesCount
+)
+
+func (s Stage) String() string {
+	switch s {
+	case DecodeStage:
+		return "decode"
+	case CacheStage:
+		return "cache"
+	case SortStage:
+		return "sort"
+	case ProcessStage:
+		return "process"
+	case EnrichStage:
+		return "enrich"
+	case DeriveStage:
+		return "derive"
+	case EngineStage:
+		return "engine"
+	case SinkStage:
+		return "sink"
+	}
+	return "unknown"
+}
+
+// StageStats counts the events received and dropped by a pipeline stage, and reports the
+// events waiting in its input queue
+type StageStats struct {
+	Received counter.Counter
+	Dropped  counter.Counter // filtered or failed events, not sent down the pipeline
+	queue    atomic.Pointer[stageQueue]
+}
+
+type stageQueue struct {
+	length   func() int
+	capacity int
+}
+
+// SetQueue sets the input queue of the stage, marking the stage as running
+func (s *StageStats) SetQueue(length func() int, capacity int) {
+	s.queue.Store(&stageQueue{length: length, capacity: capacity})
+}
+
+// Running reports whether the stage is part of the pipeline
+func (s *StageStats) Running() bool {
+	return s.queue.Load() != nil
+}
+
+// Queue returns the number of events waiting in the stage input queue, and its capacity
+func (s *StageStats) Queue() (int, int) {
+	q := s.queue.Load()
+	if q == nil {
+		return 0, 0
+	}
+	return q.length(), q.capacity
+}
+
+// EventTypeStats counts the events of a type emitted, filtered and lost by the pipeline
+type EventTypeStats struct {
+	Name     string
+	Emitted  counter.Counter
+	Filtered counter.Counter // dropped by the policies and the rules
+	Lost     counter.Counter // dropped because of errors
+}
+
+// PipelineStats holds the events pipeline metrics. The number of series is bounded by the
+// pipeline stages, the event types defined, and the possible cpus.
+type PipelineStats struct {
+	stages     [stagesCount]StageStats
+	eventTypes sync.Map // event id -> *EventTypeStats
+	lostPerCPU atomic.Pointer[func() []uint64]
+}
+
+// Stage returns the metrics of the given pipeline stage
+func (p *PipelineStats) Stage(stage Stage) *StageStats {
+	return &p.stages[stage]
+}
+
+// Stages returns the stages part of the pipeline, in the pipeline order
+func (p *PipelineStats) Stages() []Stage {
+	stages := []Stage{}
+	for stage := Stage(0); stage < stagesCount; stage++ {
+		if p.stages[stage].Running() {
+			stages = append(stages, stage)
+		}
+	}
+
+	return stages
+}
+
+// EventType returns the metrics of the given event type, creating them on first use
+func (p *PipelineStats) EventType(id int, name string) *EventTypeStats {
+	if stats, ok := p.eventTypes.Load(id); ok {
+		return stats.(*EventTypeStats)
+	}
+	stats, _ := p.eventTypes.LoadOrStore(id, &EventTypeStats{Name: name})
+	return stats.(*EventTypeStats)
+}
+
+// EventTypes returns the metrics of the event types seen by the pipeline, ordered by name
+func (p *PipelineStats) EventTypes() []*EventTypeStats {
+	eventTypes := []*EventTypeStats{}
+	p.eventTypes.Range(func(_, stats any) bool {
+		eventTypes = append(eventTypes, stats.(*EventTypeStats))
+		return true
+	})
+	sort.Slice(eventTypes, func(i, j int) bool { return eventTypes[i].Name < eventTypes[j].Name })
+
+	return eventTypes
+}
+
+// SetLostPerCPU sets the function reading the events lost by the perf buffer on each cpu
+func (p *PipelineStats) SetLostPerCPU(lost func() []uint64) {
+	p.lostPerCPU.Store(&lost)
+}
+
+// LostPerCPU returns the events lost by the perf buffer, indexed by cpu
+func (p *PipelineStats) LostPerCPU() []uint64 {
+	lost := p.lostPerCPU.Load()
+	if lost == nil {
+		return nil
+	}
+	return (*lost)()
+}
+
+var (
+	stageReceivedDesc = prometheus.NewDesc(
+		"tracker_ebpf_pipeline_events_total",
+		"events received by a pipeline stage",
+		[]string{"stage"}, nil,
+	)
+	stageDroppedDesc = prometheus.NewDesc(
+		"tracker_ebpf_pipeline_dropped_total",
+		"events filtered or failed in a pipeline stage",
+		[]string{"stage"}, nil,
+	)
+	stageQueueLengthDesc = prometheus.NewDesc(
+		"tracker_ebpf_pipeline_queue_length",
+		"events waiting in the input queue of a pipeline stage",
+		[]string{"stage"}, nil,
+	)
+	stageQueueCapacityDesc = prometheus.NewDesc(
+		"tracker_ebpf_pipeline_queue_capacity",
+		"capacity of the input queue of a pipeline stage",
+		[]string{"stage"}, nil,
+	)
+	eventTypeDesc = prometheus.NewDesc(
+		"tracker_ebpf_event_type_total",
+		"events of a type emitted, filtered by the policies and rules, or lost because of errors",
+		[]string{"event", "result"}, nil,
+	)
+	lostPerCPUDesc = prometheus.NewDesc(
+		"tracker_ebpf_cpu_lostevents_total",
+		"events lost in the submission buffer of a cpu",
+		[]string{"cpu"}, nil,
+	)
+)
+
+// Describe implements prometheus.Collector
+func (p *PipelineStats) Describe(ch chan<- *prometheus.Desc) {
+	ch <- stageReceivedDesc
+	ch <- stageDroppedDesc
+	ch <- stageQueueLengthDesc
+	ch <- stageQueueCapacityDesc
+	ch <- eventTypeDesc
+	ch <- lostPerCPUDesc
+}
+
+// Collect implements prometheus.Collector, only reporting the running stages
+func (p *PipelineStats) Collect(ch chan<- prometheus.Metric) {
+	for _, stage := range p.Stages() {
+		stats := p.Stage(stage)
+		name := stage.String()
+		length, capacity := stats.Queue()
+		ch <- prometheus.MustNewConstMetric(stageReceivedDesc, prometheus.CounterValue, float64(stats.Received.Get()), name)
+		ch <- prometheus.MustNewConstMetric(stageDroppedDesc, prometheus.CounterValue, float64(stats.Dropped.Get()), name)
+		ch <- prometheus.MustNewConstMetric(stageQueueLengthDesc, prometheus.GaugeValue, float64(length), name)
+		ch <- prometheus.MustNewConstMetric(stageQueueCapacityDesc, prometheus.GaugeValue, float64(capacity), name)
+	}
+
+	for _, stats := range p.EventTypes() {
+		ch <- prometheus.MustNewConstMetric(eventTypeDesc, prometheus.CounterValue, float64(stats.Emitted.Get()), stats.Name, "emitted")
+		ch <- prometheus.MustNewConstMetric(eventTypeDesc, prometheus.CounterValue, float64(stats.Filtered.Get()), stats.Name, "filtered")
+		ch <- prometheus.MustNewConstMetric(eventTypeDesc, prometheus.CounterValue, float64(stats.Lost.Get()), stats.Name, "lost")
+	}
+
+	for cpu, lost := range p.LostPerCPU() {
+		ch <- prometheus.MustNewConstMetric(lostPerCPUDesc, prometheus.CounterValue, float64(lost), strconv.Itoa(cpu))
+	}
+}
+
+// RegisterPrometheus registers the pipeline metrics to the prometheus metrics exporter
+func (p *PipelineStats) RegisterPrometheus() error {
+	return prometheus.Register(p)
+}
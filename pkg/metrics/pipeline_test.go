@@ -0,0 +1,52 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipelineStats(t *testing.T) {
+	t.Parallel()
+
+	var p PipelineStats
+
+	queue := make(chan int, 10)
+	queue <- 1
+	p.Stage(SinkStage).SetQueue(func() int { return len(queue) }, cap(queue))
+	p.Stage(DecodeStage).SetQueue(func() int { return 0 }, 1000)
+	_ = p.Stage(SinkStage).Received.Increment(3)
+	_ = p.Stage(SinkStage).Dropped.Increment()
+
+	assert.Equal(t, []Stage{DecodeStage, SinkStage}, p.Stages())
+	length, capacity := p.Stage(SinkStage).Queue()
+	assert.Equal(t, 1, length)
+	assert.Equal(t, 10, capacity)
+	length, capacity = p.Stage(SortStage).Queue()
+	assert.Zero(t, length)
+	assert.Zero(t, capacity)
+
+	_ = p.EventType(2, "openat").Emitted.Increment()
+	_ = p.EventType(1, "execve").Filtered.Increment()
+	_ = p.EventType(2, "openat").Lost.Increment()
+
+	eventTypes := p.EventTypes()
+	require.Len(t, eventTypes, 2)
+	assert.Equal(t, "execve", eventTypes[0].Name)
+	assert.Equal(t, uint64(1), eventTypes[0].Filtered.Get())
+	assert.Equal(t, "openat", eventTypes[1].Name)
+	assert.Equal(t, uint64(1), eventTypes[1].Emitted.Get())
+	assert.Equal(t, uint64(1), eventTypes[1].Lost.Get())
+
+	assert.Nil(t, p.LostPerCPU())
+	p.SetLostPerCPU(func() []uint64 { return []uint64{0, 5} })
+	assert.Equal(t, []uint64{0, 5}, p.LostPerCPU())
+
+	// 4 series per running stage, 3 per event type and 1 per cpu
+	ch := make(chan prometheus.Metric, 100)
+	p.Collect(ch)
+	close(ch)
+	assert.Len(t, ch, 2*4+2*3+2)
+}
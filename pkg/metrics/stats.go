@@ -18,6 +18,19 @@ type Stats struct {
 	LostWrCount      counter.Counter
 	LostNtCapCount   counter.Counter // lost network capture events
 	LostBPFLogsCount counter.Counter
+	ReloadCount      counter.Counter // policies and signatures reloads
+	ReloadErrorCount counter.Counter // policies and signatures reloads that failed
+	// rule actions
+	WebhookActionCount counter.Counter
+	CaptureActionCount counter.Counter
+	SignalActionCount  counter.Counter
+	RateLimitedCount   counter.Counter // events dropped by rate-limit actions
+	DedupCount         counter.Counter // events dropped by dedup actions
+	ActionErrorCount   counter.Counter
+	// webhook output
+	WebhookOutputDelivered counter.Counter
+	WebhookOutputFailed    counter.Counter // events that couldn't be delivered
+	WebhookOutputDropped   counter.Counter // events dropped because the queue was full
 }
 
 // Register Stats to prometheus metrics exporter
@@ -92,6 +105,76 @@ func (stats *Stats) RegisterPrometheus() error {
 		return errfmt.WrapError(err)
 	}
 
+	err = prometheus.Register(prometheus.NewCounterFunc(prometheus.CounterOpts{
+		Namespace: "tracker_ebpf",
+		Name:      "reloads_total",
+		Help:      "policies and signatures reloads",
+	}, func() float64 { return float64(stats.ReloadCount.Get()) }))
+
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+
+	err = prometheus.Register(prometheus.NewCounterFunc(prometheus.CounterOpts{
+		Namespace: "tracker_ebpf",
+		Name:      "reload_errors_total",
+		Help:      "policies and signatures reloads that failed, keeping the running configuration",
+	}, func() float64 { return float64(stats.ReloadErrorCount.Get()) }))
+
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+
+	actions := map[string]*counter.Counter{
+		"webhook":    &stats.WebhookActionCount,
+		"capture":    &stats.CaptureActionCount,
+		"signal":     &stats.SignalActionCount,
+		"rate-limit": &stats.RateLimitedCount,
+		"dedup":      &stats.DedupCount,
+	}
+	for action, count := range actions {
+		count := count
+		err = prometheus.Register(prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace:   "tracker_ebpf",
+			Name:        "rule_actions_total",
+			Help:        "rule actions run by tracker-ebpf (rate-limit and dedup count the dropped events)",
+			ConstLabels: prometheus.Labels{"action": action},
+		}, func() float64 { return float64(count.Get()) }))
+
+		if err != nil {
+			return errfmt.WrapError(err)
+		}
+	}
+
+	err = prometheus.Register(prometheus.NewCounterFunc(prometheus.CounterOpts{
+		Namespace: "tracker_ebpf",
+		Name:      "rule_action_errors_total",
+		Help:      "rule actions that failed",
+	}, func() float64 { return float64(stats.ActionErrorCount.Get()) }))
+
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+
+	webhookOutput := map[string]*counter.Counter{
+		"delivered": &stats.WebhookOutputDelivered,
+		"failed":    &stats.WebhookOutputFailed,
+		"dropped":   &stats.WebhookOutputDropped,
+	}
+	for result, count := range webhookOutput {
+		count := count
+		err = prometheus.Register(prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace:   "tracker_ebpf",
+			Name:        "webhook_output_events_total",
+			Help:        "events sent to webhook outputs, by result",
+			ConstLabels: prometheus.Labels{"result": result},
+		}, func() float64 { return float64(count.Get()) }))
+
+		if err != nil {
+			return errfmt.WrapError(err)
+		}
+	}
+
 	err = prometheus.Register(prometheus.NewCounterFunc(prometheus.CounterOpts{
 		Namespace: "tracker_ebpf",
 		Name:      "errors_total",
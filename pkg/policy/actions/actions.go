@@ -0,0 +1,191 @@
+// Package actions parses the actions of the policy rules, the responses to the events
+// matched by the rules.
+package actions
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// Kind is the kind of response to the events matched by a policy rule
+type Kind string
+
+const (
+	Log       Kind = "log"        // send the event to the outputs (default)
+	Print     Kind = "print"      // same as log
+	Webhook   Kind = "webhook"    // post the event to the given url
+	Capture   Kind = "capture"    // capture the executable of the process
+	Signal    Kind = "signal"     // send the given signal to the process
+	RateLimit Kind = "rate-limit" // drop the events above the given rate (or rule rateLimit)
+	Dedup     Kind = "dedup"      // drop the identical events within the given window (rule dedupWindow)
+)
+
+// Action is a response to the events matched by a policy rule, given as one of:
+//
+//	log
+//	print
+//	webhook=http://localhost:8080/events
+//	capture
+//	signal=SIGKILL
+//	rate-limit=100/s
+//
+// Rate limits can also be given by the rule fields, and dedups only by them, see
+// ParseThrottle.
+type Action struct {
+	Kind   Kind
+	URL    string         // webhook url
+	Signal syscall.Signal // signal to be sent
+	Rate   int            // maximum events per second
+	Window time.Duration  // dedup window
+	Keys   []string       // event data compared by the dedup (all if empty)
+}
+
+// IsOutput returns true if the action only sends the event to the outputs
+func (a Action) IsOutput() bool {
+	return a.Kind == Log || a.Kind == Print
+}
+
+func (a Action) String() string {
+	switch a.Kind {
+	case Webhook:
+		return fmt.Sprintf("%s=%s", a.Kind, a.URL)
+	case Signal:
+		return fmt.Sprintf("%s=%s", a.Kind, unix.SignalName(a.Signal))
+	case RateLimit:
+		return fmt.Sprintf("%s=%d/s", a.Kind, a.Rate)
+	case Dedup:
+		return fmt.Sprintf("%s=%s", a.Kind, a.Window)
+	}
+
+	return string(a.Kind)
+}
+
+// Parse parses an action of a policy rule
+func Parse(action string) (Action, error) {
+	kind, value, hasValue := strings.Cut(action, "=")
+	a := Action{Kind: Kind(kind)}
+
+	switch a.Kind {
+	case Log, Print, Capture:
+		if hasValue {
+			return a, invalidError(action, "no value expected")
+		}
+		return a, nil
+
+	case Webhook:
+		u, err := url.ParseRequestURI(value)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return a, invalidError(action, "an http or https url is expected")
+		}
+		a.URL = value
+		return a, nil
+
+	case Signal:
+		name := strings.ToUpper(value)
+		if !strings.HasPrefix(name, "SIG") {
+			name = "SIG" + name
+		}
+		a.Signal = unix.SignalNum(name)
+		if a.Signal == 0 {
+			return a, invalidError(action, "a signal name (e.g. SIGKILL) is expected")
+		}
+		return a, nil
+
+	case RateLimit:
+		rate, ok := parseRate(value)
+		if !ok {
+			return a, invalidError(action, "a rate of events per second (e.g. 100/s) is expected")
+		}
+		a.Rate = rate
+		return a, nil
+
+	case Dedup:
+		return a, fmt.Errorf("action %s is not valid: use the dedupWindow field of the rule", action)
+	}
+
+	return a, unknownError(action)
+}
+
+// ParseAll parses the actions of a policy rule
+func ParseAll(actions []string) ([]Action, error) {
+	parsed := make([]Action, 0, len(actions))
+	for _, action := range actions {
+		a, err := Parse(action)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, a)
+	}
+
+	return parsed, nil
+}
+
+// RuleRateLimit returns the rate limit field applying to a policy rule with the given
+// actions: the rule one, or else the policy one. A rate-limit action replaces them, so it
+// can't be given along with the rule field.
+func RuleRateLimit(actions []Action, ruleRateLimit, policyRateLimit string) (string, error) {
+	for _, a := range actions {
+		if a.Kind != RateLimit {
+			continue
+		}
+		if ruleRateLimit != "" {
+			return "", fmt.Errorf("rate limit %s is given by both the rateLimit field and the %s action", ruleRateLimit, a)
+		}
+		return "", nil
+	}
+
+	if ruleRateLimit != "" {
+		return ruleRateLimit, nil
+	}
+
+	return policyRateLimit, nil
+}
+
+// ParseThrottle parses the rate limit and dedup window of a policy rule (given by
+// the rule fields) into the equivalent actions.
+func ParseThrottle(rateLimit, dedupWindow string, dedupKeys []string) ([]Action, error) {
+	var actions []Action
+
+	if rateLimit != "" {
+		rate, ok := parseRate(rateLimit)
+		if !ok {
+			return nil, fmt.Errorf("rate limit %s is not valid: a rate of events per second (e.g. 100/s) is expected", rateLimit)
+		}
+		actions = append(actions, Action{Kind: RateLimit, Rate: rate})
+	}
+
+	if dedupWindow != "" {
+		window, err := time.ParseDuration(dedupWindow)
+		if err != nil || window <= 0 {
+			return nil, fmt.Errorf("dedup window %s is not valid: a duration (e.g. 10s) is expected", dedupWindow)
+		}
+		actions = append(actions, Action{Kind: Dedup, Window: window, Keys: dedupKeys})
+	}
+
+	return actions, nil
+}
+
+// parseRate parses a rate of events per second (e.g. 100/s)
+func parseRate(value string) (int, bool) {
+	count, unit, _ := strings.Cut(value, "/")
+	rate, err := strconv.Atoi(count)
+	if err != nil || rate <= 0 || unit != "s" {
+		return 0, false
+	}
+
+	return rate, true
+}
+
+func unknownError(action string) error {
+	return fmt.Errorf("action %s is not valid", action)
+}
+
+func invalidError(action, reason string) error {
+	return fmt.Errorf("action %s is not valid: %s", action, reason)
+}
@@ -0,0 +1,110 @@
+package actions
+
+import (
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		action        string
+		expected      Action
+		expectedError string
+	}{
+		{action: "log", expected: Action{Kind: Log}},
+		{action: "print", expected: Action{Kind: Print}},
+		{action: "capture", expected: Action{Kind: Capture}},
+		{action: "capture=all", expectedError: "action capture=all is not valid: no value expected"},
+		{action: "webhook=http://localhost:8080/events", expected: Action{Kind: Webhook, URL: "http://localhost:8080/events"}},
+		{action: "webhook=localhost:8080", expectedError: "action webhook=localhost:8080 is not valid: an http or https url is expected"},
+		{action: "webhook", expectedError: "action webhook is not valid: an http or https url is expected"},
+		{action: "signal=SIGKILL", expected: Action{Kind: Signal, Signal: syscall.SIGKILL}},
+		{action: "signal=term", expected: Action{Kind: Signal, Signal: syscall.SIGTERM}},
+		{action: "signal=SIGFOO", expectedError: "action signal=SIGFOO is not valid: a signal name (e.g. SIGKILL) is expected"},
+		{action: "rate-limit=100/s", expected: Action{Kind: RateLimit, Rate: 100}},
+		{action: "rate-limit=100", expectedError: "action rate-limit=100 is not valid: a rate of events per second (e.g. 100/s) is expected"},
+		{action: "rate-limit=0/s", expectedError: "action rate-limit=0/s is not valid: a rate of events per second (e.g. 100/s) is expected"},
+		{action: "dedup=10s", expectedError: "action dedup=10s is not valid: use the dedupWindow field of the rule"},
+		{action: "audit", expectedError: "action audit is not valid"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.action, func(t *testing.T) {
+			t.Parallel()
+
+			a, err := Parse(tc.action)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, a)
+		})
+	}
+}
+
+func TestActionString(t *testing.T) {
+	t.Parallel()
+
+	for _, action := range []string{"log", "capture", "webhook=https://example.com/hook", "signal=SIGKILL", "rate-limit=100/s"} {
+		a, err := Parse(action)
+		require.NoError(t, err)
+		assert.Equal(t, action, a.String())
+	}
+}
+
+func TestParseThrottle(t *testing.T) {
+	t.Parallel()
+
+	actions, err := ParseThrottle("", "", nil)
+	require.NoError(t, err)
+	assert.Empty(t, actions)
+
+	actions, err = ParseThrottle("10/s", "30s", []string{"pathname"})
+	require.NoError(t, err)
+	assert.Equal(t, []Action{
+		{Kind: RateLimit, Rate: 10},
+		{Kind: Dedup, Window: 30 * time.Second, Keys: []string{"pathname"}},
+	}, actions)
+
+	_, err = ParseThrottle("10", "", nil)
+	assert.EqualError(t, err, "rate limit 10 is not valid: a rate of events per second (e.g. 100/s) is expected")
+
+	_, err = ParseThrottle("0/s", "", nil)
+	assert.EqualError(t, err, "rate limit 0/s is not valid: a rate of events per second (e.g. 100/s) is expected")
+
+	_, err = ParseThrottle("", "10", nil)
+	assert.EqualError(t, err, "dedup window 10 is not valid: a duration (e.g. 10s) is expected")
+
+	actions, err = ParseThrottle("5/s", "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "rate-limit=5/s", actions[0].String())
+}
+
+func TestRuleRateLimit(t *testing.T) {
+	t.Parallel()
+
+	rateLimit, err := RuleRateLimit(nil, "10/s", "100/s")
+	require.NoError(t, err)
+	assert.Equal(t, "10/s", rateLimit)
+
+	rateLimit, err = RuleRateLimit([]Action{{Kind: Log}}, "", "100/s")
+	require.NoError(t, err)
+	assert.Equal(t, "100/s", rateLimit)
+
+	// the action replaces the policy rate limit
+	rateLimit, err = RuleRateLimit([]Action{{Kind: RateLimit, Rate: 5}}, "", "100/s")
+	require.NoError(t, err)
+	assert.Empty(t, rateLimit)
+
+	_, err = RuleRateLimit([]Action{{Kind: RateLimit, Rate: 5}}, "10/s", "")
+	assert.EqualError(t, err, "rate limit 10/s is given by both the rateLimit field and the rate-limit=5/s action")
+}
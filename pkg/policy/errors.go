@@ -1,9 +1,13 @@
 package policy
 
 import (
+	"errors"
 	"fmt"
 )
 
+// ErrPolicyNotFound is wrapped by the errors of the policies not found
+var ErrPolicyNotFound = errors.New("policy not found")
+
 func PolicyNilError() error {
 	return fmt.Errorf("policy cannot be nil")
 }
@@ -21,9 +25,9 @@ func PolicyAlreadyExistsError(name string, idx int) error {
 }
 
 func PolicyNotFoundByIDError(idx int) error {
-	return fmt.Errorf("policy not found at index [%d]", idx)
+	return fmt.Errorf("%w at index [%d]", ErrPolicyNotFound, idx)
 }
 
 func PolicyNotFoundByNameError(name string) error {
-	return fmt.Errorf("policy [%s] not found", name)
+	return fmt.Errorf("%w: [%s]", ErrPolicyNotFound, name)
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/khulnasoft-lab/tracker/pkg/events"
 	"github.com/khulnasoft-lab/tracker/pkg/filters"
 	"github.com/khulnasoft-lab/tracker/pkg/logger"
+	"github.com/khulnasoft-lab/tracker/pkg/policy/actions"
 	"github.com/khulnasoft-lab/tracker/pkg/utils"
 )
 
@@ -43,6 +44,7 @@ type Policies struct {
 	pidFilterableInUserland bool
 	filterableInUserland    uint64 // bitmap of policies that must be filtered in userland
 	containerFiltersEnabled uint64 // bitmap of policies that have at least one container filter type enabled
+	withActions             uint64 // bitmap of policies that have at least one rule action (other than output)
 }
 
 func NewPolicies() *Policies {
@@ -63,6 +65,7 @@ func NewPolicies() *Policies {
 		pidFilterableInUserland: false,
 		filterableInUserland:    0,
 		containerFiltersEnabled: 0,
+		withActions:             0,
 	}
 }
 
@@ -231,6 +234,58 @@ func (ps *Policies) MatchedNames(matched uint64) []string {
 	return names
 }
 
+// MatchedBitmap returns the matched bitmap of the given policies names, ignoring the
+// names of policies that don't exist.
+func (ps *Policies) MatchedBitmap(names []string) uint64 {
+	ps.rwmu.RLock()
+	defer ps.rwmu.RUnlock()
+
+	var matched uint64
+
+	for _, name := range names {
+		if p, ok := ps.policiesMapByName[name]; ok {
+			utils.SetBit(&matched, uint(p.ID))
+		}
+	}
+
+	return matched
+}
+
+// RuleActions are the actions of the rule of a matched policy
+type RuleActions struct {
+	PolicyID   int
+	PolicyName string
+	Actions    []actions.Action
+}
+
+// MatchedActions returns the actions of the given rule in the matched policies,
+// based on the given matched bitmap.
+func (ps *Policies) MatchedActions(matched uint64, ruleId events.ID) []RuleActions {
+	ps.rwmu.RLock()
+	defer ps.rwmu.RUnlock()
+
+	matched &= ps.withActions
+	if matched == 0 {
+		return nil
+	}
+
+	var ruleActions []RuleActions
+	for _, p := range ps.allFromArray() {
+		if p == nil || !utils.HasBit(matched, uint(p.ID)) {
+			continue
+		}
+		if actions := p.Actions[ruleId]; len(actions) > 0 {
+			ruleActions = append(ruleActions, RuleActions{
+				PolicyID:   p.ID,
+				PolicyName: p.Name,
+				Actions:    actions,
+			})
+		}
+	}
+
+	return ruleActions
+}
+
 // Clone returns a deep copy of Policies.
 func (ps *Policies) Clone() *Policies {
 	if ps == nil {
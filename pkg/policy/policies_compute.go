@@ -13,6 +13,7 @@ func (ps *Policies) compute() {
 	ps.calculateGlobalMinMax()
 	ps.updateContainerFilterEnabled()
 	ps.updateUserlandPolicies()
+	ps.updateWithActions()
 }
 
 // calculateGlobalMinMax sets the global min and max, to be checked in kernel,
@@ -139,3 +140,14 @@ func (ps *Policies) updateUserlandPolicies() {
 
 	ps.userlandPolicies = userlandList
 }
+
+// updateWithActions sets the bitmap of policies with rule actions.
+func (ps *Policies) updateWithActions() {
+	ps.withActions = 0
+
+	for _, p := range ps.allFromMap() {
+		if len(p.Actions) > 0 {
+			utils.SetBit(&ps.withActions, uint(p.ID))
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/khulnasoft-lab/tracker/pkg/events"
@@ -85,3 +86,19 @@ func TestPoliciesClone(t *testing.T) {
 		t.Errorf("Changes to copied policy affected the original: %+v", policies)
 	}
 }
+
+func TestPoliciesMatchedBitmap(t *testing.T) {
+	t.Parallel()
+
+	policies := NewPolicies()
+	for i, name := range []string{"a", "b", "c"} {
+		p := NewPolicy()
+		p.ID = i * 2
+		p.Name = name
+		require.NoError(t, policies.Set(p))
+	}
+
+	matched := policies.MatchedBitmap([]string{"a", "c", "removed"})
+	assert.Equal(t, uint64(0b10001), matched)
+	assert.ElementsMatch(t, []string{"a", "c"}, policies.MatchedNames(matched))
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/khulnasoft-lab/tracker/pkg/events"
 	"github.com/khulnasoft-lab/tracker/pkg/filters"
+	"github.com/khulnasoft-lab/tracker/pkg/policy/actions"
 	"github.com/khulnasoft-lab/tracker/pkg/utils"
 )
 
@@ -28,6 +29,7 @@ type Policy struct {
 	ProcessTreeFilter *filters.ProcessTreeFilter
 	BinaryFilter      *filters.BinaryFilter
 	Follow            bool
+	Actions           map[events.ID][]actions.Action // rule actions, other than the output ones
 }
 
 // Compile-time check to ensure that Policy implements the Cloner interface
@@ -54,6 +56,7 @@ func NewPolicy() *Policy {
 		ProcessTreeFilter: filters.NewProcessTreeFilter(),
 		BinaryFilter:      filters.NewBinaryFilter(),
 		Follow:            false,
+		Actions:           map[events.ID][]actions.Action{},
 	}
 }
 
@@ -90,6 +93,9 @@ func (p *Policy) Clone() *Policy {
 	n.ProcessTreeFilter = p.ProcessTreeFilter.Clone()
 	n.BinaryFilter = p.BinaryFilter.Clone()
 	n.Follow = p.Follow
+	for id, ruleActions := range p.Actions {
+		n.Actions[id] = append([]actions.Action{}, ruleActions...)
+	}
 
 	return n
 }
@@ -67,6 +67,19 @@ func (pm *PolicyManager) isRuleEnabled(matchedPolicies uint64, ruleId events.ID)
 	return state.policyMask&matchedPolicies != 0
 }
 
+// MatchedRulePolicies returns the matched policies which have the given rule enabled
+func (pm *PolicyManager) MatchedRulePolicies(matchedPolicies uint64, ruleId events.ID) uint64 {
+	pm.mu.RLock()
+	defer pm.mu.RUnlock()
+
+	state, ok := pm.rules[ruleId]
+	if !ok {
+		return 0
+	}
+
+	return state.policyMask & matchedPolicies
+}
+
 // IsEventEnabled returns true if a given event policy is enabled for a given rule
 func (pm *PolicyManager) IsEventEnabled(evenId events.ID) bool {
 	pm.mu.RLock()
@@ -147,6 +160,33 @@ func (pm *PolicyManager) DisableEvent(eventId events.ID) {
 	state.enabled = false
 }
 
+// ReplacePolicies replaces the managed policies, enabling the rules of the events
+// traced by each of the new policies. Rules enabled for the old policies are reset,
+// while events enabled or disabled globally keep their state.
+func (pm *PolicyManager) ReplacePolicies(ps *Policies) {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+
+	for _, state := range pm.rules {
+		state.policyMask = 0
+	}
+
+	for it := ps.CreateAllIterator(); it.HasNext(); {
+		p := it.Next()
+		for ruleId := range p.EventsToTrace {
+			state, ok := pm.rules[ruleId]
+			if !ok {
+				state = &eventState{enabled: true}
+				pm.rules[ruleId] = state
+			}
+
+			utils.SetBit(&state.policyMask, uint(p.ID))
+		}
+	}
+
+	pm.policies = ps
+}
+
 //
 // Policies methods made available by PolicyManager.
 // Some are transitive (tidying), some are not.
@@ -193,6 +233,20 @@ func (pm *PolicyManager) MatchedNames(matched uint64) []string {
 	return pm.policies.MatchedNames(matched)
 }
 
+func (pm *PolicyManager) MatchedBitmap(names []string) uint64 {
+	pm.mu.RLock()
+	defer pm.mu.RUnlock()
+
+	return pm.policies.MatchedBitmap(names)
+}
+
+func (pm *PolicyManager) MatchedActions(matched uint64, ruleId events.ID) []RuleActions {
+	pm.mu.RLock()
+	defer pm.mu.RUnlock()
+
+	return pm.policies.MatchedActions(matched, ruleId)
+}
+
 func (pm *PolicyManager) LookupByName(name string) (*Policy, error) {
 	pm.mu.RLock()
 	defer pm.mu.RUnlock()
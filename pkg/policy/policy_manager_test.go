@@ -272,3 +272,41 @@ func TestPolicyManagerIsEnabled(t *testing.T) {
 	assert.True(t, policyManager.IsEnabled(policy2Mached, events.SecurityBPF))
 	assert.True(t, policyManager.IsEnabled(policy1And2Mached, events.SecurityBPF))
 }
+
+func TestPolicyManagerReplacePolicies(t *testing.T) {
+	t.Parallel()
+
+	policyManager := NewPolicyManager(nil)
+
+	policyManager.EnableRule(0, events.SecurityBPF)
+	policyManager.DisableEvent(events.Ptrace)
+
+	p1 := NewPolicy()
+	p1.Name = "p1"
+	p1.EventsToTrace[events.Ptrace] = "ptrace"
+	p2 := NewPolicy()
+	p2.Name = "p2"
+	p2.EventsToTrace[events.Ptrace] = "ptrace"
+	p2.EventsToTrace[events.SecurityFileOpen] = "security_file_open"
+
+	ps := NewPolicies()
+	assert.NoError(t, ps.Add(p1))
+	assert.NoError(t, ps.Add(p2))
+
+	policyManager.ReplacePolicies(ps)
+
+	// rules of the old policies are reset
+	assert.False(t, policyManager.IsRuleEnabled(PolicyAll, events.SecurityBPF))
+
+	// rules of the new policies are enabled
+	assert.Equal(t, uint64(0b11), policyManager.MatchedRulePolicies(PolicyAll, events.Ptrace))
+	assert.Equal(t, uint64(0b10), policyManager.MatchedRulePolicies(PolicyAll, events.SecurityFileOpen))
+	assert.Equal(t, uint64(0b10), policyManager.MatchedRulePolicies(0b10, events.Ptrace))
+
+	// globally disabled events remain disabled
+	assert.False(t, policyManager.IsEnabled(PolicyAll, events.Ptrace))
+	assert.True(t, policyManager.IsEnabled(PolicyAll, events.SecurityFileOpen))
+
+	_, err := policyManager.LookupByName("p2")
+	assert.NoError(t, err)
+}
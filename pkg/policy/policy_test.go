@@ -7,14 +7,17 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/require"
 
+	"github.com/khulnasoft-lab/tracker/pkg/events"
 	"github.com/khulnasoft-lab/tracker/pkg/filters"
 	"github.com/khulnasoft-lab/tracker/pkg/filters/sets"
+	"github.com/khulnasoft-lab/tracker/pkg/policy/actions"
 )
 
 func TestPolicyClone(t *testing.T) {
 	policy := NewPolicy()
 	err := policy.PIDFilter.Parse("=1")
 	require.NoError(t, err)
+	policy.Actions[events.ID(1)] = []actions.Action{{Kind: actions.Signal, Signal: 9}}
 
 	copy := policy.Clone()
 
@@ -49,4 +52,8 @@ func TestPolicyClone(t *testing.T) {
 	if cmp.Equal(policy, copy, opt1, opt2) {
 		t.Errorf("Changes to copied policy affected the original: %+v", policy)
 	}
+	copy.Actions[events.ID(1)][0].Signal = 15
+	if policy.Actions[events.ID(1)][0].Signal != 9 {
+		t.Errorf("Changes to copied policy actions affected the original: %+v", policy.Actions)
+	}
 }
@@ -3,15 +3,14 @@ package v1beta1
 import (
 	"os"
 	"path/filepath"
-	"strconv"
 	"strings"
 
 	"gopkg.in/yaml.v2"
-	"k8s.io/apimachinery/pkg/util/validation"
 
 	"github.com/khulnasoft-lab/tracker/pkg/errfmt"
 	"github.com/khulnasoft-lab/tracker/pkg/events"
 	k8s "github.com/khulnasoft-lab/tracker/pkg/k8s/apis/tracker.khulnasoft.com/v1beta1"
+	"github.com/khulnasoft-lab/tracker/pkg/policy/v1beta1/validate"
 )
 
 // PolicyFile is the structure of the policy file
@@ -51,15 +50,21 @@ func (p PolicyFile) GetDefaultActions() []string {
 	return p.Spec.DefaultActions
 }
 
+func (p PolicyFile) GetRateLimit() string {
+	return p.Spec.RateLimit
+}
+
+func (p PolicyFile) GetDedupWindow() string {
+	return p.Spec.DedupWindow
+}
+
 func (p PolicyFile) GetRules() []k8s.Rule {
 	return p.Spec.Rules
 }
 
+// Validate validates the policy file, looking up its events in the tracker events (including
+// the signature events)
 func (p PolicyFile) Validate() error {
-	if err := validation.IsDNS1123Subdomain(p.GetName()); err != nil {
-		return errfmt.Errorf("policy name %s is invalid: %s", p.GetName(), err)
-	}
-
 	if p.APIVersion != "tracker.khulnasoft.com/v1beta1" {
 		return errfmt.Errorf("policy %s, apiVersion not supported", p.GetName())
 	}
@@ -68,226 +73,28 @@ func (p PolicyFile) Validate() error {
 		return errfmt.Errorf("policy %s, kind not supported", p.GetName())
 	}
 
-	if p.GetScope() == nil || len(p.GetScope()) == 0 {
-		return errfmt.Errorf("policy %s, scope cannot be empty", p.GetName())
-	}
-
-	if p.GetRules() == nil || len(p.GetRules()) == 0 {
-		return errfmt.Errorf("policy %s, rules cannot be empty", p.GetName())
-	}
-
-	if err := p.validateDefaultActions(); err != nil {
-		return err
-	}
-
-	if err := p.validateScope(); err != nil {
-		return err
-	}
-
-	return p.validateRules()
-}
-
-func (p PolicyFile) validateDefaultActions() error {
-	if p.GetDefaultActions() == nil || len(p.GetDefaultActions()) == 0 {
-		return nil
-	}
-
-	return validateActions(p.GetName(), p.GetDefaultActions())
-}
-
-func validateActions(policyName string, actions []string) error {
-	for _, action := range actions {
-		switch action {
-		case "log", "print": // supported actions
-			continue
-		default:
-			return errfmt.Errorf("policy %s, action %s is not valid", policyName, action)
-		}
-	}
-
-	return nil
-}
-
-func (p PolicyFile) validateScope() error {
-	scopes := []string{
-		"uid",
-		"pid",
-		"mntns",
-		"pidns",
-		"uts",
-		"comm",
-		"container",
-		"not-container",
-		"tree",
-		"exec", "executable", "bin", "binary",
-		"follow",
-	}
-
-	for _, scope := range p.GetScope() {
-		scope = strings.ReplaceAll(scope, " ", "")
-
-		if scope == "global" && len(p.GetScope()) > 1 {
-			return errfmt.Errorf("policy %s, global scope must be unique", p.GetName())
-		}
-
-		if scope == "global" {
-			return nil
-		}
-
-		scope, err := parseScope(p.GetName(), scope)
-		if err != nil {
-			return err
-		}
-
-		var found bool
-		for _, s := range scopes {
-			if scope == s {
-				found = true
-			}
-		}
-
-		if !found {
-			return errfmt.Errorf("policy %s, scope %s is not valid", p.GetName(), scope)
-		}
-	}
-	return nil
-}
-
-func parseScope(policyName, scope string) (string, error) {
-	switch scope {
-	case "follow", "not-container", "container":
-		return scope, nil
-	default:
-		operatorIdx := strings.IndexAny(scope, "=!<>")
-
-		if operatorIdx == -1 {
-			return "", errfmt.Errorf("policy %s, scope %s is not valid", policyName, scope)
-		}
-		if operatorIdx == 0 {
-			return scope, nil
-		}
-
-		return scope[:operatorIdx], nil
-	}
+	return validate.Policy(p, coreEvent)
 }
 
-func (p PolicyFile) validateRules() error {
-	evts := make(map[string]bool)
-
-	for _, r := range p.GetRules() {
-		// Currently, an event can only be used once in the policy. Support for using the same
-		// event, multiple times, with different filters, shall be implemented in the future.
-		if _, ok := evts[r.Event]; ok {
-			return errfmt.Errorf("policy %s, event %s is duplicated", p.GetName(), r.Event)
-		}
-
-		evts[r.Event] = true
-
-		err := validateEvent(p.GetName(), r.Event)
-		if err != nil {
-			return err
-		}
-
-		err = validateActions(p.GetName(), r.Actions)
-		if err != nil {
-			return err
-		}
-
-		for _, f := range r.Filters {
-			operatorIdx := strings.IndexAny(f, "=!<>")
-
-			if operatorIdx == -1 {
-				return errfmt.Errorf("policy %s, invalid filter operator: %s", p.GetName(), f)
-			}
-
-			// data
-			// option "args" will be deprecate in future
-			if strings.HasPrefix(f, "data") || strings.HasPrefix(f, "args") {
-				s := strings.Split(f, ".")
-				if len(s) == 1 {
-					return errfmt.Errorf("policy %s, data name can't be empty", p.GetName())
-				}
-
-				err := validateEventData(p.GetName(), r.Event, s[1])
-				if err != nil {
-					return err
-				}
-
-				continue
-			}
-
-			// retval
-			if strings.HasPrefix(f, "retval") {
-				s := strings.Split(f, "=")
-				if len(s) == 1 {
-					return errfmt.Errorf("policy %s, retval must have value: %s", p.GetName(), f)
-				}
-
-				if s[1] == "" {
-					return errfmt.Errorf("policy %s, retval cannot be empty", p.GetName())
-				}
-
-				_, err := strconv.Atoi(s[1])
-				if err != nil {
-					return errfmt.Errorf("policy %s, retval must be an integer: %s", p.GetName(), s[1])
-				}
-
-				continue
-			}
-		}
-	}
-
-	return nil
-}
-
-func validateEvent(policyName, eventName string) error {
-	if eventName == "" {
-		return errfmt.Errorf("policy %s, event cannot be empty", policyName)
-	}
-
-	_, ok := events.Core.GetDefinitionIDByName(eventName)
-	if !ok {
-		return errfmt.Errorf("policy %s, event %s is not valid", policyName, eventName)
-	}
-	return nil
-}
-
-func validateEventData(policyName, eventName, dataName string) error {
-	s := strings.Split(dataName, "!=")
-
-	if len(s) == 1 {
-		s = strings.Split(dataName, "=")
-	}
-
-	if len(s) == 1 {
-		return errfmt.Errorf("policy %s, data %s value can't be empty", policyName, s[0])
-	}
-
-	if s[1] == "" {
-		return errfmt.Errorf("policy %s, data %s value can't be empty", policyName, s[0])
-	}
-
-	dataName = s[0]
-
-	eventDefID, ok := events.Core.GetDefinitionIDByName(eventName)
+// coreEvent looks up an event used by a policy in the tracker events
+func coreEvent(name string) (validate.Event, bool) {
+	id, ok := events.Core.GetDefinitionIDByName(name)
 	if !ok {
-		return errfmt.Errorf("policy %s, event %s is not valid", policyName, eventName)
+		return validate.Event{}, false
 	}
 
-	eventDefinition := events.Core.GetDefinitionByID(eventDefID)
-
-	for _, set := range eventDefinition.GetSets() {
-		if set == "signatures" { // no sig event validation (arguments are dynamic)
-			return nil
+	definition := events.Core.GetDefinitionByID(id)
+	var event validate.Event
+	for _, set := range definition.GetSets() {
+		if set == "signatures" {
+			event.Dynamic = true
 		}
 	}
-	for _, p := range eventDefinition.GetParams() {
-		if p.Name == dataName {
-			return nil
-		}
+	for _, param := range definition.GetParams() {
+		event.Data = append(event.Data, param.Name)
 	}
 
-	return errfmt.Errorf("policy %s, event %s does not have data %s", policyName, eventName, dataName)
+	return event, true
 }
 
 // PoliciesFromPaths returns a slice of policies from the given paths
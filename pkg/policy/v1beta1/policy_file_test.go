@@ -124,7 +124,7 @@ func TestPolicyValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: errors.New("v1beta1.validateEvent: policy empty-event-name, event cannot be empty"),
+			expectedError: errors.New("validate.validateEvent: policy empty-event-name, event cannot be empty"),
 		},
 		{
 			testName: "invalid event name",
@@ -142,7 +142,7 @@ func TestPolicyValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: errors.New("v1beta1.validateEvent: policy invalid-event-name, event non_existing_event is not valid"),
+			expectedError: errors.New("validate.validateEvent: policy invalid-event-name, event non_existing_event is not valid"),
 		},
 		{
 			testName: "invalid_scope_operator",
@@ -160,7 +160,7 @@ func TestPolicyValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: errors.New("v1beta1.parseScope: policy invalid-scope-operator, scope random is not valid"),
+			expectedError: errors.New("validate.parseScope: policy invalid-scope-operator, scope random is not valid"),
 		},
 		{
 			testName: "invalid_scope",
@@ -178,7 +178,7 @@ func TestPolicyValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: errors.New("v1beta1.PolicyFile.validateScope: policy invalid-scope, scope random is not valid"),
+			expectedError: errors.New("validate.validateScope: policy invalid-scope, scope random is not valid"),
 		},
 		{
 			testName: "global scope must be unique",
@@ -238,7 +238,7 @@ func TestPolicyValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: errors.New("v1beta1.PolicyFile.validateRules: policy invalid-filter-operator, invalid filter operator: random"),
+			expectedError: errors.New("validate.validateRules: policy invalid-filter-operator, invalid filter operator: random"),
 		},
 		{
 			testName: "invalid policy action",
@@ -256,7 +256,181 @@ func TestPolicyValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: errors.New("v1beta1.validateActions: policy invalid-policy-action, action audit is not valid"),
+			expectedError: errors.New("validate.validateActions: policy invalid-policy-action, action audit is not valid"),
+		},
+		{
+			testName: "invalid rule action value",
+			policy: PolicyFile{
+				APIVersion: "tracker.khulnasoft.com/v1beta1",
+				Kind:       "Policy",
+				Metadata: Metadata{
+					Name: "invalid-rule-action-value",
+				},
+				Spec: k8s.PolicySpec{
+					Scope: []string{"global"},
+					Rules: []k8s.Rule{
+						{Event: "write", Actions: []string{"signal=SIGFOO"}},
+					},
+				},
+			},
+			expectedError: errors.New("validate.validateActions: policy invalid-rule-action-value, action signal=SIGFOO is not valid: a signal name (e.g. SIGKILL) is expected"),
+		},
+		{
+			testName: "rate limit rule action",
+			policy: PolicyFile{
+				APIVersion: "tracker.khulnasoft.com/v1beta1",
+				Kind:       "Policy",
+				Metadata: Metadata{
+					Name: "rate-limit-rule-action",
+				},
+				Spec: k8s.PolicySpec{
+					Scope: []string{"global"},
+					Rules: []k8s.Rule{
+						{Event: "write", Actions: []string{"log", "rate-limit=10/s"}},
+					},
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			testName: "rate limit rule action and field",
+			policy: PolicyFile{
+				APIVersion: "tracker.khulnasoft.com/v1beta1",
+				Kind:       "Policy",
+				Metadata: Metadata{
+					Name: "rate-limit-rule-action-and-field",
+				},
+				Spec: k8s.PolicySpec{
+					Scope: []string{"global"},
+					Rules: []k8s.Rule{
+						{Event: "write", Actions: []string{"rate-limit=10/s"}, RateLimit: "5/s"},
+					},
+				},
+			},
+			expectedError: errors.New("validate.validateThrottle: policy rate-limit-rule-action-and-field, event write rate limit 5/s is given by both the rateLimit field and the rate-limit=10/s action"),
+		},
+		{
+			testName: "dedup rule action",
+			policy: PolicyFile{
+				APIVersion: "tracker.khulnasoft.com/v1beta1",
+				Kind:       "Policy",
+				Metadata: Metadata{
+					Name: "dedup-rule-action",
+				},
+				Spec: k8s.PolicySpec{
+					Scope: []string{"global"},
+					Rules: []k8s.Rule{
+						{Event: "write", Actions: []string{"dedup=10s"}},
+					},
+				},
+			},
+			expectedError: errors.New("validate.validateActions: policy dedup-rule-action, action dedup=10s is not valid: use the dedupWindow field of the rule"),
+		},
+		{
+			testName: "response rule actions",
+			policy: PolicyFile{
+				APIVersion: "tracker.khulnasoft.com/v1beta1",
+				Kind:       "Policy",
+				Metadata: Metadata{
+					Name: "response-rule-actions",
+				},
+				Spec: k8s.PolicySpec{
+					Scope: []string{"global"},
+					Rules: []k8s.Rule{
+						{Event: "write", Actions: []string{"log", "webhook=http://localhost:8080/events", "capture", "signal=SIGKILL"}},
+					},
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			testName: "rule rate limit and dedup",
+			policy: PolicyFile{
+				APIVersion: "tracker.khulnasoft.com/v1beta1",
+				Kind:       "Policy",
+				Metadata: Metadata{
+					Name: "rule-rate-limit-and-dedup",
+				},
+				Spec: k8s.PolicySpec{
+					Scope:     []string{"global"},
+					RateLimit: "100/s",
+					Rules: []k8s.Rule{
+						{Event: "security_file_open", DedupWindow: "10s", DedupKeys: []string{"pathname"}},
+						{Event: "write", RateLimit: "10/s"},
+					},
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			testName: "invalid policy rate limit",
+			policy: PolicyFile{
+				APIVersion: "tracker.khulnasoft.com/v1beta1",
+				Kind:       "Policy",
+				Metadata: Metadata{
+					Name: "invalid-policy-rate-limit",
+				},
+				Spec: k8s.PolicySpec{
+					Scope:     []string{"global"},
+					RateLimit: "fast",
+					Rules: []k8s.Rule{
+						{Event: "write"},
+					},
+				},
+			},
+			expectedError: errors.New("validate.validateThrottle: policy invalid-policy-rate-limit, rate limit fast is not valid: a rate of events per second (e.g. 100/s) is expected"),
+		},
+		{
+			testName: "invalid rule dedup window",
+			policy: PolicyFile{
+				APIVersion: "tracker.khulnasoft.com/v1beta1",
+				Kind:       "Policy",
+				Metadata: Metadata{
+					Name: "invalid-rule-dedup-window",
+				},
+				Spec: k8s.PolicySpec{
+					Scope: []string{"global"},
+					Rules: []k8s.Rule{
+						{Event: "write", DedupWindow: "-1s"},
+					},
+				},
+			},
+			expectedError: errors.New("validate.validateThrottle: policy invalid-rule-dedup-window, dedup window -1s is not valid: a duration (e.g. 10s) is expected"),
+		},
+		{
+			testName: "dedup keys without window",
+			policy: PolicyFile{
+				APIVersion: "tracker.khulnasoft.com/v1beta1",
+				Kind:       "Policy",
+				Metadata: Metadata{
+					Name: "dedup-keys-without-window",
+				},
+				Spec: k8s.PolicySpec{
+					Scope: []string{"global"},
+					Rules: []k8s.Rule{
+						{Event: "security_file_open", DedupKeys: []string{"pathname"}},
+					},
+				},
+			},
+			expectedError: errors.New("validate.validateThrottle: policy dedup-keys-without-window, event security_file_open dedup keys require a dedup window"),
+		},
+		{
+			testName: "invalid dedup key",
+			policy: PolicyFile{
+				APIVersion: "tracker.khulnasoft.com/v1beta1",
+				Kind:       "Policy",
+				Metadata: Metadata{
+					Name: "invalid-dedup-key",
+				},
+				Spec: k8s.PolicySpec{
+					Scope:       []string{"global"},
+					DedupWindow: "1m",
+					Rules: []k8s.Rule{
+						{Event: "security_file_open", DedupKeys: []string{"path"}},
+					},
+				},
+			},
+			expectedError: errors.New("validate.validateThrottle: policy invalid-dedup-key, event security_file_open does not have data path"),
 		},
 		{
 			testName: "invalid retval",
@@ -279,7 +453,7 @@ func TestPolicyValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: errors.New("v1beta1.PolicyFile.validateRules: policy invalid-retval, invalid filter operator: retval"),
+			expectedError: errors.New("validate.validateRules: policy invalid-retval, invalid filter operator: retval"),
 		},
 		{
 			testName: "empty retval",
@@ -302,7 +476,7 @@ func TestPolicyValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: errors.New("v1beta1.PolicyFile.validateRules: policy empty-retval, retval cannot be empty"),
+			expectedError: errors.New("validate.validateRules: policy empty-retval, retval cannot be empty"),
 		},
 		{
 			testName: "retval not an integer",
@@ -325,7 +499,7 @@ func TestPolicyValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: errors.New("v1beta1.PolicyFile.validateRules: policy retval-not-an-integer, retval must be an integer: lala"),
+			expectedError: errors.New("validate.validateRules: policy retval-not-an-integer, retval must be an integer: lala"),
 		},
 		{
 			testName: "empty data name 1",
@@ -348,7 +522,7 @@ func TestPolicyValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: errors.New("v1beta1.PolicyFile.validateRules: policy empty-filter-data-1, invalid filter operator: data"),
+			expectedError: errors.New("validate.validateRules: policy empty-filter-data-1, invalid filter operator: data"),
 		},
 		{
 			testName: "empty data name 3",
@@ -371,7 +545,7 @@ func TestPolicyValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: errors.New("v1beta1.PolicyFile.validateRules: policy empty-filter-data-3, data name can't be empty"),
+			expectedError: errors.New("validate.validateRules: policy empty-filter-data-3, data name can't be empty"),
 		},
 		{
 			testName: "empty data name 4",
@@ -394,7 +568,7 @@ func TestPolicyValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: errors.New("v1beta1.PolicyFile.validateRules: policy empty-filter-data-4, data name can't be empty"),
+			expectedError: errors.New("validate.validateRules: policy empty-filter-data-4, data name can't be empty"),
 		},
 		{
 			testName: "invalid data",
@@ -417,7 +591,7 @@ func TestPolicyValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: errors.New("v1beta1.validateEventData: policy invalid-data, event openat does not have data lala"),
+			expectedError: errors.New("validate.validateEventData: policy invalid-data, event openat does not have data lala"),
 		},
 		// keep a single args (deprecated) filter test that shall break on future removal
 		{
@@ -441,7 +615,7 @@ func TestPolicyValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: errors.New("v1beta1.validateEventData: policy invalid-args, event openat does not have data lala"),
+			expectedError: errors.New("validate.validateEventData: policy invalid-args, event openat does not have data lala"),
 		},
 		{
 			testName: "empty data value",
@@ -464,7 +638,7 @@ func TestPolicyValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: errors.New("v1beta1.validateEventData: policy empty-data-value, data pathname value can't be empty"),
+			expectedError: errors.New("validate.validateEventData: policy empty-data-value, data pathname value can't be empty"),
 		},
 		{
 			testName: "empty data value",
@@ -487,7 +661,7 @@ func TestPolicyValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: errors.New("v1beta1.validateEventData: policy empty-data-value, data pathname value can't be empty"),
+			expectedError: errors.New("validate.validateEventData: policy empty-data-value, data pathname value can't be empty"),
 		},
 		// deprecated this test after deprecated args option
 		{
@@ -511,7 +685,7 @@ func TestPolicyValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: errors.New("v1beta1.validateEventData: policy empty-args-value, data pathname value can't be empty"),
+			expectedError: errors.New("validate.validateEventData: policy empty-args-value, data pathname value can't be empty"),
 		},
 		{
 			testName: "signature filter data",
@@ -0,0 +1,303 @@
+// Package validate validates the policies, without depending on the eBPF parts of tracker,
+// so that it can also be used by the operator. The events used by the policies are looked
+// up by the caller.
+package validate
+
+import (
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/khulnasoft-lab/tracker/pkg/errfmt"
+	"github.com/khulnasoft-lab/tracker/pkg/events/builtin"
+	k8s "github.com/khulnasoft-lab/tracker/pkg/k8s/apis/tracker.khulnasoft.com/v1beta1"
+	"github.com/khulnasoft-lab/tracker/pkg/policy/actions"
+)
+
+// Event is an event that can be used by the rules of a policy
+type Event struct {
+	Data    []string // names of the event data
+	Dynamic bool     // the data is only known at runtime (signature events)
+}
+
+// EventLookup returns the event with the given name, if it exists
+type EventLookup func(name string) (Event, bool)
+
+// Policy validates a policy, its scope, actions and rules, looking up the events of the
+// rules with the given lookup
+func Policy(p k8s.PolicyInterface, lookup EventLookup) error {
+	if err := validation.IsDNS1123Subdomain(p.GetName()); err != nil {
+		return errfmt.Errorf("policy name %s is invalid: %s", p.GetName(), err)
+	}
+
+	if len(p.GetScope()) == 0 {
+		return errfmt.Errorf("policy %s, scope cannot be empty", p.GetName())
+	}
+
+	if len(p.GetRules()) == 0 {
+		return errfmt.Errorf("policy %s, rules cannot be empty", p.GetName())
+	}
+
+	if err := validateActions(p.GetName(), p.GetDefaultActions()); err != nil {
+		return err
+	}
+
+	if err := validateScope(p); err != nil {
+		return err
+	}
+
+	return validateRules(p, lookup)
+}
+
+func validateActions(policyName string, policyActions []string) error {
+	for _, action := range policyActions {
+		if _, err := actions.Parse(action); err != nil {
+			return errfmt.Errorf("policy %s, %v", policyName, err)
+		}
+	}
+
+	return nil
+}
+
+// validateThrottle validates the rate limit and dedup window of a rule, or else of the policy
+func validateThrottle(p k8s.PolicyInterface, r k8s.Rule, event Event) error {
+	// rules without actions use the policy default actions (both already validated)
+	ruleActions := r.Actions
+	if len(ruleActions) == 0 {
+		ruleActions = p.GetDefaultActions()
+	}
+	parsedActions, err := actions.ParseAll(ruleActions)
+	if err != nil {
+		return errfmt.Errorf("policy %s, %v", p.GetName(), err)
+	}
+	rateLimit, err := actions.RuleRateLimit(parsedActions, r.RateLimit, p.GetRateLimit())
+	if err != nil {
+		return errfmt.Errorf("policy %s, event %s %v", p.GetName(), r.Event, err)
+	}
+
+	dedupWindow := p.GetDedupWindow()
+	if r.DedupWindow != "" {
+		dedupWindow = r.DedupWindow
+	}
+
+	if _, err := actions.ParseThrottle(rateLimit, dedupWindow, r.DedupKeys); err != nil {
+		return errfmt.Errorf("policy %s, %v", p.GetName(), err)
+	}
+
+	if len(r.DedupKeys) > 0 && dedupWindow == "" {
+		return errfmt.Errorf("policy %s, event %s dedup keys require a dedup window", p.GetName(), r.Event)
+	}
+
+	for _, key := range r.DedupKeys {
+		if !event.hasData(key) {
+			return errfmt.Errorf("policy %s, event %s does not have data %s", p.GetName(), r.Event, key)
+		}
+	}
+
+	return nil
+}
+
+func validateScope(p k8s.PolicyInterface) error {
+	scopes := []string{
+		"uid",
+		"pid",
+		"mntns",
+		"pidns",
+		"uts",
+		"comm",
+		"container",
+		"not-container",
+		"tree",
+		"exec", "executable", "bin", "binary",
+		"follow",
+	}
+
+	for _, scope := range p.GetScope() {
+		scope = strings.ReplaceAll(scope, " ", "")
+
+		if scope == "global" && len(p.GetScope()) > 1 {
+			return errfmt.Errorf("policy %s, global scope must be unique", p.GetName())
+		}
+
+		if scope == "global" {
+			return nil
+		}
+
+		scope, err := parseScope(p.GetName(), scope)
+		if err != nil {
+			return err
+		}
+
+		var found bool
+		for _, s := range scopes {
+			if scope == s {
+				found = true
+			}
+		}
+
+		if !found {
+			return errfmt.Errorf("policy %s, scope %s is not valid", p.GetName(), scope)
+		}
+	}
+	return nil
+}
+
+func parseScope(policyName, scope string) (string, error) {
+	switch scope {
+	case "follow", "not-container", "container":
+		return scope, nil
+	default:
+		operatorIdx := strings.IndexAny(scope, "=!<>")
+
+		if operatorIdx == -1 {
+			return "", errfmt.Errorf("policy %s, scope %s is not valid", policyName, scope)
+		}
+		if operatorIdx == 0 {
+			return scope, nil
+		}
+
+		return scope[:operatorIdx], nil
+	}
+}
+
+func validateRules(p k8s.PolicyInterface, lookup EventLookup) error {
+	evts := make(map[string]bool)
+
+	for _, r := range p.GetRules() {
+		// Currently, an event can only be used once in the policy. Support for using the same
+		// event, multiple times, with different filters, shall be implemented in the future.
+		if _, ok := evts[r.Event]; ok {
+			return errfmt.Errorf("policy %s, event %s is duplicated", p.GetName(), r.Event)
+		}
+
+		evts[r.Event] = true
+
+		event, err := validateEvent(p.GetName(), r.Event, lookup)
+		if err != nil {
+			return err
+		}
+
+		err = validateActions(p.GetName(), r.Actions)
+		if err != nil {
+			return err
+		}
+
+		err = validateThrottle(p, r, event)
+		if err != nil {
+			return err
+		}
+
+		for _, f := range r.Filters {
+			operatorIdx := strings.IndexAny(f, "=!<>")
+
+			if operatorIdx == -1 {
+				return errfmt.Errorf("policy %s, invalid filter operator: %s", p.GetName(), f)
+			}
+
+			// data
+			// option "args" will be deprecate in future
+			if strings.HasPrefix(f, "data") || strings.HasPrefix(f, "args") {
+				s := strings.Split(f, ".")
+				if len(s) == 1 {
+					return errfmt.Errorf("policy %s, data name can't be empty", p.GetName())
+				}
+
+				err := validateEventData(p.GetName(), r.Event, event, s[1])
+				if err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			// retval
+			if strings.HasPrefix(f, "retval") {
+				s := strings.Split(f, "=")
+				if len(s) == 1 {
+					return errfmt.Errorf("policy %s, retval must have value: %s", p.GetName(), f)
+				}
+
+				if s[1] == "" {
+					return errfmt.Errorf("policy %s, retval cannot be empty", p.GetName())
+				}
+
+				_, err := strconv.Atoi(s[1])
+				if err != nil {
+					return errfmt.Errorf("policy %s, retval must be an integer: %s", p.GetName(), s[1])
+				}
+
+				continue
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateEvent(policyName, eventName string, lookup EventLookup) (Event, error) {
+	if eventName == "" {
+		return Event{}, errfmt.Errorf("policy %s, event cannot be empty", policyName)
+	}
+
+	event, ok := lookup(eventName)
+	if !ok {
+		return Event{}, errfmt.Errorf("policy %s, event %s is not valid", policyName, eventName)
+	}
+	return event, nil
+}
+
+func validateEventData(policyName, eventName string, event Event, dataName string) error {
+	s := strings.Split(dataName, "!=")
+
+	if len(s) == 1 {
+		s = strings.Split(dataName, "=")
+	}
+
+	if len(s) == 1 {
+		return errfmt.Errorf("policy %s, data %s value can't be empty", policyName, s[0])
+	}
+
+	if s[1] == "" {
+		return errfmt.Errorf("policy %s, data %s value can't be empty", policyName, s[0])
+	}
+
+	dataName = s[0]
+
+	if !event.hasData(dataName) {
+		return errfmt.Errorf("policy %s, event %s does not have data %s", policyName, eventName, dataName)
+	}
+
+	return nil
+}
+
+// hasData returns true if the event has the data, or if its data is dynamic (no signature
+// event data validation, the data is only known at runtime)
+func (e Event) hasData(dataName string) bool {
+	if e.Dynamic {
+		return true
+	}
+	for _, name := range e.Data {
+		if name == dataName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// BuiltinEvent looks up an event in the builtin events of tracker. The signature events are
+// not builtin, they are only known where the signatures are loaded.
+func BuiltinEvent(name string) (Event, bool) {
+	event, ok := builtin.Lookup(name)
+	if !ok {
+		return Event{}, false
+	}
+
+	data := make([]string, 0, len(event.Params))
+	for _, param := range event.Params {
+		data = append(data, param.Name)
+	}
+
+	return Event{Data: data}, true
+}
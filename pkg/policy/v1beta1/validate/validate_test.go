@@ -0,0 +1,65 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	k8s "github.com/khulnasoft-lab/tracker/pkg/k8s/apis/tracker.khulnasoft.com/v1beta1"
+)
+
+func TestPolicyEventLookup(t *testing.T) {
+	t.Parallel()
+
+	lookup := func(name string) (Event, bool) {
+		switch name {
+		case "openat":
+			return Event{Data: []string{"dirfd", "pathname", "flags", "mode"}}, true
+		case "anti_debugging":
+			return Event{Dynamic: true}, true
+		}
+		return Event{}, false
+	}
+
+	testCases := []struct {
+		name          string
+		rule          k8s.Rule
+		expectedError string
+	}{
+		{name: "event data", rule: k8s.Rule{Event: "openat", Filters: []string{"data.pathname=/etc/shadow"}}},
+		{name: "dynamic event data", rule: k8s.Rule{Event: "anti_debugging", Filters: []string{"data.anything=1"}}},
+		{
+			name:          "unknown event data",
+			rule:          k8s.Rule{Event: "openat", Filters: []string{"data.path=/etc/shadow"}},
+			expectedError: "policy test, event openat does not have data path",
+		},
+		{
+			name:          "unknown dedup key",
+			rule:          k8s.Rule{Event: "openat", DedupWindow: "10s", DedupKeys: []string{"path"}},
+			expectedError: "policy test, event openat does not have data path",
+		},
+		{
+			name:          "unknown event",
+			rule:          k8s.Rule{Event: "non_existing_event"},
+			expectedError: "policy test, event non_existing_event is not valid",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := k8s.Policy{Spec: k8s.PolicySpec{Scope: []string{"global"}, Rules: []k8s.Rule{tc.rule}}}
+			p.Name = "test"
+
+			err := Policy(p, lookup)
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package proctree
+
+import "sync"
+
+// pidIndex indexes the processes of the tree by host pid. A pid is reused over time, so it
+// indexes all the processes that had it while they are in the tree.
+type pidIndex struct {
+	mutex  *sync.Mutex
+	hashes map[int]map[uint32]struct{} // pid -> hashes of processes
+	pids   map[uint32]int              // hash -> pid
+}
+
+func newPidIndex() *pidIndex {
+	return &pidIndex{
+		mutex:  &sync.Mutex{},
+		hashes: make(map[int]map[uint32]struct{}),
+		pids:   make(map[uint32]int),
+	}
+}
+
+// set indexes the process with the given hash by its pid
+func (i *pidIndex) set(hash uint32, pid int) {
+	if pid <= 0 {
+		return
+	}
+
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	if old, ok := i.pids[hash]; ok {
+		if old == pid {
+			return
+		}
+		i.unset(hash, old)
+	}
+
+	hashes, ok := i.hashes[pid]
+	if !ok {
+		hashes = make(map[uint32]struct{})
+		i.hashes[pid] = hashes
+	}
+	hashes[hash] = struct{}{}
+	i.pids[hash] = pid
+}
+
+// remove removes the process with the given hash from the index
+func (i *pidIndex) remove(hash uint32) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	if pid, ok := i.pids[hash]; ok {
+		i.unset(hash, pid)
+	}
+}
+
+func (i *pidIndex) unset(hash uint32, pid int) {
+	delete(i.pids, hash)
+	delete(i.hashes[pid], hash)
+	if len(i.hashes[pid]) == 0 {
+		delete(i.hashes, pid)
+	}
+}
+
+// get returns the hashes of the processes with the given pid
+func (i *pidIndex) get(pid int) []uint32 {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	hashes := make([]uint32, 0, len(i.hashes[pid]))
+	for hash := range i.hashes[pid] {
+		hashes = append(hashes, hash)
+	}
+
+	return hashes
+}
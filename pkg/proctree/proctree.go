@@ -74,6 +74,7 @@ type ProcTreeConfig struct {
 type ProcessTree struct {
 	processes   *lru.Cache[uint32, *Process] // hash -> process
 	threads     *lru.Cache[uint32, *Thread]  // hash -> threads
+	pids        *pidIndex                    // pid -> hashes of processes
 	procfsChan  chan int                     // channel of pids to read from procfs
 	procfsOnce  *sync.Once                   // busy loop debug message throttling
 	ctx         context.Context              // context for the process tree
@@ -86,10 +87,15 @@ func NewProcessTree(ctx context.Context, config ProcTreeConfig) (*ProcessTree, e
 	procEvited := 0
 	thrEvicted := 0
 
+	pids := newPidIndex()
+
 	// Create caches for processes.
 	processes, err := lru.NewWithEvict[uint32, *Process](
 		config.ProcessCacheSize,
-		func(uint32, *Process) { procEvited++ },
+		func(hash uint32, _ *Process) {
+			procEvited++
+			pids.remove(hash)
+		},
 	)
 	if err != nil {
 		return nil, errfmt.WrapError(err)
@@ -138,6 +144,7 @@ func NewProcessTree(ctx context.Context, config ProcTreeConfig) (*ProcessTree, e
 	procTree := &ProcessTree{
 		processes:   processes,
 		threads:     threads,
+		pids:        pids,
 		ctx:         ctx,
 		mutex:       &sync.RWMutex{},
 		procfsQuery: config.ProcfsQuerying,
@@ -190,6 +197,30 @@ func (pt *ProcessTree) GetOrCreateProcessByHash(hash uint32) *Process {
 	return process // return an existing process
 }
 
+// GetProcessByPid returns the process with the given host pid alive at the given time. As pids are
+// reused, the most recently started process is returned if more than one is found.
+func (pt *ProcessTree) GetProcessByPid(pid int, targetTime time.Time) (*Process, bool) {
+	pt.mutex.RLock()
+	defer pt.mutex.RUnlock()
+
+	var found *Process
+	for _, hash := range pt.pids.get(pid) {
+		process, ok := pt.processes.Peek(hash)
+		if !ok {
+			continue
+		}
+		info := process.GetInfo()
+		if info.GetPid() != pid || !info.IsAliveAt(targetTime) {
+			continue
+		}
+		if found == nil || info.GetStartTimeNS() > found.GetInfo().GetStartTimeNS() {
+			found = process
+		}
+	}
+
+	return found, found != nil
+}
+
 //
 // Threads
 //
@@ -65,6 +65,7 @@ func (pt *ProcessTree) FeedFromFork(feed ForkFeed) error {
 			},
 			utils.NsSinceBootTimeToTime(feed.TimeStamp),
 		)
+		pt.pids.set(parent.GetHash(), int(feed.ParentPid))
 		if pt.procfsQuery {
 			pt.FeedFromProcFSAsync(int(feed.ParentPid)) // try to enrich ppid and name from procfs
 		}
@@ -103,6 +104,7 @@ func (pt *ProcessTree) FeedFromFork(feed ForkFeed) error {
 			},
 			utils.NsSinceBootTimeToTime(feed.TimeStamp),
 		)
+		pt.pids.set(leader.GetHash(), int(feed.LeaderPid))
 		if pt.procfsQuery {
 			pt.FeedFromProcFSAsync(int(feed.LeaderPid)) // try to enrich name from procfs if needed
 		}
@@ -180,6 +180,7 @@ func dealWithProc(pt *ProcessTree, givenPid int) error {
 		},
 		utils.NsSinceBootTimeToTime(uint64(start)), // try to be the first changelog entry
 	)
+	pt.pids.set(hash, tgid)
 
 	// TODO: Update executable with information from /proc/<pid>/exe
 
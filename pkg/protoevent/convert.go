@@ -0,0 +1,556 @@
+package protoevent
+
+import (
+	"net/http"
+	"strconv"
+
+	pb "github.com/khulnasoft-lab/tracker/api/v1beta1"
+	"github.com/khulnasoft-lab/tracker/pkg/errfmt"
+	"github.com/khulnasoft-lab/tracker/pkg/events/builtin"
+	"github.com/khulnasoft-lab/tracker/types/trace"
+)
+
+// ToTrace converts a protobuf event back to a tracker event, the inverse of FromTrace. The
+// event ID and the argument types are resolved from the builtin event definitions, as the
+// protobuf event ID isn't the tracker one. Other events (signatures) keep the protobuf ID,
+// which is the tracker one out of the builtin events range, and have no argument types.
+func ToTrace(e *pb.Event) (trace.Event, error) {
+	event := trace.Event{
+		EventName:       e.GetName(),
+		MatchedPolicies: e.GetPolicies().GetMatched(),
+		Labels:          e.GetLabels(),
+	}
+
+	if e.GetTimestamp() != nil {
+		event.Timestamp = int(e.GetTimestamp().AsTime().UnixNano())
+	}
+
+	setProcess(&event, e.GetContext().GetProcess())
+	setContainer(&event, e.GetContext().GetContainer())
+	setK8s(&event, e.GetContext().GetK8S())
+
+	if threat := e.GetThreat(); threat != nil {
+		event.Metadata = getMetadata(threat)
+	}
+
+	args, returnValue, err := getArguments(e.GetData())
+	if err != nil {
+		return trace.Event{}, errfmt.Errorf("event %s: %v", e.GetName(), err)
+	}
+	event.Args = args
+	event.ArgsNum = len(args)
+	event.ReturnValue = returnValue
+
+	definition, ok := builtin.Lookup(event.EventName)
+	if !ok {
+		if e.GetId() > pb.EventId(builtin.MaxBuiltinID) {
+			event.EventID = int(e.GetId())
+		}
+		return event, nil
+	}
+	event.EventID = definition.ID
+
+	for i := range event.Args {
+		for _, param := range definition.Params {
+			if param.Name == event.Args[i].Name {
+				event.Args[i].Type = param.Type
+				break
+			}
+		}
+	}
+
+	return event, nil
+}
+
+func setProcess(event *trace.Event, process *pb.Process) {
+	if process == nil {
+		return
+	}
+
+	event.Executable.Path = process.GetExecutable().GetPath()
+	event.ProcessEntityId = process.GetUniqueId().GetValue()
+	event.HostProcessID = int(process.GetHostPid().GetValue())
+	event.ProcessID = int(process.GetPid().GetValue())
+	event.UserID = int(process.GetRealUser().GetId().GetValue())
+
+	if thread := process.GetThread(); thread != nil {
+		if thread.GetStartTime() != nil {
+			event.ThreadStartTime = int(thread.GetStartTime().AsTime().UnixNano())
+		}
+		event.ProcessName = thread.GetName()
+		event.ThreadEntityId = thread.GetUniqueId().GetValue()
+		event.HostThreadID = int(thread.GetHostTid().GetValue())
+		event.ThreadID = int(thread.GetTid().GetValue())
+		event.Syscall = thread.GetSyscall()
+		event.ContextFlags.ContainerStarted = thread.GetCompat()
+		for _, address := range thread.GetUserStackTrace().GetAddresses() {
+			event.StackAddresses = append(event.StackAddresses, address.GetAddress())
+		}
+	}
+
+	if ancestors := process.GetAncestors(); len(ancestors) > 0 {
+		event.ParentEntityId = ancestors[0].GetUniqueId().GetValue()
+		event.HostParentProcessID = int(ancestors[0].GetHostPid().GetValue())
+		event.ParentProcessID = int(ancestors[0].GetPid().GetValue())
+	}
+}
+
+func setContainer(event *trace.Event, container *pb.Container) {
+	if container == nil {
+		return
+	}
+
+	event.ContainerID = container.GetId()
+	event.Container.ID = container.GetId()
+	event.Container.Name = container.GetName()
+	event.Container.ImageName = container.GetImage().GetName()
+	if digests := container.GetImage().GetRepoDigests(); len(digests) > 0 {
+		event.Container.ImageDigest = digests[0]
+	}
+}
+
+func setK8s(event *trace.Event, k8s *pb.K8S) {
+	if k8s == nil {
+		return
+	}
+
+	event.Kubernetes.PodNamespace = k8s.GetNamespace().GetName()
+	event.Kubernetes.PodName = k8s.GetPod().GetName()
+	event.Kubernetes.PodUID = k8s.GetPod().GetUid()
+}
+
+// getMetadata returns the finding metadata of a threat, with the properties set by the
+// signatures
+func getMetadata(threat *pb.Threat) *trace.Metadata {
+	properties := make(map[string]interface{}, len(threat.GetProperties())+5)
+	for k, v := range threat.GetProperties() {
+		properties[k] = v
+	}
+
+	properties["Severity"] = int(threat.GetSeverity())
+	if tactic := threat.GetMitre().GetTactic().GetName(); tactic != "" {
+		properties["Category"] = tactic
+	}
+	if id := threat.GetMitre().GetTechnique().GetId(); id != "" {
+		properties["external_id"] = id
+	}
+	if technique := threat.GetMitre().GetTechnique().GetName(); technique != "" {
+		properties["Technique"] = technique
+	}
+	if name := threat.GetName(); name != "" {
+		properties["signatureName"] = name
+	}
+
+	return &trace.Metadata{
+		Description: threat.GetDescription(),
+		Properties:  properties,
+	}
+}
+
+// getArguments returns the arguments of the event data, and the syscall return value
+// which is added to the data of the syscall events
+func getArguments(data []*pb.EventValue) ([]trace.Argument, int, error) {
+	var (
+		args        []trace.Argument
+		returnValue int
+	)
+
+	for _, value := range data {
+		if v, ok := value.GetValue().(*pb.EventValue_Int64); ok && value.GetName() == "returnValue" {
+			returnValue = int(v.Int64)
+			continue
+		}
+
+		arg, err := getArgument(value)
+		if err != nil {
+			return nil, 0, err
+		}
+		args = append(args, arg)
+	}
+
+	return args, returnValue, nil
+}
+
+// getArgument converts an event value to an argument, the inverse of parseArgument of
+// the gRPC server
+func getArgument(value *pb.EventValue) (trace.Argument, error) {
+	arg := trace.Argument{ArgMeta: trace.ArgMeta{Name: value.GetName()}}
+
+	switch v := value.GetValue().(type) {
+	case nil:
+	case *pb.EventValue_Int32:
+		arg.Value = v.Int32
+	case *pb.EventValue_Int64:
+		arg.Value = v.Int64
+	case *pb.EventValue_UInt32:
+		arg.Value = v.UInt32
+	case *pb.EventValue_UInt64:
+		arg.Value = v.UInt64
+	case *pb.EventValue_Str:
+		arg.Value = v.Str
+	case *pb.EventValue_Bytes:
+		arg.Value = v.Bytes
+	case *pb.EventValue_Bool:
+		arg.Value = v.Bool
+	case *pb.EventValue_StrArray:
+		arg.Value = append([]string{}, v.StrArray.GetValue()...)
+	case *pb.EventValue_Int32Array:
+		var array [2]int32
+		copy(array[:], v.Int32Array.GetValue())
+		arg.Value = array
+	case *pb.EventValue_UInt64Array:
+		arg.Value = append([]uint64{}, v.UInt64Array.GetValue()...)
+	case *pb.EventValue_Sockaddr:
+		arg.Value = getSockaddrMap(v.Sockaddr)
+	case *pb.EventValue_Credentials:
+		arg.Value = getCredentials(v.Credentials)
+	case *pb.EventValue_Timespec:
+		arg.Value = v.Timespec.GetValue().GetValue()
+	case *pb.EventValue_TriggeredBy:
+		triggeredBy, err := getTriggeredBy(v.TriggeredBy)
+		if err != nil {
+			return arg, err
+		}
+		arg.Value = triggeredBy
+	case *pb.EventValue_HookedSyscalls:
+		syscalls := make([]trace.HookedSymbolData, 0, len(v.HookedSyscalls.GetValue()))
+		for _, s := range v.HookedSyscalls.GetValue() {
+			syscalls = append(syscalls, trace.HookedSymbolData{
+				SymbolName:  s.GetSymbolName(),
+				ModuleOwner: s.GetModuleOwner(),
+			})
+		}
+		arg.Value = syscalls
+	case *pb.EventValue_HookedSeqOps:
+		seqOps := make(map[string]trace.HookedSymbolData, len(v.HookedSeqOps.GetValue()))
+		for k, s := range v.HookedSeqOps.GetValue() {
+			seqOps[k] = trace.HookedSymbolData{
+				SymbolName:  s.GetSymbolName(),
+				ModuleOwner: s.GetModuleOwner(),
+			}
+		}
+		arg.Value = seqOps
+	case *pb.EventValue_Ipv4:
+		arg.Value = getIPv4(v.Ipv4)
+	case *pb.EventValue_Ipv6:
+		arg.Value = getIPv6(v.Ipv6)
+	case *pb.EventValue_Tcp:
+		arg.Value = getTCP(v.Tcp)
+	case *pb.EventValue_Udp:
+		arg.Value = trace.ProtoUDP{
+			SrcPort:  uint16(v.Udp.GetSrcPort()),
+			DstPort:  uint16(v.Udp.GetDstPort()),
+			Length:   uint16(v.Udp.GetLength()),
+			Checksum: uint16(v.Udp.GetChecksum()),
+		}
+	case *pb.EventValue_Icmp:
+		arg.Value = trace.ProtoICMP{
+			TypeCode: v.Icmp.GetTypeCode(),
+			Checksum: uint16(v.Icmp.GetChecksum()),
+			Id:       uint16(v.Icmp.GetId()),
+			Seq:      uint16(v.Icmp.GetSeq()),
+		}
+	case *pb.EventValue_Icmpv6:
+		arg.Value = trace.ProtoICMPv6{
+			TypeCode: v.Icmpv6.GetTypeCode(),
+			Checksum: uint16(v.Icmpv6.GetChecksum()),
+		}
+	case *pb.EventValue_Dns:
+		arg.Value = getDNS(v.Dns)
+	case *pb.EventValue_DnsQuestions:
+		questions := make([]trace.DnsQueryData, 0, len(v.DnsQuestions.GetQuestions()))
+		for _, q := range v.DnsQuestions.GetQuestions() {
+			questions = append(questions, getDNSQueryData(q))
+		}
+		arg.Value = questions
+	case *pb.EventValue_DnsResponses:
+		responses := make([]trace.DnsResponseData, 0, len(v.DnsResponses.GetResponses()))
+		for _, r := range v.DnsResponses.GetResponses() {
+			answers := make([]trace.DnsAnswer, 0, len(r.GetDnsAnswer()))
+			for _, a := range r.GetDnsAnswer() {
+				answers = append(answers, trace.DnsAnswer{
+					Type:   a.GetType(),
+					Ttl:    a.GetTtl(),
+					Answer: a.GetAnswer(),
+				})
+			}
+			responses = append(responses, trace.DnsResponseData{
+				QueryData: getDNSQueryData(r.GetDnsQueryData()),
+				DnsAnswer: answers,
+			})
+		}
+		arg.Value = responses
+	case *pb.EventValue_PacketMetadata:
+		arg.Value = getPacketMetadata(v.PacketMetadata)
+	case *pb.EventValue_Http:
+		arg.Value = trace.ProtoHTTP{
+			Direction:     v.Http.GetDirection(),
+			Method:        v.Http.GetMethod(),
+			Protocol:      v.Http.GetProtocol(),
+			Host:          v.Http.GetHost(),
+			URIPath:       v.Http.GetUriPath(),
+			Status:        v.Http.GetStatus(),
+			StatusCode:    int(v.Http.GetStatusCode()),
+			Headers:       getHTTPHeader(v.Http.GetHeaders()),
+			ContentLength: v.Http.GetContentLength(),
+		}
+	case *pb.EventValue_HttpRequest:
+		arg.Value = trace.ProtoHTTPRequest{
+			Method:        v.HttpRequest.GetMethod(),
+			Protocol:      v.HttpRequest.GetProtocol(),
+			Host:          v.HttpRequest.GetHost(),
+			URIPath:       v.HttpRequest.GetUriPath(),
+			Headers:       getHTTPHeader(v.HttpRequest.GetHeaders()),
+			ContentLength: v.HttpRequest.GetContentLength(),
+		}
+	case *pb.EventValue_HttpResponse:
+		arg.Value = trace.ProtoHTTPResponse{
+			Status:        v.HttpResponse.GetStatus(),
+			StatusCode:    int(v.HttpResponse.GetStatusCode()),
+			Protocol:      v.HttpResponse.GetProtocol(),
+			Headers:       getHTTPHeader(v.HttpResponse.GetHeaders()),
+			ContentLength: v.HttpResponse.GetContentLength(),
+		}
+	case *pb.EventValue_Struct:
+		arg.Value = v.Struct.AsMap()
+	default:
+		return arg, errfmt.Errorf("unsupported value type %T of argument %s", v, value.GetName())
+	}
+
+	return arg, nil
+}
+
+func getSockaddrMap(v *pb.SockAddr) map[string]string {
+	sockaddr := map[string]string{"sa_family": v.GetSaFamily().String()}
+
+	switch v.GetSaFamily() {
+	case pb.SaFamilyT_AF_INET:
+		sockaddr["sin_port"] = strconv.FormatUint(uint64(v.GetSinPort()), 10)
+		sockaddr["sin_addr"] = v.GetSinAddr()
+	case pb.SaFamilyT_AF_UNIX:
+		sockaddr["sun_path"] = v.GetSunPath()
+	case pb.SaFamilyT_AF_INET6:
+		sockaddr["sin6_port"] = strconv.FormatUint(uint64(v.GetSin6Port()), 10)
+		sockaddr["sin6_flowinfo"] = strconv.FormatUint(uint64(v.GetSin6Flowinfo()), 10)
+		sockaddr["sin6_scopeid"] = strconv.FormatUint(uint64(v.GetSin6Scopeid()), 10)
+		sockaddr["sin6_addr"] = v.GetSin6Addr()
+	}
+
+	return sockaddr
+}
+
+func getCredentials(v *pb.Credentials) trace.SlimCred {
+	return trace.SlimCred{
+		Uid:            v.GetUid().GetValue(),
+		Gid:            v.GetGid().GetValue(),
+		Suid:           v.GetSuid().GetValue(),
+		Sgid:           v.GetSgid().GetValue(),
+		Euid:           v.GetEuid().GetValue(),
+		Egid:           v.GetEgid().GetValue(),
+		Fsuid:          v.GetFsuid().GetValue(),
+		Fsgid:          v.GetFsgid().GetValue(),
+		UserNamespace:  v.GetUserNamespace().GetValue(),
+		SecureBits:     v.GetSecureBits().GetValue(),
+		CapInheritable: getCapsMask(v.GetCapInheritable()),
+		CapPermitted:   getCapsMask(v.GetCapPermitted()),
+		CapEffective:   getCapsMask(v.GetCapEffective()),
+		CapBounding:    getCapsMask(v.GetCapBounding()),
+		CapAmbient:     getCapsMask(v.GetCapAmbient()),
+	}
+}
+
+func getCapsMask(caps []pb.Capability) uint64 {
+	var c uint64
+	for _, capability := range caps {
+		c |= 1 << uint64(capability)
+	}
+
+	return c
+}
+
+// getTriggeredBy returns the triggering event in the format of the event derivation:
+// its id, name and arguments, and its return value
+func getTriggeredBy(v *pb.TriggeredBy) (map[string]interface{}, error) {
+	args, returnValue, err := getArguments(v.GetData())
+	if err != nil {
+		return nil, err
+	}
+	if args == nil {
+		args = []trace.Argument{}
+	}
+
+	return map[string]interface{}{
+		"id":          int(v.GetId()),
+		"name":        v.GetName(),
+		"args":        args,
+		"returnValue": returnValue,
+	}, nil
+}
+
+func getIPv4(v *pb.IPv4) trace.ProtoIPv4 {
+	return trace.ProtoIPv4{
+		Version:    uint8(v.GetVersion()),
+		IHL:        uint8(v.GetIhl()),
+		TOS:        uint8(v.GetTos()),
+		Length:     uint16(v.GetLength()),
+		Id:         uint16(v.GetId()),
+		Flags:      uint8(v.GetFlags()),
+		FragOffset: uint16(v.GetFragOffset()),
+		TTL:        uint8(v.GetTtl()),
+		Protocol:   v.GetProtocol(),
+		Checksum:   uint16(v.GetChecksum()),
+		SrcIP:      v.GetSrcIp(),
+		DstIP:      v.GetDstIp(),
+	}
+}
+
+func getIPv6(v *pb.IPv6) trace.ProtoIPv6 {
+	return trace.ProtoIPv6{
+		Version:      uint8(v.GetVersion()),
+		TrafficClass: uint8(v.GetTrafficClass()),
+		FlowLabel:    v.GetFlowLabel(),
+		Length:       uint16(v.GetLength()),
+		NextHeader:   v.GetNextHeader(),
+		HopLimit:     uint8(v.GetHopLimit()),
+		SrcIP:        v.GetSrcIp(),
+		DstIP:        v.GetDstIp(),
+	}
+}
+
+func getTCP(v *pb.TCP) trace.ProtoTCP {
+	return trace.ProtoTCP{
+		SrcPort:    uint16(v.GetSrcPort()),
+		DstPort:    uint16(v.GetDstPort()),
+		Seq:        v.GetSeq(),
+		Ack:        v.GetAck(),
+		DataOffset: uint8(v.GetDataOffset()),
+		FIN:        uint8(v.GetFinFlag()),
+		SYN:        uint8(v.GetSynFlag()),
+		RST:        uint8(v.GetRstFlag()),
+		PSH:        uint8(v.GetPshFlag()),
+		ACK:        uint8(v.GetAckFlag()),
+		URG:        uint8(v.GetUrgFlag()),
+		ECE:        uint8(v.GetEceFlag()),
+		CWR:        uint8(v.GetCwrFlag()),
+		NS:         uint8(v.GetNsFlag()),
+		Window:     uint16(v.GetWindow()),
+		Checksum:   uint16(v.GetChecksum()),
+		Urgent:     uint16(v.GetUrgent()),
+	}
+}
+
+func getDNS(v *pb.DNS) trace.ProtoDNS {
+	questions := make([]trace.ProtoDNSQuestion, 0, len(v.GetQuestions()))
+	for _, q := range v.GetQuestions() {
+		questions = append(questions, trace.ProtoDNSQuestion{
+			Name:  q.GetName(),
+			Type:  q.GetType(),
+			Class: q.GetClass(),
+		})
+	}
+
+	return trace.ProtoDNS{
+		ID:           uint16(v.GetId()),
+		QR:           uint8(v.GetQr()),
+		OpCode:       v.GetOpCode(),
+		AA:           uint8(v.GetAa()),
+		TC:           uint8(v.GetTc()),
+		RD:           uint8(v.GetRd()),
+		RA:           uint8(v.GetRa()),
+		Z:            uint8(v.GetZ()),
+		ResponseCode: v.GetResponseCode(),
+		QDCount:      uint16(v.GetQdCount()),
+		ANCount:      uint16(v.GetAnCount()),
+		NSCount:      uint16(v.GetNsCount()),
+		ARCount:      uint16(v.GetArCount()),
+		Questions:    questions,
+		Answers:      getDNSResourceRecords(v.GetAnswers()),
+		Authorities:  getDNSResourceRecords(v.GetAuthorities()),
+		Additionals:  getDNSResourceRecords(v.GetAdditionals()),
+	}
+}
+
+func getDNSResourceRecords(records []*pb.DNSResourceRecord) []trace.ProtoDNSResourceRecord {
+	out := make([]trace.ProtoDNSResourceRecord, 0, len(records))
+	for _, r := range records {
+		opts := make([]trace.ProtoDNSOPT, 0, len(r.GetOpt()))
+		for _, o := range r.GetOpt() {
+			opts = append(opts, trace.ProtoDNSOPT{Code: o.GetCode(), Data: o.GetData()})
+		}
+
+		out = append(out, trace.ProtoDNSResourceRecord{
+			Name:  r.GetName(),
+			Type:  r.GetType(),
+			Class: r.GetClass(),
+			TTL:   r.GetTtl(),
+			IP:    r.GetIp(),
+			NS:    r.GetNs(),
+			CNAME: r.GetCname(),
+			PTR:   r.GetPtr(),
+			TXTs:  r.GetTxts(),
+			SOA: trace.ProtoDNSSOA{
+				MName:   r.GetSoa().GetMname(),
+				RName:   r.GetSoa().GetRname(),
+				Serial:  r.GetSoa().GetSerial(),
+				Refresh: r.GetSoa().GetRefresh(),
+				Retry:   r.GetSoa().GetRetry(),
+				Expire:  r.GetSoa().GetExpire(),
+				Minimum: r.GetSoa().GetMinimum(),
+			},
+			SRV: trace.ProtoDNSSRV{
+				Priority: uint16(r.GetSrv().GetPriority()),
+				Weight:   uint16(r.GetSrv().GetWeight()),
+				Port:     uint16(r.GetSrv().GetPort()),
+				Name:     r.GetSrv().GetName(),
+			},
+			MX: trace.ProtoDNSMX{
+				Preference: uint16(r.GetMx().GetPreference()),
+				Name:       r.GetMx().GetName(),
+			},
+			OPT: opts,
+			URI: trace.ProtoDNSURI{
+				Priority: uint16(r.GetUri().GetPriority()),
+				Weight:   uint16(r.GetUri().GetWeight()),
+				Target:   r.GetUri().GetTarget(),
+			},
+			TXT: r.GetTxt(),
+		})
+	}
+
+	return out
+}
+
+func getDNSQueryData(v *pb.DnsQueryData) trace.DnsQueryData {
+	return trace.DnsQueryData{
+		Query:      v.GetQuery(),
+		QueryType:  v.GetQueryType(),
+		QueryClass: v.GetQueryClass(),
+	}
+}
+
+// getPacketMetadata returns the packet metadata of the packet events, or the packet
+// metadata of the network events if it only holds the direction
+func getPacketMetadata(v *pb.PacketMetadata) interface{} {
+	if v.GetSrcIp() == "" && v.GetDstIp() == "" && v.GetDirection() != pb.PacketDirection_INVALID {
+		return trace.PacketMetadata{Direction: trace.PacketDirection(v.GetDirection())}
+	}
+
+	return trace.PktMeta{
+		SrcIP:     v.GetSrcIp(),
+		DstIP:     v.GetDstIp(),
+		SrcPort:   uint16(v.GetSrcPort()),
+		DstPort:   uint16(v.GetDstPort()),
+		Protocol:  uint8(v.GetProtocol()),
+		PacketLen: v.GetPacketLen(),
+		Iface:     v.GetIface(),
+	}
+}
+
+func getHTTPHeader(headers map[string]*pb.HttpHeader) http.Header {
+	out := make(http.Header, len(headers))
+	for k, v := range headers {
+		out[k] = v.GetHeader()
+	}
+
+	return out
+}
@@ -0,0 +1,838 @@
+package protoevent
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	pb "github.com/khulnasoft-lab/tracker/api/v1beta1"
+	"github.com/khulnasoft-lab/tracker/pkg/events/builtin"
+	"github.com/khulnasoft-lab/tracker/types/trace"
+)
+
+// eventTranslationTable translates the builtin events, by name, to their protobuf Event Id
+var eventTranslationTable = map[string]pb.EventId{
+	// syscall translation section
+	"read":                         pb.EventId_read,
+	"write":                        pb.EventId_write,
+	"open":                         pb.EventId_open,
+	"close":                        pb.EventId_close,
+	"stat":                         pb.EventId_stat,
+	"fstat":                        pb.EventId_fstat,
+	"lstat":                        pb.EventId_lstat,
+	"poll":                         pb.EventId_poll,
+	"lseek":                        pb.EventId_lseek,
+	"mmap":                         pb.EventId_mmap,
+	"mprotect":                     pb.EventId_mprotect,
+	"munmap":                       pb.EventId_munmap,
+	"brk":                          pb.EventId_brk,
+	"rt_sigaction":                 pb.EventId_rt_sigaction,
+	"rt_sigprocmask":               pb.EventId_rt_sigprocmask,
+	"rt_sigreturn":                 pb.EventId_rt_sigreturn,
+	"ioctl":                        pb.EventId_ioctl,
+	"pread64":                      pb.EventId_pread64,
+	"pwrite64":                     pb.EventId_pwrite64,
+	"readv":                        pb.EventId_readv,
+	"writev":                       pb.EventId_writev,
+	"access":                       pb.EventId_access,
+	"pipe":                         pb.EventId_pipe,
+	"select":                       pb.EventId_select,
+	"sched_yield":                  pb.EventId_sched_yield,
+	"mremap":                       pb.EventId_mremap,
+	"msync":                        pb.EventId_msync,
+	"mincore":                      pb.EventId_mincore,
+	"madvise":                      pb.EventId_madvise,
+	"shmget":                       pb.EventId_shmget,
+	"shmat":                        pb.EventId_shmat,
+	"shmctl":                       pb.EventId_shmctl,
+	"dup":                          pb.EventId_dup,
+	"dup2":                         pb.EventId_dup2,
+	"pause":                        pb.EventId_pause,
+	"nanosleep":                    pb.EventId_nanosleep,
+	"getitimer":                    pb.EventId_getitimer,
+	"alarm":                        pb.EventId_alarm,
+	"setitimer":                    pb.EventId_setitimer,
+	"getpid":                       pb.EventId_getpid,
+	"sendfile":                     pb.EventId_sendfile,
+	"socket":                       pb.EventId_socket,
+	"connect":                      pb.EventId_connect,
+	"accept":                       pb.EventId_accept,
+	"sendto":                       pb.EventId_sendto,
+	"recvfrom":                     pb.EventId_recvfrom,
+	"sendmsg":                      pb.EventId_sendmsg,
+	"recvmsg":                      pb.EventId_recvmsg,
+	"shutdown":                     pb.EventId_shutdown,
+	"bind":                         pb.EventId_bind,
+	"listen":                       pb.EventId_listen,
+	"getsockname":                  pb.EventId_getsockname,
+	"getpeername":                  pb.EventId_getpeername,
+	"socketpair":                   pb.EventId_socketpair,
+	"setsockopt":                   pb.EventId_setsockopt,
+	"getsockopt":                   pb.EventId_getsockopt,
+	"clone":                        pb.EventId_clone,
+	"fork":                         pb.EventId_fork,
+	"vfork":                        pb.EventId_vfork,
+	"execve":                       pb.EventId_execve,
+	"exit":                         pb.EventId_exit,
+	"wait4":                        pb.EventId_wait4,
+	"kill":                         pb.EventId_kill,
+	"uname":                        pb.EventId_uname,
+	"semget":                       pb.EventId_semget,
+	"semop":                        pb.EventId_semop,
+	"semctl":                       pb.EventId_semctl,
+	"shmdt":                        pb.EventId_shmdt,
+	"msgget":                       pb.EventId_msgget,
+	"msgsnd":                       pb.EventId_msgsnd,
+	"msgrcv":                       pb.EventId_msgrcv,
+	"msgctl":                       pb.EventId_msgctl,
+	"fcntl":                        pb.EventId_fcntl,
+	"flock":                        pb.EventId_flock,
+	"fsync":                        pb.EventId_fsync,
+	"fdatasync":                    pb.EventId_fdatasync,
+	"truncate":                     pb.EventId_truncate,
+	"ftruncate":                    pb.EventId_ftruncate,
+	"getdents":                     pb.EventId_getdents,
+	"getcwd":                       pb.EventId_getcwd,
+	"chdir":                        pb.EventId_chdir,
+	"fchdir":                       pb.EventId_fchdir,
+	"rename":                       pb.EventId_rename,
+	"mkdir":                        pb.EventId_mkdir,
+	"rmdir":                        pb.EventId_rmdir,
+	"creat":                        pb.EventId_creat,
+	"link":                         pb.EventId_link,
+	"unlink":                       pb.EventId_unlink,
+	"symlink":                      pb.EventId_symlink,
+	"readlink":                     pb.EventId_readlink,
+	"chmod":                        pb.EventId_chmod,
+	"fchmod":                       pb.EventId_fchmod,
+	"chown":                        pb.EventId_chown,
+	"fchown":                       pb.EventId_fchown,
+	"lchown":                       pb.EventId_lchown,
+	"umask":                        pb.EventId_umask,
+	"gettimeofday":                 pb.EventId_gettimeofday,
+	"getrlimit":                    pb.EventId_getrlimit,
+	"getrusage":                    pb.EventId_getrusage,
+	"sysinfo":                      pb.EventId_sysinfo,
+	"times":                        pb.EventId_times,
+	"ptrace":                       pb.EventId_ptrace,
+	"getuid":                       pb.EventId_getuid,
+	"syslog":                       pb.EventId_syslog,
+	"getgid":                       pb.EventId_getgid,
+	"setuid":                       pb.EventId_setuid,
+	"setgid":                       pb.EventId_setgid,
+	"geteuid":                      pb.EventId_geteuid,
+	"getegid":                      pb.EventId_getegid,
+	"setpgid":                      pb.EventId_setpgid,
+	"getppid":                      pb.EventId_getppid,
+	"getpgrp":                      pb.EventId_getpgrp,
+	"setsid":                       pb.EventId_setsid,
+	"setreuid":                     pb.EventId_setreuid,
+	"setregid":                     pb.EventId_setregid,
+	"getgroups":                    pb.EventId_getgroups,
+	"setgroups":                    pb.EventId_setgroups,
+	"setresuid":                    pb.EventId_setresuid,
+	"getresuid":                    pb.EventId_getresuid,
+	"setresgid":                    pb.EventId_setresgid,
+	"getresgid":                    pb.EventId_getresgid,
+	"getpgid":                      pb.EventId_getpgid,
+	"setfsuid":                     pb.EventId_setfsuid,
+	"setfsgid":                     pb.EventId_setfsgid,
+	"getsid":                       pb.EventId_getsid,
+	"capget":                       pb.EventId_capget,
+	"capset":                       pb.EventId_capset,
+	"rt_sigpending":                pb.EventId_rt_sigpending,
+	"rt_sigtimedwait":              pb.EventId_rt_sigtimedwait,
+	"rt_sigqueueinfo":              pb.EventId_rt_sigqueueinfo,
+	"rt_sigsuspend":                pb.EventId_rt_sigsuspend,
+	"sigaltstack":                  pb.EventId_sigaltstack,
+	"utime":                        pb.EventId_utime,
+	"mknod":                        pb.EventId_mknod,
+	"uselib":                       pb.EventId_uselib,
+	"personality":                  pb.EventId_personality,
+	"ustat":                        pb.EventId_ustat,
+	"statfs":                       pb.EventId_statfs,
+	"fstatfs":                      pb.EventId_fstatfs,
+	"sysfs":                        pb.EventId_sysfs,
+	"getpriority":                  pb.EventId_getpriority,
+	"setpriority":                  pb.EventId_setpriority,
+	"sched_setparam":               pb.EventId_sched_setparam,
+	"sched_getparam":               pb.EventId_sched_getparam,
+	"sched_setscheduler":           pb.EventId_sched_setscheduler,
+	"sched_getscheduler":           pb.EventId_sched_getscheduler,
+	"sched_get_priority_max":       pb.EventId_sched_get_priority_max,
+	"sched_get_priority_min":       pb.EventId_sched_get_priority_min,
+	"sched_rr_get_interval":        pb.EventId_sched_rr_get_interval,
+	"mlock":                        pb.EventId_mlock,
+	"munlock":                      pb.EventId_munlock,
+	"mlockall":                     pb.EventId_mlockall,
+	"munlockall":                   pb.EventId_munlockall,
+	"vhangup":                      pb.EventId_vhangup,
+	"modify_ldt":                   pb.EventId_modify_ldt,
+	"pivot_root":                   pb.EventId_pivot_root,
+	"sysctl":                       pb.EventId_sysctl,
+	"prctl":                        pb.EventId_prctl,
+	"arch_prctl":                   pb.EventId_arch_prctl,
+	"adjtimex":                     pb.EventId_adjtimex,
+	"setrlimit":                    pb.EventId_setrlimit,
+	"chroot":                       pb.EventId_chroot,
+	"sync":                         pb.EventId_sync,
+	"acct":                         pb.EventId_acct,
+	"settimeofday":                 pb.EventId_settimeofday,
+	"mount":                        pb.EventId_mount,
+	"umount2":                      pb.EventId_umount2,
+	"swapon":                       pb.EventId_swapon,
+	"swapoff":                      pb.EventId_swapoff,
+	"reboot":                       pb.EventId_reboot,
+	"sethostname":                  pb.EventId_sethostname,
+	"setdomainname":                pb.EventId_setdomainname,
+	"iopl":                         pb.EventId_iopl,
+	"ioperm":                       pb.EventId_ioperm,
+	"create_module":                pb.EventId_create_module,
+	"init_module":                  pb.EventId_init_module,
+	"delete_module":                pb.EventId_delete_module,
+	"get_kernel_syms":              pb.EventId_get_kernel_syms,
+	"query_module":                 pb.EventId_query_module,
+	"quotactl":                     pb.EventId_quotactl,
+	"nfsservctl":                   pb.EventId_nfsservctl,
+	"getpmsg":                      pb.EventId_getpmsg,
+	"putpmsg":                      pb.EventId_putpmsg,
+	"afs":                          pb.EventId_afs,
+	"tuxcall":                      pb.EventId_tuxcall,
+	"security":                     pb.EventId_security,
+	"gettid":                       pb.EventId_gettid,
+	"readahead":                    pb.EventId_readahead,
+	"setxattr":                     pb.EventId_setxattr,
+	"lsetxattr":                    pb.EventId_lsetxattr,
+	"fsetxattr":                    pb.EventId_fsetxattr,
+	"getxattr":                     pb.EventId_getxattr,
+	"lgetxattr":                    pb.EventId_lgetxattr,
+	"fgetxattr":                    pb.EventId_fgetxattr,
+	"listxattr":                    pb.EventId_listxattr,
+	"llistxattr":                   pb.EventId_llistxattr,
+	"flistxattr":                   pb.EventId_flistxattr,
+	"removexattr":                  pb.EventId_removexattr,
+	"lremovexattr":                 pb.EventId_lremovexattr,
+	"fremovexattr":                 pb.EventId_fremovexattr,
+	"tkill":                        pb.EventId_tkill,
+	"time":                         pb.EventId_time,
+	"futex":                        pb.EventId_futex,
+	"sched_setaffinity":            pb.EventId_sched_setaffinity,
+	"sched_getaffinity":            pb.EventId_sched_getaffinity,
+	"set_thread_area":              pb.EventId_set_thread_area,
+	"io_setup":                     pb.EventId_io_setup,
+	"io_destroy":                   pb.EventId_io_destroy,
+	"io_getevents":                 pb.EventId_io_getevents,
+	"io_submit":                    pb.EventId_io_submit,
+	"io_cancel":                    pb.EventId_io_cancel,
+	"get_thread_area":              pb.EventId_get_thread_area,
+	"lookup_dcookie":               pb.EventId_lookup_dcookie,
+	"epoll_create":                 pb.EventId_epoll_create,
+	"epoll_ctl_old":                pb.EventId_epoll_ctl_old,
+	"epoll_wait_old":               pb.EventId_epoll_wait_old,
+	"remap_file_pages":             pb.EventId_remap_file_pages,
+	"getdents64":                   pb.EventId_getdents64,
+	"set_tid_address":              pb.EventId_set_tid_address,
+	"restart_syscall":              pb.EventId_restart_syscall,
+	"semtimedop":                   pb.EventId_semtimedop,
+	"fadvise64":                    pb.EventId_fadvise64,
+	"timer_create":                 pb.EventId_timer_create,
+	"timer_settime":                pb.EventId_timer_settime,
+	"timer_gettime":                pb.EventId_timer_gettime,
+	"timer_getoverrun":             pb.EventId_timer_getoverrun,
+	"timer_delete":                 pb.EventId_timer_delete,
+	"clock_settime":                pb.EventId_clock_settime,
+	"clock_gettime":                pb.EventId_clock_gettime,
+	"clock_getres":                 pb.EventId_clock_getres,
+	"clock_nanosleep":              pb.EventId_clock_nanosleep,
+	"exit_group":                   pb.EventId_exit_group,
+	"epoll_wait":                   pb.EventId_epoll_wait,
+	"epoll_ctl":                    pb.EventId_epoll_ctl,
+	"tgkill":                       pb.EventId_tgkill,
+	"utimes":                       pb.EventId_utimes,
+	"vserver":                      pb.EventId_vserver,
+	"mbind":                        pb.EventId_mbind,
+	"set_mempolicy":                pb.EventId_set_mempolicy,
+	"get_mempolicy":                pb.EventId_get_mempolicy,
+	"mq_open":                      pb.EventId_mq_open,
+	"mq_unlink":                    pb.EventId_mq_unlink,
+	"mq_timedsend":                 pb.EventId_mq_timedsend,
+	"mq_timedreceive":              pb.EventId_mq_timedreceive,
+	"mq_notify":                    pb.EventId_mq_notify,
+	"mq_getsetattr":                pb.EventId_mq_getsetattr,
+	"kexec_load":                   pb.EventId_kexec_load,
+	"waitid":                       pb.EventId_waitid,
+	"add_key":                      pb.EventId_add_key,
+	"request_key":                  pb.EventId_request_key,
+	"keyctl":                       pb.EventId_keyctl,
+	"ioprio_set":                   pb.EventId_ioprio_set,
+	"ioprio_get":                   pb.EventId_ioprio_get,
+	"inotify_init":                 pb.EventId_inotify_init,
+	"inotify_add_watch":            pb.EventId_inotify_add_watch,
+	"inotify_rm_watch":             pb.EventId_inotify_rm_watch,
+	"migrate_pages":                pb.EventId_migrate_pages,
+	"openat":                       pb.EventId_openat,
+	"mkdirat":                      pb.EventId_mkdirat,
+	"mknodat":                      pb.EventId_mknodat,
+	"fchownat":                     pb.EventId_fchownat,
+	"futimesat":                    pb.EventId_futimesat,
+	"newfstatat":                   pb.EventId_newfstatat,
+	"unlinkat":                     pb.EventId_unlinkat,
+	"renameat":                     pb.EventId_renameat,
+	"linkat":                       pb.EventId_linkat,
+	"symlinkat":                    pb.EventId_symlinkat,
+	"readlinkat":                   pb.EventId_readlinkat,
+	"fchmodat":                     pb.EventId_fchmodat,
+	"faccessat":                    pb.EventId_faccessat,
+	"pselect6":                     pb.EventId_pselect6,
+	"ppoll":                        pb.EventId_ppoll,
+	"unshare":                      pb.EventId_unshare,
+	"set_robust_list":              pb.EventId_set_robust_list,
+	"get_robust_list":              pb.EventId_get_robust_list,
+	"splice":                       pb.EventId_splice,
+	"tee":                          pb.EventId_tee,
+	"sync_file_range":              pb.EventId_sync_file_range,
+	"vmsplice":                     pb.EventId_vmsplice,
+	"move_pages":                   pb.EventId_move_pages,
+	"utimensat":                    pb.EventId_utimensat,
+	"epoll_pwait":                  pb.EventId_epoll_pwait,
+	"signalfd":                     pb.EventId_signalfd,
+	"timerfd_create":               pb.EventId_timerfd_create,
+	"eventfd":                      pb.EventId_eventfd,
+	"fallocate":                    pb.EventId_fallocate,
+	"timerfd_settime":              pb.EventId_timerfd_settime,
+	"timerfd_gettime":              pb.EventId_timerfd_gettime,
+	"accept4":                      pb.EventId_accept4,
+	"signalfd4":                    pb.EventId_signalfd4,
+	"eventfd2":                     pb.EventId_eventfd2,
+	"epoll_create1":                pb.EventId_epoll_create1,
+	"dup3":                         pb.EventId_dup3,
+	"pipe2":                        pb.EventId_pipe2,
+	"inotify_init1":                pb.EventId_inotify_init1,
+	"preadv":                       pb.EventId_preadv,
+	"pwritev":                      pb.EventId_pwritev,
+	"rt_tgsigqueueinfo":            pb.EventId_rt_tgsigqueueinfo,
+	"perf_event_open":              pb.EventId_perf_event_open,
+	"recvmmsg":                     pb.EventId_recvmmsg,
+	"fanotify_init":                pb.EventId_fanotify_init,
+	"fanotify_mark":                pb.EventId_fanotify_mark,
+	"prlimit64":                    pb.EventId_prlimit64,
+	"name_to_handle_at":            pb.EventId_name_to_handle_at,
+	"open_by_handle_at":            pb.EventId_open_by_handle_at,
+	"clock_adjtime":                pb.EventId_clock_adjtime,
+	"syncfs":                       pb.EventId_syncfs,
+	"sendmmsg":                     pb.EventId_sendmmsg,
+	"setns":                        pb.EventId_setns,
+	"getcpu":                       pb.EventId_getcpu,
+	"process_vm_readv":             pb.EventId_process_vm_readv,
+	"process_vm_writev":            pb.EventId_process_vm_writev,
+	"kcmp":                         pb.EventId_kcmp,
+	"finit_module":                 pb.EventId_finit_module,
+	"sched_setattr":                pb.EventId_sched_setattr,
+	"sched_getattr":                pb.EventId_sched_getattr,
+	"renameat2":                    pb.EventId_renameat2,
+	"seccomp":                      pb.EventId_seccomp,
+	"getrandom":                    pb.EventId_getrandom,
+	"memfd_create":                 pb.EventId_memfd_create,
+	"kexec_file_load":              pb.EventId_kexec_file_load,
+	"bpf":                          pb.EventId_bpf,
+	"execveat":                     pb.EventId_execveat,
+	"userfaultfd":                  pb.EventId_userfaultfd,
+	"membarrier":                   pb.EventId_membarrier,
+	"mlock2":                       pb.EventId_mlock2,
+	"copy_file_range":              pb.EventId_copy_file_range,
+	"preadv2":                      pb.EventId_preadv2,
+	"pwritev2":                     pb.EventId_pwritev2,
+	"pkey_mprotect":                pb.EventId_pkey_mprotect,
+	"pkey_alloc":                   pb.EventId_pkey_alloc,
+	"pkey_free":                    pb.EventId_pkey_free,
+	"statx":                        pb.EventId_statx,
+	"io_pgetevents":                pb.EventId_io_pgetevents,
+	"rseq":                         pb.EventId_rseq,
+	"pidfd_send_signal":            pb.EventId_pidfd_send_signal,
+	"io_uring_setup":               pb.EventId_io_uring_setup,
+	"io_uring_enter":               pb.EventId_io_uring_enter,
+	"io_uring_register":            pb.EventId_io_uring_register,
+	"open_tree":                    pb.EventId_open_tree,
+	"move_mount":                   pb.EventId_move_mount,
+	"fsopen":                       pb.EventId_fsopen,
+	"fsconfig":                     pb.EventId_fsconfig,
+	"fsmount":                      pb.EventId_fsmount,
+	"fspick":                       pb.EventId_fspick,
+	"pidfd_open":                   pb.EventId_pidfd_open,
+	"clone3":                       pb.EventId_clone3,
+	"close_range":                  pb.EventId_close_range,
+	"openat2":                      pb.EventId_openat2,
+	"pidfd_getfd":                  pb.EventId_pidfd_getfd,
+	"faccessat2":                   pb.EventId_faccessat2,
+	"process_madvise":              pb.EventId_process_madvise,
+	"epoll_pwait2":                 pb.EventId_epoll_pwait2,
+	"mount_setattr":                pb.EventId_mount_setattr,
+	"quotactl_fd":                  pb.EventId_quotactl_fd,
+	"landlock_create_ruleset":      pb.EventId_landlock_create_ruleset,
+	"landlock_add_rule":            pb.EventId_landlock_add_rule,
+	"landlock_restrict_self":       pb.EventId_landlock_restrict_self,
+	"memfd_secret":                 pb.EventId_memfd_secret,
+	"process_mrelease":             pb.EventId_process_mrelease,
+	"waitpid":                      pb.EventId_waitpid,
+	"oldfstat":                     pb.EventId_oldfstat,
+	"break":                        pb.EventId_break,
+	"oldstat":                      pb.EventId_oldstat,
+	"umount":                       pb.EventId_umount,
+	"stime":                        pb.EventId_stime,
+	"stty":                         pb.EventId_stty,
+	"gtty":                         pb.EventId_gtty,
+	"nice":                         pb.EventId_nice,
+	"ftime":                        pb.EventId_ftime,
+	"prof":                         pb.EventId_prof,
+	"signal":                       pb.EventId_signal,
+	"lock":                         pb.EventId_lock,
+	"mpx":                          pb.EventId_mpx,
+	"ulimit":                       pb.EventId_ulimit,
+	"oldolduname":                  pb.EventId_oldolduname,
+	"sigaction":                    pb.EventId_sigaction,
+	"sgetmask":                     pb.EventId_sgetmask,
+	"ssetmask":                     pb.EventId_ssetmask,
+	"sigsuspend":                   pb.EventId_sigsuspend,
+	"sigpending":                   pb.EventId_sigpending,
+	"oldlstat":                     pb.EventId_oldlstat,
+	"readdir":                      pb.EventId_readdir,
+	"profil":                       pb.EventId_profil,
+	"socketcall":                   pb.EventId_socketcall,
+	"olduname":                     pb.EventId_olduname,
+	"idle":                         pb.EventId_idle,
+	"vm86old":                      pb.EventId_vm86old,
+	"ipc":                          pb.EventId_ipc,
+	"sigreturn":                    pb.EventId_sigreturn,
+	"sigprocmask":                  pb.EventId_sigprocmask,
+	"bdflush":                      pb.EventId_bdflush,
+	"afs_syscall":                  pb.EventId_afs_syscall,
+	"llseek":                       pb.EventId_llseek,
+	"old_select":                   pb.EventId_old_select,
+	"vm86":                         pb.EventId_vm86,
+	"old_getrlimit":                pb.EventId_old_getrlimit,
+	"mmap2":                        pb.EventId_mmap2,
+	"truncate64":                   pb.EventId_truncate64,
+	"ftruncate64":                  pb.EventId_ftruncate64,
+	"stat64":                       pb.EventId_stat64,
+	"lstat64":                      pb.EventId_lstat64,
+	"fstat64":                      pb.EventId_fstat64,
+	"lchown16":                     pb.EventId_lchown16,
+	"getuid16":                     pb.EventId_getuid16,
+	"getgid16":                     pb.EventId_getgid16,
+	"geteuid16":                    pb.EventId_geteuid16,
+	"getegid16":                    pb.EventId_getegid16,
+	"setreuid16":                   pb.EventId_setreuid16,
+	"setregid16":                   pb.EventId_setregid16,
+	"getgroups16":                  pb.EventId_getgroups16,
+	"setgroups16":                  pb.EventId_setgroups16,
+	"fchown16":                     pb.EventId_fchown16,
+	"setresuid16":                  pb.EventId_setresuid16,
+	"getresuid16":                  pb.EventId_getresuid16,
+	"setresgid16":                  pb.EventId_setresgid16,
+	"getresgid16":                  pb.EventId_getresgid16,
+	"chown16":                      pb.EventId_chown16,
+	"setuid16":                     pb.EventId_setuid16,
+	"setgid16":                     pb.EventId_setgid16,
+	"setfsuid16":                   pb.EventId_setfsuid16,
+	"setfsgid16":                   pb.EventId_setfsgid16,
+	"fcntl64":                      pb.EventId_fcntl64,
+	"sendfile32":                   pb.EventId_sendfile32,
+	"statfs64":                     pb.EventId_statfs64,
+	"fstatfs64":                    pb.EventId_fstatfs64,
+	"fadvise64_64":                 pb.EventId_fadvise64_64,
+	"clock_gettime32":              pb.EventId_clock_gettime32,
+	"clock_settime32":              pb.EventId_clock_settime32,
+	"clock_adjtime64":              pb.EventId_clock_adjtime64,
+	"clock_getres_time32":          pb.EventId_clock_getres_time32,
+	"clock_nanosleep_time32":       pb.EventId_clock_nanosleep_time32,
+	"timer_gettime32":              pb.EventId_timer_gettime32,
+	"timer_settime32":              pb.EventId_timer_settime32,
+	"timerfd_gettime32":            pb.EventId_timerfd_gettime32,
+	"timerfd_settime32":            pb.EventId_timerfd_settime32,
+	"utimensat_time32":             pb.EventId_utimensat_time32,
+	"pselect6_time32":              pb.EventId_pselect6_time32,
+	"ppoll_time32":                 pb.EventId_ppoll_time32,
+	"io_pgetevents_time32":         pb.EventId_io_pgetevents_time32,
+	"recvmmsg_time32":              pb.EventId_recvmmsg_time32,
+	"mq_timedsend_time32":          pb.EventId_mq_timedsend_time32,
+	"mq_timedreceive_time32":       pb.EventId_mq_timedreceive_time32,
+	"rt_sigtimedwait_time32":       pb.EventId_rt_sigtimedwait_time32,
+	"futex_time32":                 pb.EventId_futex_time32,
+	"sched_rr_get_interval_time32": pb.EventId_sched_rr_get_interval_time32,
+
+	// Common events translation section
+	"net_packet_base":                pb.EventId_net_packet_base,
+	"net_packet_ip_base":             pb.EventId_net_packet_ip_base,
+	"net_packet_tcp_base":            pb.EventId_net_packet_tcp_base,
+	"net_packet_udp_base":            pb.EventId_net_packet_udp_base,
+	"net_packet_icmp_base":           pb.EventId_net_packet_icmp_base,
+	"net_packet_icmpv6_base":         pb.EventId_net_packet_icmpv6_base,
+	"net_packet_dns_base":            pb.EventId_net_packet_dns_base,
+	"net_packet_http_base":           pb.EventId_net_packet_http_base,
+	"net_packet_capture":             pb.EventId_net_packet_capture,
+	"net_packet_flow_base":           pb.EventId_net_packet_flow,
+	"sys_enter":                      pb.EventId_sys_enter,
+	"sys_exit":                       pb.EventId_sys_exit,
+	"sched_process_fork":             pb.EventId_sched_process_fork,
+	"sched_process_exec":             pb.EventId_sched_process_exec,
+	"sched_process_exit":             pb.EventId_sched_process_exit,
+	"sched_switch":                   pb.EventId_sched_switch,
+	"do_exit":                        pb.EventId_do_exit,
+	"cap_capable":                    pb.EventId_cap_capable,
+	"vfs_write":                      pb.EventId_vfs_write,
+	"vfs_writev":                     pb.EventId_vfs_writev,
+	"vfs_read":                       pb.EventId_vfs_read,
+	"vfs_readv":                      pb.EventId_vfs_readv,
+	"mem_prot_alert":                 pb.EventId_mem_prot_alert,
+	"commit_creds":                   pb.EventId_commit_creds,
+	"switch_task_ns":                 pb.EventId_switch_task_ns,
+	"magic_write":                    pb.EventId_magic_write,
+	"cgroup_attach_task":             pb.EventId_cgroup_attach_task,
+	"cgroup_mkdir":                   pb.EventId_cgroup_mkdir,
+	"cgroup_rmdir":                   pb.EventId_cgroup_rmdir,
+	"security_bprm_check":            pb.EventId_security_bprm_check,
+	"security_file_open":             pb.EventId_security_file_open,
+	"security_inode_unlink":          pb.EventId_security_inode_unlink,
+	"security_socket_create":         pb.EventId_security_socket_create,
+	"security_socket_listen":         pb.EventId_security_socket_listen,
+	"security_socket_connect":        pb.EventId_security_socket_connect,
+	"security_socket_accept":         pb.EventId_security_socket_accept,
+	"security_socket_bind":           pb.EventId_security_socket_bind,
+	"security_socket_setsockopt":     pb.EventId_security_socket_setsockopt,
+	"security_sb_mount":              pb.EventId_security_sb_mount,
+	"security_bpf":                   pb.EventId_security_bpf,
+	"security_bpf_map":               pb.EventId_security_bpf_map,
+	"security_kernel_read_file":      pb.EventId_security_kernel_read_file,
+	"security_inode_mknod":           pb.EventId_security_inode_mknod,
+	"security_kernel_post_read_file": pb.EventId_security_post_read_file,
+	"security_inode_symlink":         pb.EventId_security_inode_symlink_event_id,
+	"security_mmap_file":             pb.EventId_security_mmap_file,
+	"security_file_mprotect":         pb.EventId_security_file_mprotect,
+	"socket_dup":                     pb.EventId_socket_dup,
+	"hidden_inodes":                  pb.EventId_hidden_inodes,
+	"__kernel_write":                 pb.EventId_kernel_write,
+	"proc_create":                    pb.EventId_proc_create,
+	"kprobe_attach":                  pb.EventId_kprobe_attach,
+	"call_usermodehelper":            pb.EventId_call_usermode_helper,
+	"dirty_pipe_splice":              pb.EventId_dirty_pipe_splice,
+	"debugfs_create_file":            pb.EventId_debugfs_create_file,
+	"syscall_table_check":            pb.EventId_syscall_table_check,
+	"debugfs_create_dir":             pb.EventId_debugfs_create_dir,
+	"device_add":                     pb.EventId_device_add,
+	"register_chrdev":                pb.EventId_register_chrdev,
+	"shared_object_loaded":           pb.EventId_shared_object_loaded,
+	"do_init_module":                 pb.EventId_do_init_module,
+	"socket_accept":                  pb.EventId_socket_accept,
+	"load_elf_phdrs":                 pb.EventId_load_elf_phdrs,
+	"hooked_proc_fops":               pb.EventId_hooked_proc_fops,
+	"print_net_seq_ops":              pb.EventId_print_net_seq_ops,
+	"task_rename":                    pb.EventId_task_rename,
+	"security_inode_rename":          pb.EventId_security_inode_rename,
+	"do_sigaction":                   pb.EventId_do_sigaction,
+	"bpf_attach":                     pb.EventId_bpf_attach,
+	"kallsyms_lookup_name":           pb.EventId_kallsyms_lookup_name,
+	"do_mmap":                        pb.EventId_do_mmap,
+	"print_mem_dump":                 pb.EventId_print_mem_dump,
+	"vfs_utimes":                     pb.EventId_vfs_utimes,
+	"do_truncate":                    pb.EventId_do_truncate,
+	"file_modification":              pb.EventId_file_modification,
+	"inotify_watch":                  pb.EventId_inotify_watch,
+	"security_bpf_prog":              pb.EventId_security_bpf_prog,
+	"process_execute_failed":         pb.EventId_process_execute_failed,
+	"security_path_notify":           pb.EventId_security_path_notify,
+	"set_fs_pwd":                     pb.EventId_set_fs_pwd,
+	"hidden_kernel_module_seeker":    pb.EventId_hidden_kernel_module_seeker,
+	"module_load":                    pb.EventId_module_load,
+	"module_free":                    pb.EventId_module_free,
+	"execute_finished":               pb.EventId_execute_finished,
+	"security_bprm_creds_for_exec":   pb.EventId_security_bprm_creds_for_exec,
+
+	// Events from user-space translation section
+	"net_packet_ipv4":          pb.EventId_net_packet_ipv4,
+	"net_packet_ipv6":          pb.EventId_net_packet_ipv6,
+	"net_packet_tcp":           pb.EventId_net_packet_tcp,
+	"net_packet_udp":           pb.EventId_net_packet_udp,
+	"net_packet_icmp":          pb.EventId_net_packet_icmp,
+	"net_packet_icmpv6":        pb.EventId_net_packet_icmpv6,
+	"net_packet_dns":           pb.EventId_net_packet_dns,
+	"net_packet_dns_request":   pb.EventId_net_packet_dns_request,
+	"net_packet_dns_response":  pb.EventId_net_packet_dns_response,
+	"net_packet_http":          pb.EventId_net_packet_http,
+	"net_packet_http_request":  pb.EventId_net_packet_http_request,
+	"net_packet_http_response": pb.EventId_net_packet_http_response,
+	"net_flow_tcp_begin":       pb.EventId_net_flow_tcp_begin,
+	"net_flow_tcp_end":         pb.EventId_net_flow_tcp_end,
+	"net_tcp_connect":          pb.EventId_net_tcp_connect,
+	"init_namespaces":          pb.EventId_init_namespaces,
+	"container_create":         pb.EventId_container_create,
+	"container_remove":         pb.EventId_container_remove,
+	"existing_container":       pb.EventId_existing_container,
+	"hooked_syscall":           pb.EventId_hooked_syscall,
+	"hooked_seq_ops":           pb.EventId_hooked_seq_ops,
+	"symbols_loaded":           pb.EventId_symbols_loaded,
+	"symbols_collision":        pb.EventId_symbols_collision,
+	"hidden_kernel_module":     pb.EventId_hidden_kernel_module,
+	"ftrace_hook":              pb.EventId_ftrace_hook,
+}
+
+func getExternalID(e trace.Event) pb.EventId {
+	// Only use translation table if is built-in events (below 10,000)
+	if e.EventID <= builtin.MaxBuiltinID {
+		event, _ := builtin.LookupID(e.EventID)
+		return eventTranslationTable[event.Name]
+	}
+	return pb.EventId(e.EventID)
+}
+
+// FromTrace converts a tracker event to its protobuf representation
+func FromTrace(e trace.Event) (*pb.Event, error) {
+	process := getProcess(e)
+	container := getContainer(e)
+	k8s := getK8s(e)
+	idExternal := getExternalID(e)
+
+	var eventContext *pb.Context
+	if process != nil || container != nil || k8s != nil {
+		eventContext = &pb.Context{
+			Process:   process,
+			Container: container,
+			K8S:       k8s,
+		}
+	}
+
+	eventData, err := getEventData(e)
+	if err != nil {
+		return nil, err
+	}
+
+	var threat *pb.Threat
+	if e.Metadata != nil {
+		threat = Threat(e.Metadata.Description, e.Metadata.Properties)
+	}
+
+	event := &pb.Event{
+		Id:   idExternal,
+		Name: e.EventName,
+		Policies: &pb.Policies{
+			Matched: e.MatchedPolicies,
+		},
+		Context: eventContext,
+		Threat:  threat,
+		Labels:  e.Labels,
+
+		Data: eventData,
+	}
+
+	if e.Timestamp != 0 {
+		event.Timestamp = timestamppb.New(time.Unix(0, int64(e.Timestamp)))
+	}
+
+	return event, nil
+}
+
+func getProcess(e trace.Event) *pb.Process {
+	var userStackTrace *pb.UserStackTrace
+
+	if len(e.StackAddresses) > 0 {
+		userStackTrace = &pb.UserStackTrace{
+			Addresses: getStackAddress(e.StackAddresses),
+		}
+	}
+
+	var threadStartTime *timestamp.Timestamp
+	if e.ThreadStartTime != 0 {
+		threadStartTime = timestamppb.New(time.Unix(0, int64(e.ThreadStartTime)))
+	}
+
+	var executable *pb.Executable
+	if e.Executable.Path != "" {
+		executable = &pb.Executable{Path: e.Executable.Path}
+	}
+
+	ancestors := getAncestors(e)
+
+	return &pb.Process{
+		Executable: executable,
+		UniqueId:   wrapperspb.UInt32(e.ProcessEntityId),
+		HostPid:    wrapperspb.UInt32(uint32(e.HostProcessID)),
+		Pid:        wrapperspb.UInt32(uint32(e.ProcessID)),
+		RealUser: &pb.User{
+			Id: wrapperspb.UInt32(uint32(e.UserID)),
+		},
+		Thread: &pb.Thread{
+			StartTime:      threadStartTime,
+			Name:           e.ProcessName,
+			UniqueId:       wrapperspb.UInt32(e.ThreadEntityId),
+			HostTid:        wrapperspb.UInt32(uint32(e.HostThreadID)),
+			Tid:            wrapperspb.UInt32(uint32(e.ThreadID)),
+			Syscall:        e.Syscall,
+			Compat:         e.ContextFlags.ContainerStarted,
+			UserStackTrace: userStackTrace,
+		},
+		Ancestors: ancestors,
+	}
+}
+
+func getAncestors(e trace.Event) []*pb.Process {
+	var ancestors []*pb.Process
+	if e.ParentEntityId != 0 {
+		ancestors = append(ancestors, &pb.Process{
+			UniqueId: wrapperspb.UInt32(e.ParentEntityId),
+			HostPid:  wrapperspb.UInt32(uint32(e.HostParentProcessID)),
+			Pid:      wrapperspb.UInt32(uint32(e.ParentProcessID)),
+		})
+	}
+	return ancestors
+}
+
+func getContainer(e trace.Event) *pb.Container {
+	if e.Container.ID == "" && e.Container.Name == "" {
+		return nil
+	}
+
+	container := &pb.Container{
+		Id:   e.Container.ID,
+		Name: e.Container.Name,
+	}
+
+	if e.Container.ImageName != "" {
+		var repoDigest []string
+		if e.Container.ImageDigest != "" {
+			repoDigest = []string{e.Container.ImageDigest}
+		}
+
+		container.Image = &pb.ContainerImage{
+			Name:        e.Container.ImageName,
+			RepoDigests: repoDigest,
+		}
+	}
+
+	return container
+}
+
+func getK8s(e trace.Event) *pb.K8S {
+	if e.Kubernetes.PodName == "" &&
+		e.Kubernetes.PodUID == "" &&
+		e.Kubernetes.PodNamespace == "" {
+		return nil
+	}
+
+	return &pb.K8S{
+		Namespace: &pb.K8SNamespace{
+			Name: e.Kubernetes.PodNamespace,
+		},
+		Pod: &pb.Pod{
+			Name: e.Kubernetes.PodName,
+			Uid:  e.Kubernetes.PodUID,
+		},
+	}
+}
+
+// Threat returns the threat of an event, or nil if the metadata isn't the one of a
+// signature
+func Threat(description string, metadata map[string]interface{}) *pb.Threat {
+	if metadata == nil {
+		return nil
+	}
+	// if metadata doesn't contain severity, it's not a threat,
+	// severity is set when we have an event created from a signature
+	// pkg/ebpf/fiding.go
+	// pkg/cmd/initialize/sigs.go
+	_, ok := metadata["Severity"]
+	if !ok {
+		return nil
+	}
+
+	var (
+		mitreTactic        string
+		mitreTechniqueId   string
+		mitreTechniqueName string
+		name               string
+	)
+
+	if _, ok := metadata["Category"]; ok {
+		if val, ok := metadata["Category"].(string); ok {
+			mitreTactic = val
+		}
+	}
+
+	if _, ok := metadata["external_id"]; ok {
+		if val, ok := metadata["external_id"].(string); ok {
+			mitreTechniqueId = val
+		}
+	}
+
+	if _, ok := metadata["Technique"]; ok {
+		if val, ok := metadata["Technique"].(string); ok {
+			mitreTechniqueName = val
+		}
+	}
+
+	if _, ok := metadata["signatureName"]; ok {
+		if val, ok := metadata["signatureName"].(string); ok {
+			name = val
+		}
+	}
+
+	properties := make(map[string]string)
+
+	for k, v := range metadata {
+		if k == "Category" ||
+			k == "external_id" ||
+			k == "Technique" ||
+			k == "Severity" ||
+			k == "signatureName" {
+			continue
+		}
+
+		properties[k] = fmt.Sprint(v)
+	}
+
+	return &pb.Threat{
+		Description: description,
+		Mitre: &pb.Mitre{
+			Tactic: &pb.MitreTactic{
+				Name: mitreTactic,
+			},
+			Technique: &pb.MitreTechnique{
+				Id:   mitreTechniqueId,
+				Name: mitreTechniqueName,
+			},
+		},
+		Severity:   getSeverity(metadata),
+		Name:       name,
+		Properties: properties,
+	}
+}
+
+func getSeverity(metadata map[string]interface{}) pb.Severity {
+	severityValue, ok := metadata["Severity"].(int)
+	if ok {
+		switch severityValue {
+		case 0:
+			return pb.Severity_INFO
+		case 1:
+			return pb.Severity_LOW
+		case 2:
+			return pb.Severity_MEDIUM
+		case 3:
+			return pb.Severity_HIGH
+		case 4:
+			return pb.Severity_CRITICAL
+		}
+	}
+
+	return pb.Severity_INFO
+}
+
+func getStackAddress(stackAddresses []uint64) []*pb.StackAddress {
+	var out []*pb.StackAddress
+	for _, addr := range stackAddresses {
+		out = append(out, &pb.StackAddress{Address: addr})
+	}
+
+	return out
+}
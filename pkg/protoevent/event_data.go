@@ -1,4 +1,4 @@
-package grpc
+package protoevent
 
 import (
 	"fmt"
@@ -11,7 +11,7 @@ import (
 
 	pb "github.com/khulnasoft-lab/tracker/api/v1beta1"
 	"github.com/khulnasoft-lab/tracker/pkg/errfmt"
-	"github.com/khulnasoft-lab/tracker/pkg/events"
+	"github.com/khulnasoft-lab/tracker/pkg/events/builtin"
 	"github.com/khulnasoft-lab/tracker/pkg/logger"
 	"github.com/khulnasoft-lab/tracker/types/detect"
 	"github.com/khulnasoft-lab/tracker/types/trace"
@@ -59,7 +59,7 @@ func getEventData(e trace.Event) ([]*pb.EventValue, error) {
 		data = append(data, eventValue)
 	}
 
-	if events.Core.GetDefinitionByID(events.ID(e.EventID)).IsSyscall() {
+	if event, ok := builtin.LookupID(e.EventID); ok && event.Syscall {
 		data = append(data, &pb.EventValue{
 			Name: "returnValue",
 			Value: &pb.EventValue_Int64{
@@ -465,7 +465,7 @@ func getTriggerBy(triggeredByArg trace.Argument) (*pb.TriggeredBy, error) {
 		data = append(data, eventValue)
 	}
 
-	if events.Core.GetDefinitionByID(events.ID(id)).IsSyscall() {
+	if event, ok := builtin.LookupID(id); ok && event.Syscall {
 		data = append(data, &pb.EventValue{
 			Name: "returnValue",
 			Value: &pb.EventValue_Int64{
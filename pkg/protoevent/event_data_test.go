@@ -1,4 +1,4 @@
-package grpc
+package protoevent
 
 import (
 	"reflect"
@@ -8,7 +8,7 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	pb "github.com/khulnasoft-lab/tracker/api/v1beta1"
-	"github.com/khulnasoft-lab/tracker/pkg/events"
+	"github.com/khulnasoft-lab/tracker/pkg/events/builtin"
 	"github.com/khulnasoft-lab/tracker/types/trace"
 )
 
@@ -649,12 +649,14 @@ func Test_getEventData(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			id := int(events.CommitCreds)
+			name := "commit_creds"
 			if tt.syscall {
-				id = int(events.Ptrace)
+				name = "ptrace"
 			}
+			event, ok := builtin.Lookup(name)
+			assert.True(t, ok)
 
-			eventData, err := getEventData(trace.Event{EventID: id, Args: tt.args, ReturnValue: tt.returnValue})
+			eventData, err := getEventData(trace.Event{EventID: event.ID, Args: tt.args, ReturnValue: tt.returnValue})
 			assert.NoError(t, err)
 
 			assert.Equal(t, len(tt.expected), len(eventData))
@@ -717,6 +719,10 @@ func Test_getEventData(t *testing.T) {
 }
 
 func TestEventTrigger(t *testing.T) {
+	ptrace, ok := builtin.Lookup("ptrace")
+	assert.True(t, ok)
+	ptraceID := ptrace.ID
+
 	event := trace.Event{
 		EventID: 6001,
 		Args: []trace.Argument{
@@ -733,7 +739,7 @@ func TestEventTrigger(t *testing.T) {
 					Type: "unknown",
 				},
 				Value: map[string]interface{}{
-					"id":   int(events.Ptrace),
+					"id":   ptraceID,
 					"name": "ptrace",
 					"args": []trace.Argument{
 						{
@@ -764,7 +770,7 @@ func TestEventTrigger(t *testing.T) {
 			Name: "triggeredBy",
 			Value: &pb.EventValue_TriggeredBy{
 				TriggeredBy: &pb.TriggeredBy{
-					Id:   101,
+					Id:   uint32(ptraceID),
 					Name: "ptrace",
 					Data: []*pb.EventValue{
 						{
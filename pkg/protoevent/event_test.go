@@ -0,0 +1,278 @@
+package protoevent
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/khulnasoft-lab/tracker/api/v1beta1"
+	"github.com/khulnasoft-lab/tracker/pkg/events/builtin"
+	"github.com/khulnasoft-lab/tracker/types/trace"
+)
+
+func eventID(t *testing.T, name string) int {
+	t.Helper()
+
+	event, ok := builtin.Lookup(name)
+	require.True(t, ok, name)
+
+	return event.ID
+}
+
+func Test_convertEventWithProcessContext(t *testing.T) {
+	t.Parallel()
+
+	unixTime := int(time.Now().UnixNano())
+
+	traceEvent := trace.Event{
+		Timestamp:           unixTime,
+		ThreadStartTime:     unixTime,
+		ProcessID:           1,
+		ThreadID:            2,
+		HostProcessID:       3,
+		HostThreadID:        4,
+		ParentProcessID:     5,
+		HostParentProcessID: 6,
+		UserID:              7,
+		ProcessName:         "processTest",
+		EventID:             eventID(t, "execve"),
+		EventName:           "eventTest",
+		MatchedPolicies:     []string{"policyTest"},
+		Syscall:             "syscall",
+		ContextFlags:        trace.ContextFlags{ContainerStarted: true},
+		ThreadEntityId:      9,
+		ProcessEntityId:     10,
+		ParentEntityId:      11,
+	}
+
+	protoEvent, err := FromTrace(traceEvent)
+	assert.NoError(t, err)
+
+	assert.Equal(t, uint32(1), protoEvent.Context.Process.Pid.Value)
+	assert.Equal(t, uint32(2), protoEvent.Context.Process.Thread.Tid.Value)
+	assert.Equal(t, uint32(3), protoEvent.Context.Process.HostPid.Value)
+	assert.Equal(t, uint32(4), protoEvent.Context.Process.Thread.HostTid.Value)
+	assert.Equal(t, uint32(5), protoEvent.Context.Process.Ancestors[0].Pid.Value)
+	assert.Equal(t, uint32(6), protoEvent.Context.Process.Ancestors[0].HostPid.Value)
+	assert.Equal(t, uint32(7), protoEvent.Context.Process.RealUser.Id.Value)
+	assert.Equal(t, pb.EventId_execve, protoEvent.Id)
+	assert.Equal(t, uint32(9), protoEvent.Context.Process.Thread.UniqueId.Value)
+	assert.Equal(t, uint32(10), protoEvent.Context.Process.UniqueId.Value)
+	assert.Equal(t, uint32(11), protoEvent.Context.Process.Ancestors[0].UniqueId.Value)
+	assert.Equal(t, "eventTest", protoEvent.Name)
+	assert.Equal(t, []string{"policyTest"}, protoEvent.Policies.Matched)
+	assert.Equal(t, "processTest", protoEvent.Context.Process.Thread.Name)
+	assert.Equal(t, "syscall", protoEvent.Context.Process.Thread.Syscall)
+	assert.Equal(t, true, protoEvent.Context.Process.Thread.Compat)
+}
+
+func Test_convertEventWithStackaddresses(t *testing.T) {
+	t.Parallel()
+
+	traceEvent := trace.Event{
+		StackAddresses: []uint64{1, 2, 3},
+	}
+
+	protoEvent, err := FromTrace(traceEvent)
+	assert.NoError(t, err)
+
+	expected := []*pb.StackAddress{
+		{Address: 1},
+		{Address: 2},
+		{Address: 3},
+	}
+
+	for i := range expected {
+		assert.Equal(t, expected[i].Address, protoEvent.Context.Process.Thread.UserStackTrace.Addresses[i].Address)
+	}
+}
+
+func Test_convertEventWithContainerContext(t *testing.T) {
+	t.Parallel()
+
+	traceEvent := trace.Event{
+		Container: trace.Container{
+			ID:          "containerID",
+			Name:        "containerName",
+			ImageName:   "imageName",
+			ImageDigest: "imageDigest",
+		},
+	}
+
+	protoEvent, err := FromTrace(traceEvent)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "containerID", protoEvent.Context.Container.Id)
+	assert.Equal(t, "containerName", protoEvent.Context.Container.Name)
+	assert.Equal(t, "imageName", protoEvent.Context.Container.Image.Name)
+	assert.Equal(t, []string{"imageDigest"}, protoEvent.Context.Container.Image.RepoDigests)
+}
+
+func Test_convertEventWithK8sContext(t *testing.T) {
+	t.Parallel()
+
+	traceEvent := trace.Event{
+		Kubernetes: trace.Kubernetes{
+			PodName:      "podName",
+			PodNamespace: "podNamespace",
+			PodUID:       "podUID",
+		},
+	}
+
+	protoEvent, err := FromTrace(traceEvent)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "podName", protoEvent.Context.K8S.Pod.Name)
+	assert.Equal(t, "podNamespace", protoEvent.Context.K8S.Namespace.Name)
+	assert.Equal(t, "podUID", protoEvent.Context.K8S.Pod.Uid)
+}
+
+func Test_convertEventWithThreat(t *testing.T) {
+	t.Parallel()
+
+	traceEvent := trace.Event{
+		Metadata: &trace.Metadata{
+			Description: "An attempt to abuse the Docker UNIX ..",
+			Properties: map[string]interface{}{
+				"Severity":    2,
+				"Category":    "privilege-escalation",
+				"Technique":   "Exploitation for Privilege Escalation",
+				"external_id": "T1068",
+			},
+		},
+	}
+
+	protoEvent, err := FromTrace(traceEvent)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "An attempt to abuse the Docker UNIX ..", protoEvent.Threat.Description)
+	assert.Equal(t, "privilege-escalation", protoEvent.Threat.Mitre.Tactic.Name)
+	assert.Equal(t, "Exploitation for Privilege Escalation", protoEvent.Threat.Mitre.Technique.Name)
+	assert.Equal(t, "T1068", protoEvent.Threat.Mitre.Technique.Id)
+}
+
+func TestToTrace(t *testing.T) {
+	t.Parallel()
+
+	unixTime := int(time.Now().UnixNano())
+
+	testCases := []struct {
+		name  string
+		event trace.Event
+	}{
+		{
+			name: "syscall with process and container context",
+			event: trace.Event{
+				Timestamp:           unixTime,
+				ThreadStartTime:     unixTime,
+				ProcessID:           1,
+				ThreadID:            2,
+				HostProcessID:       3,
+				HostThreadID:        4,
+				ParentProcessID:     5,
+				HostParentProcessID: 6,
+				UserID:              7,
+				ProcessName:         "bash",
+				Executable:          trace.File{Path: "/bin/bash"},
+				ContainerID:         "abc",
+				Container:           trace.Container{ID: "abc", Name: "web", ImageName: "nginx", ImageDigest: "sha256:1"},
+				Kubernetes:          trace.Kubernetes{PodName: "pod", PodNamespace: "default", PodUID: "uid"},
+				Labels:              map[string]string{"cluster": "prod-eu"},
+				EventID:             eventID(t, "execve"),
+				EventName:           "execve",
+				MatchedPolicies:     []string{"default"},
+				ArgsNum:             2,
+				ReturnValue:         -2,
+				Syscall:             "execve",
+				StackAddresses:      []uint64{1, 2},
+				ContextFlags:        trace.ContextFlags{ContainerStarted: true},
+				ThreadEntityId:      9,
+				ProcessEntityId:     10,
+				ParentEntityId:      11,
+				Args: []trace.Argument{
+					{ArgMeta: trace.ArgMeta{Name: "pathname", Type: "const char*"}, Value: "/bin/ls"},
+					{ArgMeta: trace.ArgMeta{Name: "argv", Type: "const char*const*"}, Value: []string{"ls", "-l"}},
+				},
+			},
+		},
+		{
+			name: "sockaddr",
+			event: trace.Event{
+				EventID:   eventID(t, "security_socket_connect"),
+				EventName: "security_socket_connect",
+				ArgsNum:   2,
+				Args: []trace.Argument{
+					{ArgMeta: trace.ArgMeta{Name: "sockfd", Type: "int"}, Value: int32(3)},
+					{
+						ArgMeta: trace.ArgMeta{Name: "remote_addr", Type: "struct sockaddr*"},
+						Value:   map[string]string{"sa_family": "AF_INET", "sin_port": "53", "sin_addr": "10.0.0.1"},
+					},
+				},
+			},
+		},
+		{
+			name: "network",
+			event: trace.Event{
+				EventID:   eventID(t, "net_packet_dns_request"),
+				EventName: "net_packet_dns_request",
+				ArgsNum:   2,
+				Args: []trace.Argument{
+					{
+						ArgMeta: trace.ArgMeta{Name: "metadata", Type: "trace.PktMeta"},
+						Value:   trace.PktMeta{SrcIP: "10.0.0.1", DstIP: "10.0.0.2", SrcPort: 1234, DstPort: 53, Protocol: 17, PacketLen: 60, Iface: "any"},
+					},
+					{
+						ArgMeta: trace.ArgMeta{Name: "dns_questions", Type: "[]trace.DnsQueryData"},
+						Value:   []trace.DnsQueryData{{Query: "example.com", QueryType: "A", QueryClass: "IN"}},
+					},
+				},
+			},
+		},
+		{
+			name: "finding",
+			event: trace.Event{
+				EventName: "anti_debugging",
+				Metadata: &trace.Metadata{
+					Description: "A process used anti-debugging techniques",
+					Properties: map[string]interface{}{
+						"Severity":      3,
+						"Category":      "defense-evasion",
+						"Technique":     "Debugger Evasion",
+						"external_id":   "T1622",
+						"signatureName": "Anti-Debugging detected",
+						"id":            "TRC-102",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			protoEvent, err := FromTrace(tc.event)
+			require.NoError(t, err)
+
+			event, err := ToTrace(protoEvent)
+			require.NoError(t, err)
+			assert.Equal(t, tc.event, event)
+		})
+	}
+}
+
+func TestEventTranslationTable(t *testing.T) {
+	t.Parallel()
+
+	// the table is kept by hand, every event in it must still be a builtin event for
+	// getExternalID to reach it after "make builtin-events"
+	for name, id := range eventTranslationTable {
+		_, ok := builtin.Lookup(name)
+		assert.True(t, ok, "%s is not a builtin event", name)
+		assert.NotEqual(t, pb.EventId_unspecified, id, name)
+	}
+}
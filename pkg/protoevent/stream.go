@@ -0,0 +1,88 @@
+// Package protoevent converts tracker events to and from protobuf events, and reads and
+// writes streams of length-delimited protobuf events, as written by the protobuf output.
+package protoevent
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/khulnasoft-lab/tracker/api/v1beta1"
+	"github.com/khulnasoft-lab/tracker/pkg/errfmt"
+)
+
+// MaxEventSize is the maximum size of an event in a stream, protecting the reader from
+// allocating huge buffers on corrupted input
+const MaxEventSize = 64 << 20
+
+// Writer writes events to a stream, each one prefixed by its size as a varint
+type Writer struct {
+	w   io.Writer
+	buf []byte
+}
+
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// Write writes an event and its size prefix with a single write
+func (w *Writer) Write(event *pb.Event) error {
+	size := proto.Size(event)
+
+	w.buf = protowire.AppendVarint(w.buf[:0], uint64(size))
+	buf, err := proto.MarshalOptions{}.MarshalAppend(w.buf, event)
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+	w.buf = buf
+
+	_, err = w.w.Write(w.buf)
+	return errfmt.WrapError(err)
+}
+
+// Reader reads the events of a stream written by Writer
+type Reader struct {
+	r   *bufio.Reader
+	buf []byte
+}
+
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: bufio.NewReader(r)}
+}
+
+// Read returns the next event of the stream, or io.EOF at the end of the stream. A stream
+// ending in the middle of an event returns io.ErrUnexpectedEOF.
+func (r *Reader) Read() (*pb.Event, error) {
+	size, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, io.EOF
+		}
+		return nil, errfmt.Errorf("unable to read event size: %v", err)
+	}
+	if size > MaxEventSize {
+		return nil, errfmt.Errorf("event size %d exceeds the maximum of %d bytes", size, MaxEventSize)
+	}
+
+	if uint64(cap(r.buf)) < size {
+		r.buf = make([]byte, size)
+	}
+	r.buf = r.buf[:size]
+	if _, err := io.ReadFull(r.r, r.buf); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+
+	event := &pb.Event{}
+	if err := proto.Unmarshal(r.buf, event); err != nil {
+		return nil, errfmt.Errorf("unable to unmarshal event: %v", err)
+	}
+
+	return event, nil
+}
@@ -0,0 +1,88 @@
+package protoevent
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/khulnasoft-lab/tracker/api/v1beta1"
+)
+
+func TestStream(t *testing.T) {
+	t.Parallel()
+
+	written := []*pb.Event{
+		{Name: "openat", Policies: &pb.Policies{Matched: []string{"default"}}},
+		{},
+		{Name: "execve", Data: []*pb.EventValue{
+			{Name: "pathname", Value: &pb.EventValue_Str{Str: "/bin/ls"}},
+		}},
+	}
+
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	for _, event := range written {
+		require.NoError(t, w.Write(event))
+	}
+
+	r := NewReader(&buf)
+	for _, expected := range written {
+		event, err := r.Read()
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(expected, event), "expected %v, got %v", expected, event)
+	}
+
+	_, err := r.Read()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestStreamReadErrors(t *testing.T) {
+	t.Parallel()
+
+	var event bytes.Buffer
+	require.NoError(t, NewWriter(&event).Write(&pb.Event{Name: "openat"}))
+
+	testCases := []struct {
+		name          string
+		stream        []byte
+		expectedError string
+	}{
+		{
+			name:          "truncated event",
+			stream:        event.Bytes()[:event.Len()-1],
+			expectedError: io.ErrUnexpectedEOF.Error(),
+		},
+		{
+			name:          "truncated size",
+			stream:        []byte{0x80},
+			expectedError: "unable to read event size",
+		},
+		{
+			name:          "event too large",
+			stream:        protowire.AppendVarint(nil, MaxEventSize+1),
+			expectedError: "exceeds the maximum",
+		},
+		{
+			name:          "invalid event",
+			stream:        []byte{2, 0xff, 0xff},
+			expectedError: "unable to unmarshal event",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewReader(bytes.NewReader(tc.stream)).Read()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expectedError)
+		})
+	}
+}
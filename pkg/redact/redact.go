@@ -0,0 +1,250 @@
+// Package redact masks sensitive data, such as tokens in command lines or secrets in the
+// exec environment, in the arguments of events before they leave tracker.
+package redact
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/khulnasoft-lab/tracker/pkg/counter"
+	"github.com/khulnasoft-lab/tracker/pkg/errfmt"
+	"github.com/khulnasoft-lab/tracker/types/trace"
+)
+
+// Mask replaces the redacted data
+const Mask = "[REDACTED]"
+
+// Rule masks data in the string values of event arguments
+type Rule struct {
+	Args    []string // names of the arguments the rule applies to, all arguments if empty
+	Pattern string   // regular expression of the data to mask (only its capture groups if it has any), the whole value if empty
+}
+
+// String returns the rule in its flag form
+func (r Rule) String() string {
+	var parts []string
+	if len(r.Args) > 0 {
+		parts = append(parts, "args="+strings.Join(r.Args, ","))
+	}
+	if r.Pattern != "" {
+		parts = append(parts, "pattern="+r.Pattern)
+	}
+
+	return strings.Join(parts, ":")
+}
+
+type rule struct {
+	Rule
+	args map[string]struct{}
+	re   *regexp.Regexp
+	hits counter.Counter // masked values
+}
+
+// applies returns true if the rule applies to the argument
+func (r *rule) applies(name string) bool {
+	if len(r.args) == 0 {
+		return true
+	}
+	_, ok := r.args[name]
+	return ok
+}
+
+// redact returns the value with the rule's matches masked, and the number of masked parts
+func (r *rule) redact(value string) (string, int) {
+	if value == "" || value == Mask {
+		return value, 0
+	}
+	if r.re == nil {
+		return Mask, 1
+	}
+
+	matches := r.re.FindAllStringSubmatchIndex(value, -1)
+	if matches == nil {
+		return value, 0
+	}
+
+	var b strings.Builder
+	last, hits := 0, 0
+	for _, match := range matches {
+		spans := match[:2]
+		if len(match) > 2 {
+			spans = match[2:] // the capture groups only
+		}
+		for i := 0; i < len(spans); i += 2 {
+			start, end := spans[i], spans[i+1]
+			if start < last || start == end || value[start:end] == Mask {
+				continue // unmatched, nested, empty or already masked group
+			}
+			b.WriteString(value[last:start])
+			b.WriteString(Mask)
+			last = end
+			hits++
+		}
+	}
+	if hits == 0 {
+		return value, 0
+	}
+	b.WriteString(value[last:])
+
+	return b.String(), hits
+}
+
+// Redactor masks the data matched by its rules in the arguments of events
+type Redactor struct {
+	rules []*rule
+}
+
+// New returns a redactor for the given rules
+func New(rules []Rule) (*Redactor, error) {
+	r := &Redactor{}
+
+	for _, rl := range rules {
+		if len(rl.Args) == 0 && rl.Pattern == "" {
+			return nil, errfmt.Errorf("redaction rule requires arguments or a pattern")
+		}
+
+		newRule := &rule{Rule: rl, args: map[string]struct{}{}}
+		for _, arg := range rl.Args {
+			if arg == "" {
+				return nil, errfmt.Errorf("empty argument name in redaction rule %q", rl.String())
+			}
+			newRule.args[arg] = struct{}{}
+		}
+		if rl.Pattern != "" {
+			re, err := regexp.Compile(rl.Pattern)
+			if err != nil {
+				return nil, errfmt.Errorf("invalid redaction pattern %q: %v", rl.Pattern, err)
+			}
+			newRule.re = re
+		}
+
+		r.rules = append(r.rules, newRule)
+	}
+
+	return r, nil
+}
+
+// Redact masks the data matched by the rules in the arguments of the event, including the
+// arguments of the event that triggered a finding, and returns true if any was masked.
+// The arguments are copied before they are changed, since they may be shared with other
+// copies of the event.
+func (r *Redactor) Redact(event *trace.Event) bool {
+	if r == nil {
+		return false
+	}
+
+	args, ok := r.redactArgs(event.Args)
+	if ok {
+		event.Args = args
+	}
+
+	return ok
+}
+
+// RegisterPrometheus registers the number of values masked by each rule
+func (r *Redactor) RegisterPrometheus() error {
+	for _, rl := range r.rules {
+		rl := rl
+		err := prometheus.Register(prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace:   "tracker_ebpf",
+			Name:        "redactions_total",
+			Help:        "values masked in event arguments, by redaction rule",
+			ConstLabels: prometheus.Labels{"rule": rl.String()},
+		}, func() float64 { return float64(rl.hits.Get()) }))
+
+		if err != nil {
+			return errfmt.WrapError(err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Redactor) redactArgs(args []trace.Argument) ([]trace.Argument, bool) {
+	var redacted []trace.Argument
+
+	for i := range args {
+		value, ok := r.redactValue(args[i].Name, args[i].Value)
+		if !ok {
+			continue
+		}
+		if redacted == nil {
+			redacted = make([]trace.Argument, len(args))
+			copy(redacted, args)
+		}
+		redacted[i].Value = value
+	}
+
+	if redacted == nil {
+		return args, false
+	}
+
+	return redacted, true
+}
+
+func (r *Redactor) redactValue(name string, value interface{}) (interface{}, bool) {
+	switch v := value.(type) {
+	case string:
+		return r.redactString(name, v)
+
+	case []string:
+		var redacted []string
+		for i, s := range v {
+			s, ok := r.redactString(name, s)
+			if !ok {
+				continue
+			}
+			if redacted == nil {
+				redacted = make([]string, len(v))
+				copy(redacted, v)
+			}
+			redacted[i] = s
+		}
+		if redacted == nil {
+			return value, false
+		}
+		return redacted, true
+
+	case map[string]interface{}:
+		// arguments of the event that triggered a finding
+		if name != "triggeredBy" {
+			return value, false
+		}
+		triggerArgs, ok := v["args"].([]trace.Argument)
+		if !ok {
+			return value, false
+		}
+		triggerArgs, ok = r.redactArgs(triggerArgs)
+		if !ok {
+			return value, false
+		}
+		redacted := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			redacted[key] = val
+		}
+		redacted["args"] = triggerArgs
+		return redacted, true
+	}
+
+	return value, false
+}
+
+func (r *Redactor) redactString(name, value string) (string, bool) {
+	redacted := false
+
+	for _, rl := range r.rules {
+		if !rl.applies(name) {
+			continue
+		}
+		var hits int
+		value, hits = rl.redact(value)
+		if hits > 0 {
+			_ = rl.hits.Increment(uint64(hits))
+			redacted = true
+		}
+	}
+
+	return value, redacted
+}
@@ -0,0 +1,177 @@
+package redact
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/khulnasoft-lab/tracker/types/trace"
+)
+
+func TestRedactor(t *testing.T) {
+	t.Parallel()
+
+	newEvent := func() trace.Event {
+		return trace.Event{
+			EventName: "sched_process_exec",
+			Args: []trace.Argument{
+				{ArgMeta: trace.ArgMeta{Name: "pathname"}, Value: "/usr/bin/curl"},
+				{ArgMeta: trace.ArgMeta{Name: "argv"}, Value: []string{"curl", "-H", "Authorization: Bearer abc123", "--password=hunter2"}},
+				{ArgMeta: trace.ArgMeta{Name: "env"}, Value: []string{"HOME=/root", "AWS_SECRET_ACCESS_KEY=s3cr3t"}},
+				{ArgMeta: trace.ArgMeta{Name: "flags"}, Value: int32(1)},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name         string
+		rules        []Rule
+		expectedArgs []interface{}
+		expectedHits []uint64
+	}{
+		{
+			name:  "pattern in all arguments",
+			rules: []Rule{{Pattern: `Bearer \S+`}},
+			expectedArgs: []interface{}{
+				"/usr/bin/curl",
+				[]string{"curl", "-H", "Authorization: [REDACTED]", "--password=hunter2"},
+				[]string{"HOME=/root", "AWS_SECRET_ACCESS_KEY=s3cr3t"},
+				int32(1),
+			},
+			expectedHits: []uint64{1},
+		},
+		{
+			name:  "capture groups only",
+			rules: []Rule{{Args: []string{"argv", "env"}, Pattern: `(?:--password|AWS_SECRET_ACCESS_KEY)=(.*)`}},
+			expectedArgs: []interface{}{
+				"/usr/bin/curl",
+				[]string{"curl", "-H", "Authorization: Bearer abc123", "--password=[REDACTED]"},
+				[]string{"HOME=/root", "AWS_SECRET_ACCESS_KEY=[REDACTED]"},
+				int32(1),
+			},
+			expectedHits: []uint64{2},
+		},
+		{
+			name:  "whole values",
+			rules: []Rule{{Args: []string{"pathname", "flags"}}},
+			expectedArgs: []interface{}{
+				"[REDACTED]",
+				[]string{"curl", "-H", "Authorization: Bearer abc123", "--password=hunter2"},
+				[]string{"HOME=/root", "AWS_SECRET_ACCESS_KEY=s3cr3t"},
+				int32(1),
+			},
+			expectedHits: []uint64{1},
+		},
+		{
+			name:  "pattern in other arguments",
+			rules: []Rule{{Args: []string{"pathname"}, Pattern: `Bearer \S+`}, {Pattern: `nomatch`}},
+			expectedArgs: []interface{}{
+				"/usr/bin/curl",
+				[]string{"curl", "-H", "Authorization: Bearer abc123", "--password=hunter2"},
+				[]string{"HOME=/root", "AWS_SECRET_ACCESS_KEY=s3cr3t"},
+				int32(1),
+			},
+			expectedHits: []uint64{0, 0},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := New(tc.rules)
+			require.NoError(t, err)
+
+			original := newEvent()
+			event := original
+			redacted := r.Redact(&event)
+
+			args := []interface{}{}
+			for _, arg := range event.Args {
+				args = append(args, arg.Value)
+			}
+			assert.Equal(t, tc.expectedArgs, args)
+			assert.Equal(t, redacted, !assert.ObjectsAreEqual(newEvent().Args, event.Args))
+			// the original arguments are not changed
+			assert.Equal(t, newEvent().Args, original.Args)
+
+			for i, rl := range r.rules {
+				assert.Equal(t, tc.expectedHits[i], rl.hits.Get(), rl.String())
+			}
+		})
+	}
+}
+
+func TestRedactorFinding(t *testing.T) {
+	t.Parallel()
+
+	r, err := New([]Rule{{Args: []string{"argv"}, Pattern: `--token=(\S+)`}})
+	require.NoError(t, err)
+
+	triggerArgs := []trace.Argument{
+		{ArgMeta: trace.ArgMeta{Name: "argv"}, Value: []string{"app", "--token=abc"}},
+	}
+	finding := trace.Event{
+		EventName: "TRC-1",
+		Args: []trace.Argument{
+			{
+				ArgMeta: trace.ArgMeta{Name: "triggeredBy"},
+				Value: map[string]interface{}{
+					"id":   1,
+					"name": "sched_process_exec",
+					"args": triggerArgs,
+				},
+			},
+		},
+	}
+
+	require.True(t, r.Redact(&finding))
+
+	triggeredBy := finding.Args[0].Value.(map[string]interface{})
+	assert.Equal(t, "sched_process_exec", triggeredBy["name"])
+	assert.Equal(t, []string{"app", "--token=[REDACTED]"}, triggeredBy["args"].([]trace.Argument)[0].Value)
+	assert.Equal(t, []string{"app", "--token=abc"}, triggerArgs[0].Value)
+
+	// masked values are not counted again
+	require.False(t, r.Redact(&finding))
+	assert.Equal(t, uint64(1), r.rules[0].hits.Get())
+}
+
+func TestNewErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		rule          Rule
+		expectedError string
+	}{
+		{"empty rule", Rule{}, "redaction rule requires arguments or a pattern"},
+		{"empty argument", Rule{Args: []string{"argv", ""}}, "empty argument name in redaction rule \"args=argv,\""},
+		{"invalid pattern", Rule{Pattern: "("}, "invalid redaction pattern \"(\""},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := New([]Rule{tc.rule})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expectedError)
+		})
+	}
+}
+
+func TestNilRedactor(t *testing.T) {
+	t.Parallel()
+
+	var r *Redactor
+	event := trace.Event{Args: []trace.Argument{{ArgMeta: trace.ArgMeta{Name: "pathname"}, Value: "/etc/shadow"}}}
+
+	assert.False(t, r.Redact(&event))
+	assert.Equal(t, "/etc/shadow", event.Args[0].Value)
+}
@@ -0,0 +1,271 @@
+// Package auth authenticates the callers of the tracker servers, by bearer token, client
+// certificate or unix socket peer credentials, and authorizes their calls by role.
+package auth
+
+import (
+	"bufio"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/khulnasoft-lab/tracker/pkg/errfmt"
+	"github.com/khulnasoft-lab/tracker/pkg/logger"
+)
+
+var (
+	// ErrUnauthenticated is returned for calls without valid credentials
+	ErrUnauthenticated = errors.New("unauthenticated")
+	// ErrPermissionDenied is returned for calls the role of the caller doesn't allow
+	ErrPermissionDenied = errors.New("permission denied")
+)
+
+// Role is the set of calls a caller is allowed to make
+type Role int
+
+const (
+	NoRole   Role = iota // calls open to anyone, such as health checks
+	ReadOnly             // calls which only read, such as streaming events or getting metrics
+	Admin                // all calls, including the ones changing tracker
+)
+
+// ParseRole returns the role of the given name
+func ParseRole(name string) (Role, error) {
+	switch name {
+	case "read-only":
+		return ReadOnly, nil
+	case "admin":
+		return Admin, nil
+	}
+
+	return NoRole, errfmt.Errorf("invalid role %q, use read-only or admin", name)
+}
+
+func (r Role) String() string {
+	switch r {
+	case ReadOnly:
+		return "read-only"
+	case Admin:
+		return "admin"
+	}
+
+	return "none"
+}
+
+// Config configures the transport security of the servers and the roles granted to
+// callers. Callers are allowed any call if no role is granted.
+type Config struct {
+	CertFile     string          // server certificate, enables TLS
+	KeyFile      string          // server certificate key
+	ClientCAFile string          // CA of the client certificates, requires clients to present one (mTLS)
+	TokenFiles   map[string]Role // files of "<client> <token>" lines, and the role of their tokens
+	UIDs         map[uint32]Role // roles of unix socket peers by user id
+	GIDs         map[uint32]Role // roles of unix socket peers by group id
+	CommonNames  map[string]Role // roles of client certificates by subject common name
+}
+
+// Credentials are what a caller presented to the server
+type Credentials struct {
+	Address string               // remote address of the caller
+	Token   string               // bearer token, if given
+	TLS     *tls.ConnectionState // if the connection is secured
+	Peer    *PeerCred            // if the connection is a unix socket
+}
+
+// PeerCred are the credentials of the process on the other end of a unix socket
+type PeerCred struct {
+	PID int32
+	UID uint32
+	GID uint32
+}
+
+type client struct {
+	name string
+	role Role
+}
+
+// Authenticator authenticates and authorizes the callers of the servers
+type Authenticator struct {
+	tlsConfig   *tls.Config
+	tokens      map[string]client
+	uids        map[uint32]Role
+	gids        map[uint32]Role
+	commonNames map[string]Role
+}
+
+// New returns an authenticator for the given config
+func New(cfg Config) (*Authenticator, error) {
+	a := &Authenticator{
+		tokens:      map[string]client{},
+		uids:        cfg.UIDs,
+		gids:        cfg.GIDs,
+		commonNames: cfg.CommonNames,
+	}
+
+	if (cfg.CertFile == "") != (cfg.KeyFile == "") {
+		return nil, errfmt.Errorf("tls requires both a certificate and its key")
+	}
+	if cfg.ClientCAFile != "" && cfg.CertFile == "" {
+		return nil, errfmt.Errorf("client certificates require tls")
+	}
+	if len(cfg.CommonNames) > 0 && cfg.ClientCAFile == "" {
+		return nil, errfmt.Errorf("roles by certificate common name require a client CA")
+	}
+
+	if cfg.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, errfmt.Errorf("loading server certificate: %v", err)
+		}
+		a.tlsConfig = &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			MinVersion:   tls.VersionTLS12,
+		}
+	}
+
+	if cfg.ClientCAFile != "" {
+		pem, err := os.ReadFile(cfg.ClientCAFile)
+		if err != nil {
+			return nil, errfmt.Errorf("reading client CA: %v", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errfmt.Errorf("no certificate found in client CA %s", cfg.ClientCAFile)
+		}
+		a.tlsConfig.ClientCAs = pool
+		a.tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	for file, role := range cfg.TokenFiles {
+		if err := a.readTokens(file, role); err != nil {
+			return nil, err
+		}
+	}
+
+	return a, nil
+}
+
+// readTokens reads the "<client> <token>" lines of a token file, ignoring empty lines and
+// comments
+func (a *Authenticator) readTokens(file string, role Role) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return errfmt.Errorf("reading tokens: %v", err)
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			logger.Errorw("Closing tokens file", "error", err)
+		}
+	}()
+
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return errfmt.Errorf("invalid token in %s line %d, use '<client> <token>'", file, n)
+		}
+		if prev, ok := a.tokens[fields[1]]; ok && prev.role > role {
+			continue // the token is also in a file of a higher role
+		}
+		a.tokens[fields[1]] = client{name: fields[0], role: role}
+	}
+	if err := scanner.Err(); err != nil {
+		return errfmt.Errorf("reading tokens from %s: %v", file, err)
+	}
+
+	return nil
+}
+
+// TLSConfig returns the TLS configuration of the servers, or nil if TLS isn't enabled
+func (a *Authenticator) TLSConfig() *tls.Config {
+	if a == nil || a.tlsConfig == nil {
+		return nil
+	}
+
+	return a.tlsConfig.Clone()
+}
+
+// Enabled returns true if callers must be granted a role to call the servers
+func (a *Authenticator) Enabled() bool {
+	if a == nil {
+		return false
+	}
+
+	return len(a.tokens) > 0 || len(a.uids) > 0 || len(a.gids) > 0 || len(a.commonNames) > 0
+}
+
+// Authorize returns nil if the caller is granted the role required by the call, which is
+// logged otherwise. The error wraps ErrUnauthenticated if the caller has no role, or
+// ErrPermissionDenied if its role is not enough.
+func (a *Authenticator) Authorize(call string, creds Credentials, required Role) error {
+	if required == NoRole || !a.Enabled() {
+		return nil
+	}
+
+	role, identity := a.authenticate(creds)
+
+	var err error
+	switch {
+	case role == NoRole:
+		err = ErrUnauthenticated
+	case role < required:
+		err = fmt.Errorf("%w: %s role required", ErrPermissionDenied, required)
+	default:
+		return nil
+	}
+
+	logger.Warnw("Denied server call", "call", call, "caller", identity, "role", role.String(), "error", err)
+
+	return err
+}
+
+// authenticate returns the highest role granted to the credentials, and a description of
+// the caller identities
+func (a *Authenticator) authenticate(creds Credentials) (Role, string) {
+	var identities []string
+	role, invalid := NoRole, false
+
+	grant := func(r Role) {
+		if r > role {
+			role = r
+		}
+	}
+
+	if creds.Token != "" {
+		if c, ok := a.tokens[creds.Token]; ok {
+			identities = append(identities, "token "+c.name)
+			grant(c.role)
+		} else {
+			identities = append(identities, "invalid token")
+			invalid = true
+		}
+	}
+	if creds.TLS != nil && len(creds.TLS.VerifiedChains) > 0 {
+		cn := creds.TLS.VerifiedChains[0][0].Subject.CommonName
+		identities = append(identities, "cn "+cn)
+		grant(a.commonNames[cn])
+	}
+	if creds.Peer != nil {
+		identities = append(identities, fmt.Sprintf("pid %d uid %d gid %d", creds.Peer.PID, creds.Peer.UID, creds.Peer.GID))
+		grant(a.uids[creds.Peer.UID])
+		grant(a.gids[creds.Peer.GID])
+	}
+	if creds.Address != "" {
+		identities = append(identities, "address "+creds.Address)
+	}
+
+	if invalid {
+		role = NoRole // a wrong token denies the call, even if other credentials would allow it
+	}
+	if len(identities) == 0 {
+		return role, "anonymous"
+	}
+
+	return role, strings.Join(identities, ", ")
+}
@@ -0,0 +1,199 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeCert writes a self-signed certificate and its key to dir
+func writeCert(t *testing.T, dir string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "tracker"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile := filepath.Join(dir, "server.crt")
+	keyFile := filepath.Join(dir, "server.key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+
+	return certFile, keyFile
+}
+
+func TestAuthorize(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	certFile, keyFile := writeCert(t, dir)
+	adminTokens := filepath.Join(dir, "admin-tokens")
+	readTokens := filepath.Join(dir, "read-tokens")
+	require.NoError(t, os.WriteFile(adminTokens, []byte("# admins\nci abc123\n\nops  def456\n"), 0600))
+	require.NoError(t, os.WriteFile(readTokens, []byte("grafana ghi789\nci abc123\n"), 0600))
+
+	a, err := New(Config{
+		CertFile:     certFile,
+		KeyFile:      keyFile,
+		ClientCAFile: certFile,
+		TokenFiles:   map[string]Role{adminTokens: Admin, readTokens: ReadOnly},
+		UIDs:         map[uint32]Role{0: Admin},
+		GIDs:         map[uint32]Role{1001: ReadOnly},
+		CommonNames:  map[string]Role{"prometheus": ReadOnly},
+	})
+	require.NoError(t, err)
+
+	certState := func(cn string) *tls.ConnectionState {
+		return &tls.ConnectionState{
+			VerifiedChains: [][]*x509.Certificate{{{Subject: pkix.Name{CommonName: cn}}}},
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		creds         Credentials
+		required      Role
+		expectedError error
+	}{
+		{"open call", Credentials{}, NoRole, nil},
+		{"anonymous", Credentials{Address: "10.0.0.1:1234"}, ReadOnly, ErrUnauthenticated},
+		{"admin token", Credentials{Token: "abc123"}, Admin, nil},
+		{"admin token in both files", Credentials{Token: "abc123"}, Admin, nil},
+		{"read-only token reading", Credentials{Token: "ghi789"}, ReadOnly, nil},
+		{"read-only token changing", Credentials{Token: "ghi789"}, Admin, ErrPermissionDenied},
+		{"invalid token", Credentials{Token: "nope"}, ReadOnly, ErrUnauthenticated},
+		{"invalid token and root", Credentials{Token: "nope", Peer: &PeerCred{UID: 0}}, ReadOnly, ErrUnauthenticated},
+		{"root", Credentials{Peer: &PeerCred{UID: 0, GID: 0}}, Admin, nil},
+		{"group member", Credentials{Peer: &PeerCred{UID: 1000, GID: 1001}}, ReadOnly, nil},
+		{"other user", Credentials{Peer: &PeerCred{UID: 1000, GID: 1000}}, ReadOnly, ErrUnauthenticated},
+		{"certificate", Credentials{TLS: certState("prometheus")}, ReadOnly, nil},
+		{"certificate changing", Credentials{TLS: certState("prometheus")}, Admin, ErrPermissionDenied},
+		{"other certificate", Credentials{TLS: certState("grafana")}, ReadOnly, ErrUnauthenticated},
+		{"highest role", Credentials{TLS: certState("prometheus"), Token: "def456"}, Admin, nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := a.Authorize("test", tc.creds, tc.required)
+			if tc.expectedError == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tc.expectedError)
+		})
+	}
+}
+
+func TestAuthenticateIdentity(t *testing.T) {
+	t.Parallel()
+
+	a := &Authenticator{
+		tokens: map[string]client{"abc123": {name: "ci", role: Admin}},
+		uids:   map[uint32]Role{0: ReadOnly},
+	}
+
+	role, identity := a.authenticate(Credentials{Token: "abc123", Peer: &PeerCred{PID: 42, UID: 0, GID: 0}})
+	assert.Equal(t, Admin, role)
+	assert.Equal(t, "token ci, pid 42 uid 0 gid 0", identity)
+
+	role, identity = a.authenticate(Credentials{Token: "nope", Address: "10.0.0.1:1234"})
+	assert.Equal(t, NoRole, role)
+	assert.Equal(t, "invalid token, address 10.0.0.1:1234", identity)
+
+	_, identity = a.authenticate(Credentials{})
+	assert.Equal(t, "anonymous", identity)
+}
+
+func TestDisabled(t *testing.T) {
+	t.Parallel()
+
+	var a *Authenticator
+	assert.False(t, a.Enabled())
+	assert.Nil(t, a.TLSConfig())
+	assert.NoError(t, a.Authorize("test", Credentials{}, Admin))
+
+	certFile, keyFile := writeCert(t, t.TempDir())
+	a, err := New(Config{CertFile: certFile, KeyFile: keyFile})
+	require.NoError(t, err)
+	assert.False(t, a.Enabled())
+	assert.NoError(t, a.Authorize("test", Credentials{}, Admin))
+
+	tlsConfig := a.TLSConfig()
+	require.NotNil(t, tlsConfig)
+	assert.Len(t, tlsConfig.Certificates, 1)
+	assert.Equal(t, tls.NoClientCert, tlsConfig.ClientAuth)
+}
+
+func TestNewErrors(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	certFile, keyFile := writeCert(t, dir)
+	badTokens := filepath.Join(dir, "bad-tokens")
+	require.NoError(t, os.WriteFile(badTokens, []byte("ci abc123\njusttoken\n"), 0600))
+
+	testCases := []struct {
+		name          string
+		config        Config
+		expectedError string
+	}{
+		{"certificate without key", Config{CertFile: certFile}, "tls requires both a certificate and its key"},
+		{"client CA without tls", Config{ClientCAFile: certFile}, "client certificates require tls"},
+		{"common names without client CA", Config{CertFile: certFile, KeyFile: keyFile, CommonNames: map[string]Role{"a": Admin}}, "roles by certificate common name require a client CA"},
+		{"invalid key", Config{CertFile: certFile, KeyFile: certFile}, "loading server certificate"},
+		{"invalid client CA", Config{CertFile: certFile, KeyFile: keyFile, ClientCAFile: keyFile}, "no certificate found in client CA"},
+		{"missing token file", Config{TokenFiles: map[string]Role{filepath.Join(dir, "none"): Admin}}, "reading tokens"},
+		{"invalid token line", Config{TokenFiles: map[string]Role{badTokens: Admin}}, "line 2, use '<client> <token>'"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := New(tc.config)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expectedError)
+		})
+	}
+}
+
+func TestParseRole(t *testing.T) {
+	t.Parallel()
+
+	for _, role := range []Role{ReadOnly, Admin} {
+		parsed, err := ParseRole(role.String())
+		require.NoError(t, err)
+		assert.Equal(t, role, parsed)
+	}
+
+	_, err := ParseRole("root")
+	assert.ErrorContains(t, err, "invalid role \"root\", use read-only or admin")
+}
@@ -0,0 +1,204 @@
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"strings"
+
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/khulnasoft-lab/tracker/api/v1beta1"
+	"github.com/khulnasoft-lab/tracker/pkg/errfmt"
+	"github.com/khulnasoft-lab/tracker/pkg/server/auth"
+)
+
+// readOnlyMethods are the calls allowed to the read-only role, all others require admin
+var readOnlyMethods = map[string]struct{}{
+	fullMethod(&pb.TrackerService_ServiceDesc, "StreamEvents"):        {},
+	fullMethod(&pb.TrackerService_ServiceDesc, "GetEventDefinitions"): {},
+	fullMethod(&pb.TrackerService_ServiceDesc, "GetVersion"):          {},
+	fullMethod(&pb.DiagnosticService_ServiceDesc, "GetMetrics"):       {},
+	fullMethod(&pb.PolicyService_ServiceDesc, "ListPolicies"):         {},
+	fullMethod(&pb.PolicyService_ServiceDesc, "GetPolicy"):            {},
+	fullMethod(&pb.ProcessTreeService_ServiceDesc, "GetProcess"):      {},
+	fullMethod(&pb.ProcessTreeService_ServiceDesc, "GetLineage"):      {},
+	fullMethod(&pb.ProcessTreeService_ServiceDesc, "ListChildren"):    {},
+	fullMethod(&pb.DataSourceService_ServiceDesc, "Get"):              {},
+	fullMethod(&pb.DataSourceService_ServiceDesc, "ListDataSources"):  {},
+	fullMethod(&pb.DataSourceService_ServiceDesc, "Describe"):         {},
+}
+
+func fullMethod(desc *grpc.ServiceDesc, method string) string {
+	return "/" + desc.ServiceName + "/" + method
+}
+
+func methodRole(method string) auth.Role {
+	if _, ok := readOnlyMethods[method]; ok {
+		return auth.ReadOnly
+	}
+
+	return auth.Admin
+}
+
+func (s *Server) authorizeUnary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := s.authorize(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func (s *Server) authorizeStream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := s.authorize(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+
+	return handler(srv, ss)
+}
+
+// authorize checks the credentials of the call against the role its method requires
+func (s *Server) authorize(ctx context.Context, method string) error {
+	var creds auth.Credentials
+
+	if p, ok := peer.FromContext(ctx); ok {
+		if p.Addr != nil && p.Addr.Network() != "unix" {
+			creds.Address = p.Addr.String()
+		}
+		if info, ok := p.AuthInfo.(authInfo); ok {
+			creds.Peer = info.peer
+			if info.tls != nil {
+				creds.TLS = &info.tls.State
+			}
+		}
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, value := range md.Get("authorization") {
+			if token, ok := strings.CutPrefix(value, "Bearer "); ok {
+				creds.Token = token
+			}
+		}
+	}
+
+	err := s.auth.Authorize(method, creds, methodRole(method))
+	switch {
+	case errors.Is(err, auth.ErrUnauthenticated):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, auth.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	return err
+}
+
+// authInfo is the auth info of the connections, with the TLS state of secured connections
+// and the peer credentials of unix socket connections
+type authInfo struct {
+	credentials.CommonAuthInfo
+	tls  *credentials.TLSInfo
+	peer *auth.PeerCred
+}
+
+func (i authInfo) AuthType() string {
+	if i.tls != nil {
+		return i.tls.AuthType()
+	}
+
+	return "insecure"
+}
+
+// transportCredentials secure the connections with TLS, if configured, and get the peer
+// credentials of unix socket connections
+type transportCredentials struct {
+	tls credentials.TransportCredentials
+}
+
+func newTransportCredentials(config *tls.Config) credentials.TransportCredentials {
+	c := &transportCredentials{}
+	if config != nil {
+		c.tls = credentials.NewTLS(config)
+	}
+
+	return c
+}
+
+func (c *transportCredentials) ServerHandshake(rawConn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	info := authInfo{CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.NoSecurity}}
+
+	if unixConn, ok := rawConn.(*net.UnixConn); ok {
+		peerCred, err := getPeerCred(unixConn)
+		if err != nil {
+			return nil, nil, err
+		}
+		info.peer = peerCred
+	}
+
+	if c.tls == nil {
+		return rawConn, info, nil
+	}
+
+	conn, tlsAuthInfo, err := c.tls.ServerHandshake(rawConn)
+	if err != nil {
+		return nil, nil, err
+	}
+	tlsInfo := tlsAuthInfo.(credentials.TLSInfo)
+	info.tls = &tlsInfo
+	info.CommonAuthInfo = tlsInfo.CommonAuthInfo
+
+	return conn, info, nil
+}
+
+func (c *transportCredentials) ClientHandshake(ctx context.Context, authority string, rawConn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return nil, nil, errfmt.Errorf("server credentials can't be used by clients")
+}
+
+func (c *transportCredentials) Info() credentials.ProtocolInfo {
+	if c.tls != nil {
+		return c.tls.Info()
+	}
+
+	return credentials.ProtocolInfo{SecurityProtocol: "insecure"}
+}
+
+func (c *transportCredentials) Clone() credentials.TransportCredentials {
+	clone := &transportCredentials{}
+	if c.tls != nil {
+		clone.tls = c.tls.Clone()
+	}
+
+	return clone
+}
+
+func (c *transportCredentials) OverrideServerName(string) error {
+	return nil
+}
+
+// getPeerCred returns the credentials of the process on the other end of the socket
+func getPeerCred(conn *net.UnixConn) (*auth.PeerCred, error) {
+	rawConn, err := conn.SyscallConn()
+	if err != nil {
+		return nil, errfmt.WrapError(err)
+	}
+
+	var ucred *unix.Ucred
+	var credErr error
+	err = rawConn.Control(func(fd uintptr) {
+		ucred, credErr = unix.GetsockoptUcred(int(fd), unix.SOL_SOCKET, unix.SO_PEERCRED)
+	})
+	if err != nil {
+		return nil, errfmt.WrapError(err)
+	}
+	if credErr != nil {
+		return nil, errfmt.Errorf("getting unix socket peer credentials: %v", credErr)
+	}
+
+	return &auth.PeerCred{PID: ucred.Pid, UID: ucred.Uid, GID: ucred.Gid}, nil
+}
@@ -0,0 +1,118 @@
+package grpc
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/khulnasoft-lab/tracker/api/v1beta1"
+	"github.com/khulnasoft-lab/tracker/pkg/server/auth"
+)
+
+func TestServerAuth(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	tokens := filepath.Join(dir, "admin-tokens")
+	require.NoError(t, os.WriteFile(tokens, []byte("ci abc123\n"), 0600))
+
+	uid := uint32(os.Getuid())
+
+	testCases := []struct {
+		name              string
+		uids              map[uint32]auth.Role
+		token             string
+		expectedVersion   codes.Code
+		expectedStackCode codes.Code
+	}{
+		{
+			name:              "read-only peer",
+			uids:              map[uint32]auth.Role{uid: auth.ReadOnly},
+			expectedVersion:   codes.OK,
+			expectedStackCode: codes.PermissionDenied,
+		},
+		{
+			name:              "admin peer",
+			uids:              map[uint32]auth.Role{uid: auth.Admin},
+			expectedVersion:   codes.OK,
+			expectedStackCode: codes.OK,
+		},
+		{
+			name:              "other peer",
+			uids:              map[uint32]auth.Role{uid + 1: auth.Admin},
+			expectedVersion:   codes.Unauthenticated,
+			expectedStackCode: codes.Unauthenticated,
+		},
+		{
+			name:              "other peer with admin token",
+			uids:              map[uint32]auth.Role{uid + 1: auth.Admin},
+			token:             "abc123",
+			expectedVersion:   codes.OK,
+			expectedStackCode: codes.OK,
+		},
+		{
+			name:              "read-only peer with invalid token",
+			uids:              map[uint32]auth.Role{uid: auth.ReadOnly},
+			token:             "nope",
+			expectedVersion:   codes.Unauthenticated,
+			expectedStackCode: codes.Unauthenticated,
+		},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		sock := filepath.Join(dir, string(rune('a'+i))+".sock")
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			a, err := auth.New(auth.Config{
+				TokenFiles: map[string]auth.Role{tokens: auth.Admin},
+				UIDs:       tc.uids,
+			})
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			grpcServer, err := New("unix", sock)
+			require.NoError(t, err)
+			grpcServer.SetAuthenticator(a)
+			go grpcServer.Start(ctx, nil, nil)
+
+			conn, err := grpc.NewClient("unix:"+sock, grpc.WithTransportCredentials(insecure.NewCredentials()))
+			require.NoError(t, err)
+			defer conn.Close()
+
+			if tc.token != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+tc.token)
+			}
+
+			_, err = pb.NewTrackerServiceClient(conn).GetVersion(ctx, &pb.GetVersionRequest{})
+			assert.Equal(t, tc.expectedVersion, status.Code(err), err)
+
+			_, err = pb.NewDiagnosticServiceClient(conn).GetStacktrace(ctx, &pb.GetStacktraceRequest{})
+			assert.Equal(t, tc.expectedStackCode, status.Code(err), err)
+		})
+	}
+}
+
+func TestMethodRole(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, auth.ReadOnly, methodRole("/tracker.v1beta1.TrackerService/StreamEvents"))
+	assert.Equal(t, auth.ReadOnly, methodRole("/tracker.v1beta1.DiagnosticService/GetMetrics"))
+	assert.Equal(t, auth.ReadOnly, methodRole("/tracker.v1beta1.ProcessTreeService/GetLineage"))
+	assert.Equal(t, auth.Admin, methodRole("/tracker.v1beta1.TrackerService/DisableEvent"))
+	assert.Equal(t, auth.Admin, methodRole("/tracker.v1beta1.DiagnosticService/ChangeLogLevel"))
+	assert.Equal(t, auth.Admin, methodRole("/tracker.v1beta1.UnknownService/Unknown"))
+}
@@ -2,17 +2,35 @@ package grpc
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"io"
+	"slices"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	pb "github.com/khulnasoft-lab/tracker/api/v1beta1"
 	"github.com/khulnasoft-lab/tracker/pkg/signatures/engine"
+	"github.com/khulnasoft-lab/tracker/types/datasource"
 	"github.com/khulnasoft-lab/tracker/types/detect"
 )
 
+// structuredKeys decode the structured key types of the data sources, given as an object of the
+// key fields
+var structuredKeys = map[string]func([]byte) (interface{}, error){
+	"datasource.ProcKey":    decodeKey[datasource.ProcKey],
+	"datasource.ThreadKey":  decodeKey[datasource.ThreadKey],
+	"datasource.LineageKey": decodeKey[datasource.LineageKey],
+}
+
+func decodeKey[K any](b []byte) (interface{}, error) {
+	var key K
+	err := json.Unmarshal(b, &key)
+	return key, err
+}
+
 type DataSourceService struct {
 	pb.UnimplementedDataSourceServiceServer
 	sigEngine *engine.Engine
@@ -97,3 +115,109 @@ func (s *DataSourceService) WriteStream(stream pb.DataSourceService_WriteStreamS
 	}
 	return stream.SendAndClose(&pb.WriteDataSourceResponse{})
 }
+
+// Get implements the DataSourceService Get RPC
+func (s *DataSourceService) Get(ctx context.Context, req *pb.GetDataSourceRequest) (*pb.GetDataSourceResponse, error) {
+	ds, err := s.getDataSource(req.Namespace, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if req.Key == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "key cannot be empty")
+	}
+
+	key := req.Key.AsInterface()
+	if req.KeyType != "" {
+		if !slices.Contains(ds.Keys(), req.KeyType) {
+			return nil, status.Errorf(codes.InvalidArgument, "key type %s is not supported by the data source, use one of %v", req.KeyType, ds.Keys())
+		}
+		if decode, ok := structuredKeys[req.KeyType]; ok {
+			b, err := req.Key.MarshalJSON()
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+			}
+			if key, err = decode(b); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid %s key: %v", req.KeyType, err)
+			}
+		}
+	}
+
+	data, err := ds.Get(key)
+	if err != nil {
+		if errors.Is(err, detect.ErrKeyNotSupported) {
+			return nil, status.Errorf(codes.InvalidArgument, "given key is not supported, use one of %v", ds.Keys())
+		}
+		if errors.Is(err, detect.ErrDataNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no data found for the given key")
+		}
+		return nil, status.Errorf(codes.Internal, "internal error when reading from data source: %v", err)
+	}
+
+	// data sources return any go value, so they are converted through their json form
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal data: %v", err)
+	}
+	result := &structpb.Struct{}
+	if err := result.UnmarshalJSON(b); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal data: %v", err)
+	}
+
+	return &pb.GetDataSourceResponse{Data: result}, nil
+}
+
+// ListDataSources implements the DataSourceService ListDataSources RPC
+func (s *DataSourceService) ListDataSources(ctx context.Context, req *pb.ListDataSourcesRequest) (*pb.ListDataSourcesResponse, error) {
+	if s.sigEngine == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "signatures engine is not running")
+	}
+
+	dataSources := []*pb.DataSource{}
+	for _, ds := range s.sigEngine.DataSources() {
+		if req.Namespace != "" && ds.Namespace() != req.Namespace {
+			continue
+		}
+		dataSources = append(dataSources, convertDataSourceToProto(ds))
+	}
+
+	return &pb.ListDataSourcesResponse{DataSources: dataSources}, nil
+}
+
+// Describe implements the DataSourceService Describe RPC
+func (s *DataSourceService) Describe(ctx context.Context, req *pb.DescribeDataSourceRequest) (*pb.DescribeDataSourceResponse, error) {
+	ds, err := s.getDataSource(req.Namespace, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DescribeDataSourceResponse{DataSource: convertDataSourceToProto(ds)}, nil
+}
+
+func (s *DataSourceService) getDataSource(namespace, id string) (detect.DataSource, error) {
+	if s.sigEngine == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "signatures engine is not running")
+	}
+
+	ds, ok := s.sigEngine.GetDataSource(namespace, id)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "requested data source does not exist (namespace: %s, id: %s)", namespace, id)
+	}
+
+	return ds, nil
+}
+
+func convertDataSourceToProto(ds detect.DataSource) *pb.DataSource {
+	d := &pb.DataSource{
+		Id:        ds.ID(),
+		Namespace: ds.Namespace(),
+		Version:   uint32(ds.Version()),
+		Keys:      ds.Keys(),
+		Schema:    ds.Schema(),
+	}
+	if writeable, ok := ds.(detect.WriteableDataSource); ok {
+		d.Writable = true
+		d.Values = writeable.Values()
+	}
+
+	return d
+}
@@ -0,0 +1,141 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	pb "github.com/khulnasoft-lab/tracker/api/v1beta1"
+	"github.com/khulnasoft-lab/tracker/pkg/proctree"
+	"github.com/khulnasoft-lab/tracker/pkg/signatures/engine"
+	"github.com/khulnasoft-lab/tracker/types/detect"
+	"github.com/khulnasoft-lab/tracker/types/protocol"
+)
+
+// intelDataSource is a writable data source of bad domains
+type intelDataSource struct {
+	domains map[string]string
+}
+
+func (ds *intelDataSource) Get(key interface{}) (map[string]interface{}, error) {
+	domain, ok := key.(string)
+	if !ok {
+		return nil, detect.ErrKeyNotSupported
+	}
+	reason, ok := ds.domains[domain]
+	if !ok {
+		return nil, detect.ErrDataNotFound
+	}
+	return map[string]interface{}{"domain": domain, "reason": reason}, nil
+}
+
+func (ds *intelDataSource) Write(data map[interface{}]interface{}) error {
+	for k, v := range data {
+		ds.domains[k.(string)] = v.(string)
+	}
+	return nil
+}
+
+func (ds *intelDataSource) Version() uint     { return 2 }
+func (ds *intelDataSource) Keys() []string    { return []string{"string"} }
+func (ds *intelDataSource) Values() []string  { return []string{"string"} }
+func (ds *intelDataSource) Schema() string    { return `{"domain":"string","reason":"string"}` }
+func (ds *intelDataSource) Namespace() string { return "intel" }
+func (ds *intelDataSource) ID() string        { return "domains" }
+
+func TestDataSourceService(t *testing.T) {
+	t.Parallel()
+
+	e, err := engine.NewEngine(engine.Config{
+		DataSources: []detect.DataSource{
+			&intelDataSource{domains: map[string]string{"bad.example": "phishing"}},
+			proctree.NewDataSource(newTestProcessTree(t)),
+		},
+	}, engine.EventSources{Tracker: make(chan protocol.Event)}, make(chan *detect.Finding))
+	require.NoError(t, err)
+	require.NoError(t, e.Init())
+
+	s := &DataSourceService{sigEngine: e}
+	ctx := context.Background()
+
+	t.Run("list", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := s.ListDataSources(ctx, &pb.ListDataSourcesRequest{})
+		require.NoError(t, err)
+		require.Len(t, res.DataSources, 2)
+		assert.Equal(t, "domains", res.DataSources[0].Id)
+		assert.Equal(t, "process_tree", res.DataSources[1].Id)
+
+		res, err = s.ListDataSources(ctx, &pb.ListDataSourcesRequest{Namespace: "tracker"})
+		require.NoError(t, err)
+		require.Len(t, res.DataSources, 1)
+		assert.Equal(t, "process_tree", res.DataSources[0].Id)
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := s.Describe(ctx, &pb.DescribeDataSourceRequest{Namespace: "intel", Id: "domains"})
+		require.NoError(t, err)
+		assert.Equal(t, &pb.DataSource{
+			Id:        "domains",
+			Namespace: "intel",
+			Version:   2,
+			Keys:      []string{"string"},
+			Schema:    `{"domain":"string","reason":"string"}`,
+			Writable:  true,
+			Values:    []string{"string"},
+		}, res.DataSource)
+
+		_, err = s.Describe(ctx, &pb.DescribeDataSourceRequest{Namespace: "intel", Id: "ips"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("get", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := s.Get(ctx, &pb.GetDataSourceRequest{Namespace: "intel", Id: "domains", Key: structpb.NewStringValue("bad.example")})
+		require.NoError(t, err)
+		assert.Equal(t, "phishing", res.Data.Fields["reason"].GetStringValue())
+
+		_, err = s.Get(ctx, &pb.GetDataSourceRequest{Namespace: "intel", Id: "domains", Key: structpb.NewStringValue("good.example")})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		_, err = s.Get(ctx, &pb.GetDataSourceRequest{Namespace: "intel", Id: "domains", Key: structpb.NewNumberValue(1)})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = s.Get(ctx, &pb.GetDataSourceRequest{Namespace: "intel", Id: "domains"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("get structured key", func(t *testing.T) {
+		t.Parallel()
+
+		key, err := structpb.NewValue(map[string]interface{}{"EntityId": 200})
+		require.NoError(t, err)
+
+		res, err := s.Get(ctx, &pb.GetDataSourceRequest{Namespace: "tracker", Id: "process_tree", Key: key, KeyType: "datasource.ProcKey"})
+		require.NoError(t, err)
+		info := res.Data.Fields["process_info"].GetStructValue().Fields["Info"].GetStructValue()
+		assert.Equal(t, float64(200), info.Fields["Pid"].GetNumberValue())
+
+		_, err = s.Get(ctx, &pb.GetDataSourceRequest{Namespace: "tracker", Id: "process_tree", Key: key, KeyType: "string"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = s.Get(ctx, &pb.GetDataSourceRequest{Namespace: "tracker", Id: "process_tree", Key: structpb.NewStringValue("200"), KeyType: "datasource.ProcKey"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("engine not running", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := (&DataSourceService{}).ListDataSources(ctx, &pb.ListDataSourcesRequest{})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
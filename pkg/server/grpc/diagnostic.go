@@ -28,6 +28,53 @@ func (s *DiagnosticService) GetMetrics(ctx context.Context, in *pb.GetMetricsReq
 		LostBPFLogsCount: stats.LostBPFLogsCount.Get(),
 	}
 
+	pipeline := s.tracker.Pipeline()
+	for _, stage := range pipeline.Stages() {
+		stageStats := pipeline.Stage(stage)
+		length, capacity := stageStats.Queue()
+		metrics.Stages = append(metrics.Stages, &pb.PipelineStageMetrics{
+			Name:          stage.String(),
+			Received:      stageStats.Received.Get(),
+			Dropped:       stageStats.Dropped.Get(),
+			QueueLength:   uint64(length),
+			QueueCapacity: uint64(capacity),
+		})
+	}
+
+	for _, eventType := range pipeline.EventTypes() {
+		metrics.EventTypes = append(metrics.EventTypes, &pb.EventTypeMetrics{
+			Name:     eventType.Name,
+			Emitted:  eventType.Emitted.Get(),
+			Filtered: eventType.Filtered.Get(),
+			Lost:     eventType.Lost.Get(),
+		})
+	}
+
+	metrics.LostEvCountPerCPU = pipeline.LostPerCPU()
+
+	// The signatures engine only runs if signatures are loaded.
+	if engine := s.tracker.Engine(); engine != nil {
+		for _, sig := range engine.Stats().PerSignature() {
+			metrics.Signatures = append(metrics.Signatures, &pb.SignatureMetrics{
+				Id:       sig.ID,
+				Events:   sig.Events.Get(),
+				Findings: sig.Findings.Get(),
+			})
+		}
+	}
+
+	for _, stream := range s.tracker.Streams().Streams() {
+		config := stream.Config()
+		metrics.Streams = append(metrics.Streams, &pb.StreamMetrics{
+			Id:           stream.ID(),
+			Backpressure: config.Backpressure.String(),
+			Delivered:    stream.Delivered(),
+			Dropped:      stream.Dropped(),
+			Lag:          uint64(stream.Lag()),
+			BufferSize:   uint64(config.BufferSize),
+		})
+	}
+
 	return metrics, nil
 }
 
@@ -0,0 +1,113 @@
+package grpc
+
+import (
+	"strings"
+
+	"github.com/khulnasoft-lab/tracker/pkg/errfmt"
+	"github.com/khulnasoft-lab/tracker/pkg/events"
+	"github.com/khulnasoft-lab/tracker/pkg/events/parse"
+	"github.com/khulnasoft-lab/tracker/pkg/filters"
+	"github.com/khulnasoft-lab/tracker/types/trace"
+)
+
+// streamFilter filters the events of a stream before they are serialized.
+// All the given expressions must match for an event to be sent (they are ANDed).
+type streamFilter struct {
+	eventNameFilter    *filters.StringFilter
+	processNameFilter  *filters.StringFilter
+	containerIDFilter  *filters.StringFilter
+	podNamespaceFilter *filters.StringFilter
+	severityFilter     *filters.IntFilter[int64]
+	dataFilter         *filters.DataFilter
+	scopeFilter        *filters.ScopeFilter
+}
+
+// newStreamFilter parses the filter expressions of a StreamEventsRequest.
+// Supported expressions are:
+//
+//	event=openat,execve            event names
+//	comm=bash                      process name
+//	container=ab356bc4dd554        container id
+//	podNamespace=prod-*            kubernetes pod namespace
+//	severity>=2                    finding severity (non findings never match)
+//	openat.data.pathname=/etc/*    event data (see pkg/filters.DataFilter)
+//	openat.scope.uid=0             event scope (see pkg/filters.ScopeFilter)
+func newStreamFilter(expressions []string) (*streamFilter, error) {
+	f := &streamFilter{
+		eventNameFilter:    filters.NewStringFilter(nil),
+		processNameFilter:  filters.NewStringFilter(nil),
+		containerIDFilter:  filters.NewStringFilter(nil),
+		podNamespaceFilter: filters.NewStringFilter(nil),
+		severityFilter:     filters.NewIntFilter(),
+		dataFilter:         filters.NewDataFilter(),
+		scopeFilter:        filters.NewScopeFilter(),
+	}
+
+	for _, expr := range expressions {
+		if err := f.parse(expr); err != nil {
+			return nil, err
+		}
+	}
+
+	return f, nil
+}
+
+func (f *streamFilter) parse(expr string) error {
+	idx := strings.IndexAny(expr, "=!<>")
+	if idx <= 0 {
+		return filters.InvalidExpression(expr)
+	}
+	name, operatorAndValues := expr[:idx], expr[idx:]
+
+	switch name {
+	case "e", "event":
+		return f.eventNameFilter.Parse(operatorAndValues)
+	case "comm", "processName":
+		return f.processNameFilter.Parse(operatorAndValues)
+	case "container", "containerId":
+		return f.containerIDFilter.Parse(operatorAndValues)
+	case "podNamespace":
+		return f.podNamespaceFilter.Parse(operatorAndValues)
+	case "severity":
+		return f.severityFilter.Parse(operatorAndValues)
+	}
+
+	parts := strings.Split(name, ".")
+	if len(parts) != 3 {
+		return filters.InvalidExpression(expr)
+	}
+
+	switch parts[1] {
+	case "data", "args":
+		err := f.dataFilter.Parse(name, operatorAndValues, events.Core.NamesToIDs())
+		if err != nil {
+			return errfmt.WrapError(err)
+		}
+		return nil
+	case "scope":
+		err := f.scopeFilter.Parse(name, operatorAndValues)
+		if err != nil {
+			return errfmt.WrapError(err)
+		}
+		return nil
+	}
+
+	return filters.InvalidExpression(expr)
+}
+
+// Filter returns true if the event should be sent to the stream
+func (f *streamFilter) Filter(e trace.Event) bool {
+	if f.severityFilter.Enabled() {
+		severity, ok := parse.FindingSeverity(e)
+		if !ok || !f.severityFilter.Filter(int64(severity)) {
+			return false
+		}
+	}
+
+	return f.eventNameFilter.Filter(e.EventName) &&
+		f.processNameFilter.Filter(e.ProcessName) &&
+		f.containerIDFilter.Filter(e.Container.ID) &&
+		f.podNamespaceFilter.Filter(e.Kubernetes.PodNamespace) &&
+		f.scopeFilter.Filter(e) &&
+		f.dataFilter.Filter(events.ID(e.EventID), e.Args)
+}
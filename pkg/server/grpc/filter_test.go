@@ -0,0 +1,137 @@
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/khulnasoft-lab/tracker/pkg/events"
+	"github.com/khulnasoft-lab/tracker/types/trace"
+)
+
+func Test_streamFilter(t *testing.T) {
+	t.Parallel()
+
+	openatEvent := trace.Event{
+		EventID:     int(events.Openat),
+		EventName:   "openat",
+		ProcessName: "cat",
+		Container:   trace.Container{ID: "ab356bc4dd554"},
+		Kubernetes:  trace.Kubernetes{PodNamespace: "prod-eu"},
+		UserID:      1000,
+		Args: []trace.Argument{
+			{ArgMeta: trace.ArgMeta{Name: "pathname"}, Value: "/etc/shadow"},
+		},
+	}
+
+	findingEvent := trace.Event{
+		EventID:   int(events.StartSignatureID),
+		EventName: "anti_debugging",
+		Metadata: &trace.Metadata{
+			Properties: map[string]interface{}{"Severity": 3},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expressions []string
+		event       trace.Event
+		expected    bool
+	}{
+		{
+			name:        "no expressions",
+			expressions: []string{},
+			event:       openatEvent,
+			expected:    true,
+		},
+		{
+			name:        "event name match",
+			expressions: []string{"event=openat,execve"},
+			event:       openatEvent,
+			expected:    true,
+		},
+		{
+			name:        "event name mismatch",
+			expressions: []string{"event!=openat"},
+			event:       openatEvent,
+			expected:    false,
+		},
+		{
+			name:        "process name and container match",
+			expressions: []string{"comm=cat", "container=ab356bc4dd554"},
+			event:       openatEvent,
+			expected:    true,
+		},
+		{
+			name:        "pod namespace prefix match",
+			expressions: []string{"podNamespace=prod-*"},
+			event:       openatEvent,
+			expected:    true,
+		},
+		{
+			name:        "data match",
+			expressions: []string{"openat.data.pathname=/etc/*"},
+			event:       openatEvent,
+			expected:    true,
+		},
+		{
+			name:        "data mismatch",
+			expressions: []string{"openat.data.pathname=/tmp/*"},
+			event:       openatEvent,
+			expected:    false,
+		},
+		{
+			name:        "scope mismatch",
+			expressions: []string{"openat.scope.uid=0"},
+			event:       openatEvent,
+			expected:    false,
+		},
+		{
+			name:        "severity ignores non findings",
+			expressions: []string{"severity>=2"},
+			event:       openatEvent,
+			expected:    false,
+		},
+		{
+			name:        "severity match",
+			expressions: []string{"severity>=2"},
+			event:       findingEvent,
+			expected:    true,
+		},
+		{
+			name:        "severity mismatch",
+			expressions: []string{"severity>3"},
+			event:       findingEvent,
+			expected:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			filter, err := newStreamFilter(tt.expressions)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, filter.Filter(tt.event))
+		})
+	}
+}
+
+func Test_newStreamFilterInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, expr := range []string{
+		"openat",
+		"=openat",
+		"unknown=1",
+		"openat.data.nonexistent=1",
+		"nonexistent.scope.uid=0",
+		"severity=high",
+	} {
+		_, err := newStreamFilter([]string{expr})
+		assert.Error(t, err, expr)
+	}
+}
@@ -0,0 +1,264 @@
+package grpc
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/khulnasoft-lab/tracker/api/v1beta1"
+	tracker "github.com/khulnasoft-lab/tracker/pkg/ebpf"
+	"github.com/khulnasoft-lab/tracker/pkg/logger"
+)
+
+// APIPrefix is the path the gateway serves its endpoints under
+const APIPrefix = "/api/v1/"
+
+var (
+	// the field names match the generated json marshalers of the api
+	streamMarshaler = protojson.MarshalOptions{UseProtoNames: true}
+	// responses emit the zero values, so counters and flags are always present
+	responseMarshaler = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+)
+
+// Gateway serves the tracker and diagnostic services as JSON over HTTP:
+//
+//	GET  /api/v1/version
+//	GET  /api/v1/events/definitions[?names=<event>,...]
+//	POST /api/v1/events/<event>:enable
+//	POST /api/v1/events/<event>:disable
+//	GET  /api/v1/events/stream[?policies=<policy>,...]
+//	GET  /api/v1/metrics
+//
+// The event stream is chunked NDJSON. Errors are returned as a status object with the
+// gRPC code and message, and an HTTP status matching the code.
+type Gateway struct {
+	mux        *http.ServeMux
+	tracker    *TrackerService
+	diagnostic *DiagnosticService
+}
+
+// NewGateway returns a gateway to the services of the given tracker
+func NewGateway(t *tracker.Tracker) *Gateway {
+	g := &Gateway{
+		mux:        http.NewServeMux(),
+		tracker:    &TrackerService{tracker: t},
+		diagnostic: &DiagnosticService{tracker: t},
+	}
+
+	g.mux.HandleFunc("GET "+APIPrefix+"version", g.getVersion)
+	g.mux.HandleFunc("GET "+APIPrefix+"events/definitions", g.getEventDefinitions)
+	g.mux.HandleFunc("GET "+APIPrefix+"events/stream", g.streamEvents)
+	g.mux.HandleFunc("POST "+APIPrefix+"events/{event}", g.changeEvent)
+	g.mux.HandleFunc("GET "+APIPrefix+"metrics", g.getMetrics)
+
+	return g
+}
+
+// ServeHTTP implements http.Handler
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	g.mux.ServeHTTP(w, r)
+}
+
+func (g *Gateway) getVersion(w http.ResponseWriter, r *http.Request) {
+	resp, err := g.tracker.GetVersion(r.Context(), &pb.GetVersionRequest{})
+	writeResponse(w, resp, err)
+}
+
+func (g *Gateway) getEventDefinitions(w http.ResponseWriter, r *http.Request) {
+	in := &pb.GetEventDefinitionsRequest{EventNames: queryList(r, "names")}
+	resp, err := g.tracker.GetEventDefinitions(r.Context(), in)
+	writeResponse(w, resp, err)
+}
+
+// changeEvent enables or disables an event, given as <event>:<action>. Event names may
+// contain colons, so the action follows the last one.
+func (g *Gateway) changeEvent(w http.ResponseWriter, r *http.Request) {
+	var (
+		resp proto.Message
+		err  error
+	)
+
+	event := r.PathValue("event")
+	i := strings.LastIndex(event, ":")
+	if i < 0 {
+		writeError(w, status.Errorf(codes.InvalidArgument, "missing action, use %s:enable or %s:disable", event, event))
+		return
+	}
+	name, action := event[:i], event[i+1:]
+
+	switch action {
+	case "enable":
+		resp, err = g.tracker.EnableEvent(r.Context(), &pb.EnableEventRequest{Name: name})
+	case "disable":
+		resp, err = g.tracker.DisableEvent(r.Context(), &pb.DisableEventRequest{Name: name})
+	default:
+		err = status.Errorf(codes.InvalidArgument, "unknown action %q, use enable or disable", action)
+	}
+
+	writeResponse(w, resp, err)
+}
+
+func (g *Gateway) getMetrics(w http.ResponseWriter, r *http.Request) {
+	resp, err := g.diagnostic.GetMetrics(r.Context(), &pb.GetMetricsRequest{})
+	writeResponse(w, resp, err)
+}
+
+func (g *Gateway) streamEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, status.Error(codes.Unimplemented, "streaming is not supported by the connection"))
+		return
+	}
+
+	stream := &ndjsonStream{ctx: r.Context(), w: w, flusher: flusher}
+	in := &pb.StreamEventsRequest{Policies: queryList(r, "policies")}
+
+	err := g.tracker.StreamEvents(in, stream)
+	if err == nil || r.Context().Err() != nil {
+		return
+	}
+	if !stream.started {
+		writeError(w, err)
+		return
+	}
+
+	// the status was already sent, so the error ends the stream instead
+	if err := stream.writeLine("error", status.Convert(err).Proto()); err != nil {
+		logger.Debugw("Writing events stream error", "error", err)
+	}
+}
+
+// ndjsonStream sends the responses of an events stream as lines of JSON, each holding
+// either a "result" or the "error" which ended the stream.
+type ndjsonStream struct {
+	grpc.ServerStream // only Send and Context are used by the service
+	ctx               context.Context
+	w                 http.ResponseWriter
+	flusher           http.Flusher
+	started           bool
+}
+
+func (s *ndjsonStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *ndjsonStream) Send(resp *pb.StreamEventsResponse) error {
+	if err := s.ctx.Err(); err != nil {
+		return err
+	}
+
+	return s.writeLine("result", resp)
+}
+
+func (s *ndjsonStream) writeLine(key string, m proto.Message) error {
+	b, err := streamMarshaler.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	if !s.started {
+		s.w.Header().Set("Content-Type", "application/x-ndjson")
+		s.w.WriteHeader(http.StatusOK)
+		s.started = true
+	}
+
+	line := make([]byte, 0, len(key)+len(b)+6)
+	line = append(line, `{"`+key+`":`...)
+	line = append(line, b...)
+	line = append(line, "}\n"...)
+
+	if _, err := s.w.Write(line); err != nil {
+		return err
+	}
+	s.flusher.Flush()
+
+	return nil
+}
+
+// queryList returns the values of a query parameter, given either repeated or comma
+// separated
+func queryList(r *http.Request, key string) []string {
+	var values []string
+
+	for _, value := range r.URL.Query()[key] {
+		for _, v := range strings.Split(value, ",") {
+			if v != "" {
+				values = append(values, v)
+			}
+		}
+	}
+
+	return values
+}
+
+func writeResponse(w http.ResponseWriter, resp proto.Message, err error) {
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	b, err := responseMarshaler.Marshal(resp)
+	if err != nil {
+		writeError(w, status.Errorf(codes.Internal, "marshaling response: %v", err))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, b)
+}
+
+// writeError writes the status of the error, errors which aren't a status are unknown
+func writeError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+
+	b, err := streamMarshaler.Marshal(st.Proto())
+	if err != nil {
+		http.Error(w, st.Message(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, HTTPStatusFromCode(st.Code()), b)
+}
+
+func writeJSON(w http.ResponseWriter, code int, b []byte) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if _, err := w.Write(append(b, '\n')); err != nil {
+		logger.Debugw("Writing API response", "error", err)
+	}
+}
+
+// HTTPStatusFromCode returns the HTTP status matching a gRPC status code
+func HTTPStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499 // client closed request
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	}
+
+	return http.StatusInternalServerError // Unknown, Internal, DataLoss and any other code
+}
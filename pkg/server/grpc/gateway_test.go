@@ -0,0 +1,161 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/khulnasoft-lab/tracker/api/v1beta1"
+	"github.com/khulnasoft-lab/tracker/pkg/version"
+)
+
+func TestGateway(t *testing.T) {
+	t.Parallel()
+
+	gateway := NewGateway(nil)
+
+	testCases := []struct {
+		name           string
+		method         string
+		path           string
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name:           "version",
+			method:         http.MethodGet,
+			path:           "/api/v1/version",
+			expectedStatus: http.StatusOK,
+			expectedBody:   map[string]interface{}{"version": version.GetVersion()},
+		},
+		{
+			name:           "unknown event definition",
+			method:         http.MethodGet,
+			path:           "/api/v1/events/definitions?names=openat,no_such_event",
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   map[string]interface{}{"code": float64(codes.NotFound), "message": "event no_such_event not found"},
+		},
+		{
+			name:           "unknown action",
+			method:         http.MethodPost,
+			path:           "/api/v1/events/openat:toggle",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   map[string]interface{}{"code": float64(codes.InvalidArgument), "message": "unknown action \"toggle\", use enable or disable"},
+		},
+		{
+			name:           "missing action",
+			method:         http.MethodPost,
+			path:           "/api/v1/events/openat",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   map[string]interface{}{"code": float64(codes.InvalidArgument), "message": "missing action, use openat:enable or openat:disable"},
+		},
+		{
+			name:           "wrong method",
+			method:         http.MethodGet,
+			path:           "/api/v1/events/openat:enable",
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:           "unknown endpoint",
+			method:         http.MethodGet,
+			path:           "/api/v1/policies",
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rec := httptest.NewRecorder()
+			gateway.ServeHTTP(rec, httptest.NewRequest(tc.method, tc.path, nil))
+
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+			if tc.expectedBody == nil {
+				return
+			}
+			assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+			body := map[string]interface{}{}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			assert.Equal(t, tc.expectedBody, body)
+		})
+	}
+}
+
+func TestGatewayEventDefinitions(t *testing.T) {
+	t.Parallel()
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/events/definitions?names=openat&names=close", nil)
+	NewGateway(nil).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var body struct {
+		Definitions []struct {
+			Name string `json:"name"`
+		} `json:"definitions"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Len(t, body.Definitions, 2)
+	assert.Equal(t, "openat", body.Definitions[0].Name)
+	assert.Equal(t, "close", body.Definitions[1].Name)
+}
+
+func TestNDJSONStream(t *testing.T) {
+	t.Parallel()
+
+	rec := httptest.NewRecorder()
+	stream := &ndjsonStream{ctx: context.Background(), w: rec, flusher: rec}
+
+	require.NoError(t, stream.Send(&pb.StreamEventsResponse{Event: &pb.Event{Name: "openat"}, Sequence: 1}))
+	require.NoError(t, stream.Send(&pb.StreamEventsResponse{Gap: &pb.EventsGap{From: 2, To: 5}}))
+
+	assert.Equal(t, "application/x-ndjson", rec.Header().Get("Content-Type"))
+	assert.True(t, rec.Flushed)
+	lines := strings.SplitAfter(rec.Body.String(), "\n")
+	require.Len(t, lines, 3)
+	assert.JSONEq(t, `{"result":{"event":{"name":"openat"},"sequence":"1"}}`, lines[0])
+	assert.JSONEq(t, `{"result":{"gap":{"from":"2","to":"5"}}}`, lines[1])
+	assert.Empty(t, lines[2])
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	stream.ctx = ctx
+	assert.ErrorIs(t, stream.Send(&pb.StreamEventsResponse{}), context.Canceled)
+}
+
+func TestHTTPStatusFromCode(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		code     codes.Code
+		expected int
+	}{
+		{codes.OK, http.StatusOK},
+		{codes.InvalidArgument, http.StatusBadRequest},
+		{codes.FailedPrecondition, http.StatusBadRequest},
+		{codes.NotFound, http.StatusNotFound},
+		{codes.AlreadyExists, http.StatusConflict},
+		{codes.PermissionDenied, http.StatusForbidden},
+		{codes.Unauthenticated, http.StatusUnauthorized},
+		{codes.ResourceExhausted, http.StatusTooManyRequests},
+		{codes.Unimplemented, http.StatusNotImplemented},
+		{codes.Unavailable, http.StatusServiceUnavailable},
+		{codes.Unknown, http.StatusInternalServerError},
+		{codes.Internal, http.StatusInternalServerError},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, HTTPStatusFromCode(tc.code), tc.code.String())
+	}
+}
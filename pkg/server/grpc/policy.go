@@ -0,0 +1,135 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/khulnasoft-lab/tracker/api/v1beta1"
+	tracker "github.com/khulnasoft-lab/tracker/pkg/ebpf"
+	k8s "github.com/khulnasoft-lab/tracker/pkg/k8s/apis/tracker.khulnasoft.com/v1beta1"
+	"github.com/khulnasoft-lab/tracker/pkg/policy/v1beta1"
+)
+
+type PolicyService struct {
+	pb.UnimplementedPolicyServiceServer
+	tracker *tracker.Tracker
+}
+
+func (s *PolicyService) ListPolicies(ctx context.Context, in *pb.ListPoliciesRequest) (*pb.ListPoliciesResponse, error) {
+	documents, err := s.tracker.Policies()
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	policies := make([]*pb.Policy, 0, len(documents))
+	for _, d := range documents {
+		policies = append(policies, convertPolicyToProto(d))
+	}
+
+	return &pb.ListPoliciesResponse{Policies: policies}, nil
+}
+
+func (s *PolicyService) GetPolicy(ctx context.Context, in *pb.GetPolicyRequest) (*pb.GetPolicyResponse, error) {
+	documents, err := s.tracker.Policies()
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	for _, d := range documents {
+		if d.GetName() == in.Name {
+			return &pb.GetPolicyResponse{Policy: convertPolicyToProto(d)}, nil
+		}
+	}
+
+	return nil, status.Errorf(codes.NotFound, "policy %s not found", in.Name)
+}
+
+func (s *PolicyService) ApplyPolicy(ctx context.Context, in *pb.ApplyPolicyRequest) (*pb.ApplyPolicyResponse, error) {
+	if in.Policy == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "policy cannot be empty")
+	}
+
+	p := convertPolicyFromProto(in.Policy)
+	if err := p.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	if err := s.tracker.ApplyPolicy(p); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	return &pb.ApplyPolicyResponse{}, nil
+}
+
+func (s *PolicyService) DeletePolicy(ctx context.Context, in *pb.DeletePolicyRequest) (*pb.DeletePolicyResponse, error) {
+	if _, err := s.GetPolicy(ctx, &pb.GetPolicyRequest{Name: in.Name}); err != nil {
+		return nil, err
+	}
+
+	if err := s.tracker.DeletePolicy(in.Name); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	return &pb.DeletePolicyResponse{}, nil
+}
+
+func convertPolicyToProto(p k8s.PolicyInterface) *pb.Policy {
+	rules := make([]*pb.PolicyRule, 0, len(p.GetRules()))
+	for _, r := range p.GetRules() {
+		rules = append(rules, &pb.PolicyRule{
+			Event:       r.Event,
+			Filters:     r.Filters,
+			Actions:     r.Actions,
+			RateLimit:   r.RateLimit,
+			DedupWindow: r.DedupWindow,
+			DedupKeys:   r.DedupKeys,
+		})
+	}
+
+	return &pb.Policy{
+		Name:           p.GetName(),
+		Description:    p.GetDescription(),
+		Scope:          p.GetScope(),
+		DefaultActions: p.GetDefaultActions(),
+		Rules:          rules,
+		RateLimit:      p.GetRateLimit(),
+		DedupWindow:    p.GetDedupWindow(),
+	}
+}
+
+func convertPolicyFromProto(p *pb.Policy) v1beta1.PolicyFile {
+	rules := make([]k8s.Rule, 0, len(p.Rules))
+	for _, r := range p.Rules {
+		rules = append(rules, k8s.Rule{
+			Event:       r.Event,
+			Filters:     r.Filters,
+			Actions:     r.Actions,
+			RateLimit:   r.RateLimit,
+			DedupWindow: r.DedupWindow,
+			DedupKeys:   r.DedupKeys,
+		})
+	}
+
+	var annotations map[string]string
+	if p.Description != "" {
+		annotations = map[string]string{"description": p.Description}
+	}
+
+	return v1beta1.PolicyFile{
+		APIVersion: "tracker.khulnasoft.com/v1beta1",
+		Kind:       "Policy",
+		Metadata: v1beta1.Metadata{
+			Name:        p.Name,
+			Annotations: annotations,
+		},
+		Spec: k8s.PolicySpec{
+			Scope:          p.Scope,
+			DefaultActions: p.DefaultActions,
+			RateLimit:      p.RateLimit,
+			DedupWindow:    p.DedupWindow,
+			Rules:          rules,
+		},
+	}
+}
@@ -0,0 +1,32 @@
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/khulnasoft-lab/tracker/api/v1beta1"
+)
+
+func Test_convertPolicy(t *testing.T) {
+	t.Parallel()
+
+	in := &pb.Policy{
+		Name:           "noisy-rules",
+		Description:    "tuned rules",
+		Scope:          []string{"comm=bash"},
+		DefaultActions: []string{"log"},
+		RateLimit:      "100/s",
+		Rules: []*pb.PolicyRule{
+			{Event: "openat", Filters: []string{"data.pathname=/etc/*"}, DedupWindow: "10s", DedupKeys: []string{"pathname"}},
+			{Event: "execve", RateLimit: "10/s"},
+		},
+	}
+
+	p := convertPolicyFromProto(in)
+
+	assert.NoError(t, p.Validate())
+	assert.Equal(t, "noisy-rules", p.GetName())
+	assert.Equal(t, "tuned rules", p.GetDescription())
+	assert.Equal(t, in, convertPolicyToProto(p))
+}
@@ -0,0 +1,267 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/khulnasoft-lab/tracker/api/v1beta1"
+	"github.com/khulnasoft-lab/tracker/pkg/containers"
+	"github.com/khulnasoft-lab/tracker/pkg/proctree"
+	"github.com/khulnasoft-lab/tracker/types/datasource"
+	"github.com/khulnasoft-lab/tracker/types/detect"
+)
+
+// defaultLineageDepth is the number of ancestors walked up when no max depth is given
+const defaultLineageDepth = 32
+
+// containerProcesses gives the processes of the containers (see containers.Containers)
+type containerProcesses interface {
+	GetContainerPids(containerID string) ([]int, error)
+}
+
+type ProcessTreeService struct {
+	pb.UnimplementedProcessTreeServiceServer
+	processTree *proctree.ProcessTree
+	containers  containerProcesses
+}
+
+func (s *ProcessTreeService) GetProcess(ctx context.Context, in *pb.GetProcessRequest) (*pb.GetProcessResponse, error) {
+	ds, entityId, at, err := s.lookup(in.EntityId, in.Pid, in.At)
+	if err != nil {
+		return nil, err
+	}
+
+	process, err := getProcessNode(ds, entityId, at)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetProcessResponse{Process: process}, nil
+}
+
+func (s *ProcessTreeService) GetLineage(ctx context.Context, in *pb.GetLineageRequest) (*pb.GetLineageResponse, error) {
+	ds, entityId, at, err := s.lookup(in.EntityId, in.Pid, in.At)
+	if err != nil {
+		return nil, err
+	}
+
+	maxDepth := int(in.MaxDepth)
+	if maxDepth == 0 {
+		maxDepth = defaultLineageDepth
+	}
+
+	data, err := ds.Get(datasource.LineageKey{EntityId: entityId, Time: at, MaxDepth: maxDepth})
+	if err != nil {
+		return nil, processError(err, entityId)
+	}
+
+	lineage := make([]*pb.ProcessNode, 0, maxDepth+1)
+	for _, info := range data["process_lineage"].(datasource.ProcessLineage) {
+		lineage = append(lineage, convertProcessToProto(ds, info))
+	}
+
+	return &pb.GetLineageResponse{Lineage: lineage}, nil
+}
+
+func (s *ProcessTreeService) ListChildren(ctx context.Context, in *pb.ListChildrenRequest) (*pb.ListChildrenResponse, error) {
+	entityId := in.EntityId
+	if in.ContainerId != "" && entityId == 0 && in.Pid == 0 {
+		var err error
+		entityId, err = s.containerProcess(in.ContainerId, in.At)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ds, entityId, at, err := s.lookup(entityId, in.Pid, in.At)
+	if err != nil {
+		return nil, err
+	}
+
+	process, err := getProcessNode(ds, entityId, at)
+	if err != nil {
+		return nil, err
+	}
+
+	// Walk the children breadth first, down to all descendants if recursive.
+	children := []*pb.ProcessNode{}
+	visited := map[uint32]bool{entityId: true}
+	pending := process.ChildrenEntityIds
+	for len(pending) > 0 {
+		var next []uint32
+		for _, childId := range pending {
+			if visited[childId] {
+				continue
+			}
+			visited[childId] = true
+
+			child, err := getProcessNode(ds, childId, at)
+			if err != nil {
+				continue // evicted from the tree in the meantime
+			}
+			children = append(children, child)
+			next = append(next, child.ChildrenEntityIds...)
+		}
+		if !in.Recursive {
+			break
+		}
+		pending = next
+	}
+
+	return &pb.ListChildrenResponse{Children: children}, nil
+}
+
+// lookup returns the data source of the process tree, with the entity ID of the requested process
+// and the time of the query
+func (s *ProcessTreeService) lookup(entityId, pid uint32, at *timestamppb.Timestamp) (*proctree.DataSource, uint32, time.Time, error) {
+	if s.processTree == nil {
+		return nil, 0, time.Time{}, status.Errorf(codes.FailedPrecondition, "process tree is disabled, enable it with '--proctree source=<source>'")
+	}
+	if entityId == 0 && pid == 0 {
+		return nil, 0, time.Time{}, status.Errorf(codes.InvalidArgument, "entity_id or pid must be given")
+	}
+
+	queryTime, err := parseQueryTime(at)
+	if err != nil {
+		return nil, 0, time.Time{}, err
+	}
+
+	if entityId == 0 {
+		process, ok := s.processTree.GetProcessByPid(int(pid), queryTime)
+		if !ok {
+			return nil, 0, time.Time{}, status.Errorf(codes.NotFound, "process with pid %d not found", pid)
+		}
+		entityId = process.GetHash()
+	}
+
+	return proctree.NewDataSource(s.processTree), entityId, queryTime, nil
+}
+
+// containerProcess returns the entity ID of the first process of a container, the earliest
+// started of its current processes alive at the given time
+func (s *ProcessTreeService) containerProcess(containerId string, at *timestamppb.Timestamp) (uint32, error) {
+	if s.processTree == nil {
+		return 0, status.Errorf(codes.FailedPrecondition, "process tree is disabled, enable it with '--proctree source=<source>'")
+	}
+	if s.containers == nil {
+		return 0, status.Errorf(codes.FailedPrecondition, "containers are not available")
+	}
+
+	queryTime, err := parseQueryTime(at)
+	if err != nil {
+		return 0, err
+	}
+
+	pids, err := s.containers.GetContainerPids(containerId)
+	switch {
+	case errors.Is(err, containers.ErrContainerNotFound):
+		return 0, status.Errorf(codes.NotFound, "container %s not found", containerId)
+	case errors.Is(err, containers.ErrContainerAmbiguous):
+		return 0, status.Errorf(codes.InvalidArgument, "container id %s is ambiguous", containerId)
+	case err != nil:
+		return 0, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	var first *proctree.Process
+	for _, pid := range pids {
+		process, ok := s.processTree.GetProcessByPid(pid, queryTime)
+		if !ok {
+			continue
+		}
+		if first == nil || process.GetInfo().GetStartTimeNS() < first.GetInfo().GetStartTimeNS() {
+			first = process
+		}
+	}
+	if first == nil {
+		return 0, status.Errorf(codes.NotFound, "no process of container %s found", containerId)
+	}
+
+	return first.GetHash(), nil
+}
+
+// parseQueryTime returns the time of a query, now if not given
+func parseQueryTime(at *timestamppb.Timestamp) (time.Time, error) {
+	if at == nil {
+		return time.Now(), nil
+	}
+	if err := at.CheckValid(); err != nil {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "invalid time: %v", err)
+	}
+
+	return at.AsTime(), nil
+}
+
+// getProcessNode returns the process of the given entity ID as it was at the given time
+func getProcessNode(ds *proctree.DataSource, entityId uint32, at time.Time) (*pb.ProcessNode, error) {
+	data, err := ds.Get(datasource.ProcKey{EntityId: entityId, Time: at})
+	if err != nil {
+		return nil, processError(err, entityId)
+	}
+
+	return convertProcessToProto(ds, data["process_info"].(datasource.TimeRelevantInfo[datasource.ProcessInfo])), nil
+}
+
+func processError(err error, entityId uint32) error {
+	if errors.Is(err, detect.ErrDataNotFound) {
+		return status.Errorf(codes.NotFound, "process with entity_id %d not found", entityId)
+	}
+	return status.Errorf(codes.Internal, "%v", err)
+}
+
+func convertProcessToProto(ds *proctree.DataSource, info datasource.TimeRelevantInfo[datasource.ProcessInfo]) *pb.ProcessNode {
+	p := info.Info
+
+	node := &pb.ProcessNode{
+		EntityId:       p.EntityId,
+		ParentEntityId: p.ParentEntityId,
+		Pid:            uint32(p.Pid),
+		NsPid:          uint32(p.NsPid),
+		Ppid:           uint32(p.Ppid),
+		Executable: &pb.ProcessFile{
+			Path:   p.ExecutionBinary.Path,
+			Inode:  uint64(p.ExecutionBinary.Inode),
+			Device: uint32(p.ExecutionBinary.Device),
+			Ctime:  timestamppb.New(p.ExecutionBinary.Ctime),
+			Mode:   uint32(p.ExecutionBinary.Mode),
+		},
+		StartTime: timestamppb.New(p.StartTime),
+		Alive:     p.IsAlive,
+		At:        timestamppb.New(info.Timestamp),
+	}
+
+	// Not exited processes have the boot time as exit time.
+	if p.ExitTime.After(p.StartTime) {
+		node.ExitTime = timestamppb.New(p.ExitTime)
+	}
+
+	// The process shares its name and credentials with its thread group leader thread.
+	if data, err := ds.Get(datasource.ThreadKey{EntityId: p.EntityId, Time: info.Timestamp}); err == nil {
+		thread := data["thread_info"].(datasource.TimeRelevantInfo[datasource.ThreadInfo]).Info
+		node.Name = thread.Name
+		node.Uid = uint32(thread.UserId)
+		node.Gid = uint32(thread.GroupId)
+	}
+
+	for tid := range p.ThreadsIds {
+		node.ThreadIds = append(node.ThreadIds, uint32(tid))
+	}
+	sort.Slice(node.ThreadIds, func(i, j int) bool { return node.ThreadIds[i] < node.ThreadIds[j] })
+
+	// Children are ordered by pid, as their entity IDs are hashes.
+	pids := make([]int, 0, len(p.ChildProcessesIds))
+	for pid := range p.ChildProcessesIds {
+		pids = append(pids, pid)
+	}
+	sort.Ints(pids)
+	for _, pid := range pids {
+		node.ChildrenEntityIds = append(node.ChildrenEntityIds, p.ChildProcessesIds[pid])
+	}
+
+	return node
+}
@@ -0,0 +1,233 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/khulnasoft-lab/tracker/api/v1beta1"
+	"github.com/khulnasoft-lab/tracker/pkg/containers"
+	"github.com/khulnasoft-lab/tracker/pkg/proctree"
+	"github.com/khulnasoft-lab/tracker/pkg/utils"
+)
+
+// newTestProcessTree returns a process tree of init (1) -> bash (100) -> make (200) -> cc (300),
+// with bash also the parent of ls (400), that exited. The entity IDs are the pids, but for the
+// process of init that reused pid 400 later (entity ID 401), and exited too.
+func newTestProcessTree(t *testing.T) *proctree.ProcessTree {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	pt, err := proctree.NewProcessTree(ctx, proctree.ProcTreeConfig{
+		Source:           proctree.SourceEvents,
+		ProcessCacheSize: proctree.DefaultProcessCacheSize,
+		ThreadCacheSize:  proctree.DefaultThreadCacheSize,
+	})
+	require.NoError(t, err)
+
+	forkTask := func(parent int32, childHash uint32, child int32, start uint64) {
+		require.NoError(t, pt.FeedFromFork(proctree.ForkFeed{
+			TimeStamp:       start,
+			ChildHash:       childHash,
+			ParentHash:      uint32(parent),
+			LeaderHash:      childHash,
+			ParentTid:       parent,
+			ParentNsTid:     parent,
+			ParentPid:       parent,
+			ParentNsPid:     parent,
+			ParentStartTime: 1,
+			LeaderTid:       child,
+			LeaderNsTid:     child,
+			LeaderPid:       child,
+			LeaderNsPid:     child,
+			LeaderStartTime: start,
+			ChildTid:        child,
+			ChildNsTid:      child,
+			ChildPid:        child,
+			ChildNsPid:      child,
+			ChildStartTime:  start,
+		}))
+	}
+	fork := func(parent, child int32, start uint64) {
+		forkTask(parent, uint32(child), child, start)
+	}
+	exec := func(pid int32, path string, at uint64) {
+		require.NoError(t, pt.FeedFromExec(proctree.ExecFeed{
+			TimeStamp: at,
+			TaskHash:  uint32(pid),
+			CmdPath:   path,
+			PathName:  path,
+			Inode:     uint64(pid),
+		}))
+	}
+
+	fork(1, 100, 1e9)
+	exec(100, "/bin/bash", 1e9+1)
+	fork(100, 200, 2e9)
+	exec(200, "/usr/bin/make", 2e9+1)
+	fork(200, 300, 3e9)
+	exec(300, "/usr/bin/cc", 3e9+1)
+	fork(100, 400, 4e9)
+	exec(400, "/bin/ls", 4e9+1)
+	require.NoError(t, pt.FeedFromExit(proctree.ExitFeed{TimeStamp: 5e9, TaskHash: 400, LeaderHash: 400}))
+	forkTask(1, 401, 400, 6e9)
+	require.NoError(t, pt.FeedFromExit(proctree.ExitFeed{TimeStamp: 7e9, TaskHash: 401, LeaderHash: 401}))
+
+	return pt
+}
+
+// testContainers gives the pids of the containers by their ID
+type testContainers map[string][]int
+
+func (c testContainers) GetContainerPids(containerID string) ([]int, error) {
+	if containerID == "c" {
+		return nil, fmt.Errorf("%w: %s", containers.ErrContainerAmbiguous, containerID)
+	}
+	pids, ok := c[containerID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", containers.ErrContainerNotFound, containerID)
+	}
+	return pids, nil
+}
+
+func TestProcessTreeService(t *testing.T) {
+	t.Parallel()
+
+	s := &ProcessTreeService{
+		processTree: newTestProcessTree(t),
+		containers:  testContainers{"c1": {300, 200}, "c2": {400}},
+	}
+	ctx := context.Background()
+	beforeExit := timestamppb.New(utils.NsSinceBootTimeToTime(4.5e9))
+
+	pids := func(nodes []*pb.ProcessNode) []uint32 {
+		var pids []uint32
+		for _, n := range nodes {
+			pids = append(pids, n.Pid)
+		}
+		return pids
+	}
+
+	t.Run("get process by pid", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := s.GetProcess(ctx, &pb.GetProcessRequest{Pid: 200})
+		require.NoError(t, err)
+		assert.Equal(t, uint32(200), res.Process.EntityId)
+		assert.Equal(t, uint32(100), res.Process.ParentEntityId)
+		assert.Equal(t, uint32(100), res.Process.Ppid)
+		assert.Equal(t, "make", res.Process.Name)
+		assert.Equal(t, "/usr/bin/make", res.Process.Executable.Path)
+		assert.Equal(t, []uint32{200}, res.Process.ThreadIds)
+		assert.Equal(t, []uint32{300}, res.Process.ChildrenEntityIds)
+		assert.True(t, res.Process.Alive)
+		assert.Nil(t, res.Process.ExitTime)
+	})
+
+	t.Run("get exited process", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := s.GetProcess(ctx, &pb.GetProcessRequest{Pid: 400})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		res, err := s.GetProcess(ctx, &pb.GetProcessRequest{Pid: 400, At: beforeExit})
+		require.NoError(t, err)
+		assert.True(t, res.Process.Alive)
+		assert.NotNil(t, res.Process.ExitTime)
+
+		res, err = s.GetProcess(ctx, &pb.GetProcessRequest{EntityId: 400})
+		require.NoError(t, err)
+		assert.False(t, res.Process.Alive)
+	})
+
+	t.Run("get process by reused pid", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := s.GetProcess(ctx, &pb.GetProcessRequest{Pid: 400, At: beforeExit})
+		require.NoError(t, err)
+		assert.Equal(t, uint32(400), res.Process.EntityId)
+
+		res, err = s.GetProcess(ctx, &pb.GetProcessRequest{Pid: 400, At: timestamppb.New(utils.NsSinceBootTimeToTime(6.5e9))})
+		require.NoError(t, err)
+		assert.Equal(t, uint32(401), res.Process.EntityId)
+		assert.Equal(t, uint32(1), res.Process.ParentEntityId)
+	})
+
+	t.Run("get lineage", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := s.GetLineage(ctx, &pb.GetLineageRequest{EntityId: 300})
+		require.NoError(t, err)
+		assert.Equal(t, []uint32{300, 200, 100, 1}, pids(res.Lineage))
+		assert.Equal(t, "bash", res.Lineage[2].Name)
+
+		res, err = s.GetLineage(ctx, &pb.GetLineageRequest{Pid: 300, MaxDepth: 1})
+		require.NoError(t, err)
+		assert.Equal(t, []uint32{300, 200}, pids(res.Lineage))
+	})
+
+	t.Run("list children", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := s.ListChildren(ctx, &pb.ListChildrenRequest{Pid: 100})
+		require.NoError(t, err)
+		assert.Equal(t, []uint32{200}, pids(res.Children))
+
+		res, err = s.ListChildren(ctx, &pb.ListChildrenRequest{Pid: 100, Recursive: true})
+		require.NoError(t, err)
+		assert.Equal(t, []uint32{200, 300}, pids(res.Children))
+
+		res, err = s.ListChildren(ctx, &pb.ListChildrenRequest{Pid: 100, At: beforeExit})
+		require.NoError(t, err)
+		assert.Equal(t, []uint32{200, 400}, pids(res.Children))
+	})
+
+	t.Run("list container children", func(t *testing.T) {
+		t.Parallel()
+
+		// the tree is listed from the first process of the container
+		res, err := s.ListChildren(ctx, &pb.ListChildrenRequest{ContainerId: "c1", Recursive: true})
+		require.NoError(t, err)
+		assert.Equal(t, []uint32{300}, pids(res.Children))
+
+		_, err = s.ListChildren(ctx, &pb.ListChildrenRequest{ContainerId: "c2"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		res, err = s.ListChildren(ctx, &pb.ListChildrenRequest{ContainerId: "c2", At: beforeExit})
+		require.NoError(t, err)
+		assert.Empty(t, res.Children)
+
+		_, err = s.ListChildren(ctx, &pb.ListChildrenRequest{ContainerId: "c3"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		_, err = s.ListChildren(ctx, &pb.ListChildrenRequest{ContainerId: "c"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		noContainers := &ProcessTreeService{processTree: s.processTree}
+		_, err = noContainers.ListChildren(ctx, &pb.ListChildrenRequest{ContainerId: "c1"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := s.GetProcess(ctx, &pb.GetProcessRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = s.GetLineage(ctx, &pb.GetLineageRequest{EntityId: 999})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		_, err = s.ListChildren(ctx, &pb.ListChildrenRequest{Pid: 100, At: &timestamppb.Timestamp{Nanos: -1}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		disabled := &ProcessTreeService{}
+		_, err = disabled.GetProcess(ctx, &pb.GetProcessRequest{Pid: 1})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
@@ -11,6 +11,8 @@ import (
 	pb "github.com/khulnasoft-lab/tracker/api/v1beta1"
 	tracker "github.com/khulnasoft-lab/tracker/pkg/ebpf"
 	"github.com/khulnasoft-lab/tracker/pkg/logger"
+	"github.com/khulnasoft-lab/tracker/pkg/proctree"
+	"github.com/khulnasoft-lab/tracker/pkg/server/auth"
 	"github.com/khulnasoft-lab/tracker/pkg/signatures/engine"
 )
 
@@ -19,6 +21,7 @@ type Server struct {
 	protocol   string
 	listenAddr string
 	server     *grpc.Server
+	auth       *auth.Authenticator
 }
 
 func New(protocol, listenAddr string) (*Server, error) {
@@ -34,6 +37,12 @@ func New(protocol, listenAddr string) (*Server, error) {
 	return &Server{listener: lis, protocol: protocol, listenAddr: listenAddr}, nil
 }
 
+// SetAuthenticator secures the server connections and authorizes its calls with the given
+// authenticator
+func (s *Server) SetAuthenticator(a *auth.Authenticator) {
+	s.auth = a
+}
+
 func (s *Server) Start(ctx context.Context, t *tracker.Tracker, e *engine.Engine) {
 	srvCtx, srvCancel := context.WithCancel(ctx)
 	defer srvCancel()
@@ -44,11 +53,22 @@ func (s *Server) Start(ctx context.Context, t *tracker.Tracker, e *engine.Engine
 		Timeout: 1 * time.Second, // Wait 1 second for the ping ack before assuming the connection is dead
 	}
 
-	grpcServer := grpc.NewServer(grpc.KeepaliveParams(keepaliveParams))
+	opts := []grpc.ServerOption{grpc.KeepaliveParams(keepaliveParams)}
+	if s.auth != nil {
+		opts = append(opts,
+			grpc.Creds(newTransportCredentials(s.auth.TLSConfig())),
+			grpc.UnaryInterceptor(s.authorizeUnary),
+			grpc.StreamInterceptor(s.authorizeStream),
+		)
+	}
+
+	grpcServer := grpc.NewServer(opts...)
 	s.server = grpcServer
 	pb.RegisterTrackerServiceServer(grpcServer, &TrackerService{tracker: t})
 	pb.RegisterDiagnosticServiceServer(grpcServer, &DiagnosticService{tracker: t})
 	pb.RegisterDataSourceServiceServer(grpcServer, &DataSourceService{sigEngine: e})
+	pb.RegisterPolicyServiceServer(grpcServer, &PolicyService{tracker: t})
+	pb.RegisterProcessTreeServiceServer(grpcServer, &ProcessTreeService{processTree: processTree(t), containers: containerProcessesOf(t)})
 
 	go func() {
 		logger.Debugw("Starting grpc server", "protocol", s.protocol, "address", s.listenAddr)
@@ -68,6 +88,21 @@ func (s *Server) Start(ctx context.Context, t *tracker.Tracker, e *engine.Engine
 	}
 }
 
+// processTree returns the process tree of the given tracker, if any
+func processTree(t *tracker.Tracker) *proctree.ProcessTree {
+	if t == nil {
+		return nil
+	}
+	return t.ProcessTree()
+}
+
+func containerProcessesOf(t *tracker.Tracker) containerProcesses {
+	if t == nil || t.Containers() == nil {
+		return nil
+	}
+	return t.Containers()
+}
+
 func (s *Server) cleanup() {
 	s.server.GracefulStop()
 }
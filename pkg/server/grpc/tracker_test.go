@@ -1,144 +1,86 @@
 package grpc
 
 import (
+	"errors"
+	"fmt"
 	"testing"
-	"time"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/khulnasoft-lab/tracker/api/v1beta1"
-	"github.com/khulnasoft-lab/tracker/pkg/events"
-	"github.com/khulnasoft-lab/tracker/types/trace"
+	tracker "github.com/khulnasoft-lab/tracker/pkg/ebpf"
+	"github.com/khulnasoft-lab/tracker/pkg/policy"
+	"github.com/khulnasoft-lab/tracker/pkg/streams"
 )
 
-func Test_convertEventWithProcessContext(t *testing.T) {
+func Test_getStreamConfig(t *testing.T) {
 	t.Parallel()
 
-	unixTime := int(time.Now().UnixNano())
-
-	traceEvent := trace.Event{
-		Timestamp:           unixTime,
-		ThreadStartTime:     unixTime,
-		ProcessID:           1,
-		ThreadID:            2,
-		HostProcessID:       3,
-		HostThreadID:        4,
-		ParentProcessID:     5,
-		HostParentProcessID: 6,
-		UserID:              7,
-		ProcessName:         "processTest",
-		EventID:             int(events.Execve),
-		EventName:           "eventTest",
-		MatchedPolicies:     []string{"policyTest"},
-		Syscall:             "syscall",
-		ContextFlags:        trace.ContextFlags{ContainerStarted: true},
-		ThreadEntityId:      9,
-		ProcessEntityId:     10,
-		ParentEntityId:      11,
-	}
-
-	protoEvent, err := convertTrackerEventToProto(traceEvent)
+	config, err := getStreamConfig(&pb.StreamEventsRequest{})
 	assert.NoError(t, err)
+	assert.Equal(t, streams.Block, config.Backpressure)
 
-	assert.Equal(t, uint32(1), protoEvent.Context.Process.Pid.Value)
-	assert.Equal(t, uint32(2), protoEvent.Context.Process.Thread.Tid.Value)
-	assert.Equal(t, uint32(3), protoEvent.Context.Process.HostPid.Value)
-	assert.Equal(t, uint32(4), protoEvent.Context.Process.Thread.HostTid.Value)
-	assert.Equal(t, uint32(5), protoEvent.Context.Process.Ancestors[0].Pid.Value)
-	assert.Equal(t, uint32(6), protoEvent.Context.Process.Ancestors[0].HostPid.Value)
-	assert.Equal(t, uint32(7), protoEvent.Context.Process.RealUser.Id.Value)
-	assert.Equal(t, pb.EventId_execve, protoEvent.Id)
-	assert.Equal(t, uint32(9), protoEvent.Context.Process.Thread.UniqueId.Value)
-	assert.Equal(t, uint32(10), protoEvent.Context.Process.UniqueId.Value)
-	assert.Equal(t, uint32(11), protoEvent.Context.Process.Ancestors[0].UniqueId.Value)
-	assert.Equal(t, "eventTest", protoEvent.Name)
-	assert.Equal(t, []string{"policyTest"}, protoEvent.Policies.Matched)
-	assert.Equal(t, "processTest", protoEvent.Context.Process.Thread.Name)
-	assert.Equal(t, "syscall", protoEvent.Context.Process.Thread.Syscall)
-	assert.Equal(t, true, protoEvent.Context.Process.Thread.Compat)
-}
-
-func Test_convertEventWithStackaddresses(t *testing.T) {
-	t.Parallel()
-
-	traceEvent := trace.Event{
-		StackAddresses: []uint64{1, 2, 3},
-	}
-
-	protoEvent, err := convertTrackerEventToProto(traceEvent)
+	config, err = getStreamConfig(&pb.StreamEventsRequest{
+		Backpressure: pb.StreamBackpressure_BACKPRESSURE_DROP_OLDEST,
+	})
 	assert.NoError(t, err)
+	assert.Equal(t, streams.DropOldest, config.Backpressure)
 
-	expected := []*pb.StackAddress{
-		{Address: 1},
-		{Address: 2},
-		{Address: 3},
-	}
-
-	for i := range expected {
-		assert.Equal(t, expected[i].Address, protoEvent.Context.Process.Thread.UserStackTrace.Addresses[i].Address)
-	}
-}
-
-func Test_convertEventWithContainerContext(t *testing.T) {
-	t.Parallel()
-
-	traceEvent := trace.Event{
-		Container: trace.Container{
-			ID:          "containerID",
-			Name:        "containerName",
-			ImageName:   "imageName",
-			ImageDigest: "imageDigest",
-		},
-	}
-
-	protoEvent, err := convertTrackerEventToProto(traceEvent)
+	config, err = getStreamConfig(&pb.StreamEventsRequest{
+		Backpressure: pb.StreamBackpressure_BACKPRESSURE_DISCONNECT,
+		MaxDrops:     100,
+	})
 	assert.NoError(t, err)
+	assert.Equal(t, streams.Disconnect, config.Backpressure)
+	assert.Equal(t, uint64(100), config.MaxDrops)
 
-	assert.Equal(t, "containerID", protoEvent.Context.Container.Id)
-	assert.Equal(t, "containerName", protoEvent.Context.Container.Name)
-	assert.Equal(t, "imageName", protoEvent.Context.Container.Image.Name)
-	assert.Equal(t, []string{"imageDigest"}, protoEvent.Context.Container.Image.RepoDigests)
+	_, err = getStreamConfig(&pb.StreamEventsRequest{
+		Backpressure: pb.StreamBackpressure_BACKPRESSURE_DISCONNECT,
+	})
+	assert.Error(t, err)
 }
 
-func Test_convertEventWithK8sContext(t *testing.T) {
+func Test_trackerError(t *testing.T) {
 	t.Parallel()
 
-	traceEvent := trace.Event{
-		Kubernetes: trace.Kubernetes{
-			PodName:      "podName",
-			PodNamespace: "podNamespace",
-			PodUID:       "podUID",
+	testCases := []struct {
+		name     string
+		err      error
+		expected codes.Code
+	}{
+		{
+			name:     "unknown event",
+			err:      fmt.Errorf("%w: no_such_event", tracker.ErrEventNotFound),
+			expected: codes.NotFound,
+		},
+		{
+			name:     "unknown policy",
+			err:      policy.PolicyNotFoundByNameError("no_such_policy"),
+			expected: codes.NotFound,
+		},
+		{
+			name:     "resume ahead",
+			err:      fmt.Errorf("%w: sequence 10, last published 2", streams.ErrResumeAhead),
+			expected: codes.OutOfRange,
+		},
+		{
+			name:     "other error",
+			err:      errors.New("failed to update the policies"),
+			expected: codes.Internal,
 		},
 	}
 
-	protoEvent, err := convertTrackerEventToProto(traceEvent)
-	assert.NoError(t, err)
+	for _, tc := range testCases {
+		tc := tc
 
-	assert.Equal(t, "podName", protoEvent.Context.K8S.Pod.Name)
-	assert.Equal(t, "podNamespace", protoEvent.Context.K8S.Namespace.Name)
-	assert.Equal(t, "podUID", protoEvent.Context.K8S.Pod.Uid)
-}
-
-func Test_convertEventWithThreat(t *testing.T) {
-	t.Parallel()
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
 
-	traceEvent := trace.Event{
-		Metadata: &trace.Metadata{
-			Description: "An attempt to abuse the Docker UNIX ..",
-			Properties: map[string]interface{}{
-				"Severity":    2,
-				"Category":    "privilege-escalation",
-				"Technique":   "Exploitation for Privilege Escalation",
-				"external_id": "T1068",
-			},
-		},
+			err := trackerError(tc.err)
+			assert.Equal(t, tc.expected, status.Code(err))
+			assert.Equal(t, tc.err.Error(), status.Convert(err).Message())
+		})
 	}
-
-	protoEvent, err := convertTrackerEventToProto(traceEvent)
-	assert.NoError(t, err)
-
-	assert.Equal(t, "An attempt to abuse the Docker UNIX ..", protoEvent.Threat.Description)
-	assert.Equal(t, "privilege-escalation", protoEvent.Threat.Mitre.Tactic.Name)
-	assert.Equal(t, "Exploitation for Privilege Escalation", protoEvent.Threat.Mitre.Technique.Name)
-	assert.Equal(t, "T1068", protoEvent.Threat.Mitre.Technique.Id)
 }
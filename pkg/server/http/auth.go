@@ -0,0 +1,49 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/khulnasoft-lab/tracker/pkg/server/auth"
+)
+
+// authorize returns a handler which checks the credentials of the requests against the
+// role their endpoint requires, before passing them to the next handler
+func authorize(a *auth.Authenticator, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		creds := auth.Credentials{Address: r.RemoteAddr, TLS: r.TLS}
+		if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+			creds.Token = token
+		}
+
+		err := a.Authorize(r.Method+" "+r.URL.Path, creds, requestRole(r))
+		switch {
+		case errors.Is(err, auth.ErrUnauthenticated):
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		case errors.Is(err, auth.ErrPermissionDenied):
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// requestRole returns the role required by the request: health checks are open to probes,
+// profiles expose the memory of tracker, and otherwise reading is allowed to the
+// read-only role
+func requestRole(r *http.Request) auth.Role {
+	switch {
+	case r.URL.Path == "/healthz":
+		return auth.NoRole
+	case strings.HasPrefix(r.URL.Path, "/debug/pprof/"):
+		return auth.Admin
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		return auth.ReadOnly
+	}
+
+	return auth.Admin
+}
@@ -0,0 +1,74 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/khulnasoft-lab/tracker/pkg/server/auth"
+)
+
+func TestServerAuth(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	adminTokens := filepath.Join(dir, "admin-tokens")
+	readTokens := filepath.Join(dir, "read-tokens")
+	require.NoError(t, os.WriteFile(adminTokens, []byte("ci abc123\n"), 0600))
+	require.NoError(t, os.WriteFile(readTokens, []byte("grafana def456\n"), 0600))
+
+	a, err := auth.New(auth.Config{
+		TokenFiles: map[string]auth.Role{adminTokens: auth.Admin, readTokens: auth.ReadOnly},
+	})
+	require.NoError(t, err)
+
+	httpServer := New("")
+	httpServer.EnableMetricsEndpoint()
+	httpServer.EnableHealthzEndpoint()
+	httpServer.EnablePProfEndpoint()
+	httpServer.EnableAPIEndpoint()
+	httpServer.SetAPIHandler("/api/v1/", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {}))
+	httpServer.SetAuthenticator(a)
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		token  string
+		status int
+	}{
+		{name: "healthz without token", method: http.MethodGet, path: "/healthz", status: http.StatusOK},
+		{name: "metrics without token", method: http.MethodGet, path: "/metrics", status: http.StatusUnauthorized},
+		{name: "metrics with invalid token", method: http.MethodGet, path: "/metrics", token: "nope", status: http.StatusUnauthorized},
+		{name: "metrics with read-only token", method: http.MethodGet, path: "/metrics", token: "def456", status: http.StatusOK},
+		{name: "pprof with read-only token", method: http.MethodGet, path: "/debug/pprof/", token: "def456", status: http.StatusForbidden},
+		{name: "pprof with admin token", method: http.MethodGet, path: "/debug/pprof/", token: "abc123", status: http.StatusOK},
+		{name: "api change with read-only token", method: http.MethodPost, path: "/api/v1/events/openat:enable", token: "def456", status: http.StatusForbidden},
+		{name: "api change with admin token", method: http.MethodPost, path: "/api/v1/events/openat:enable", token: "abc123", status: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			rec := httptest.NewRecorder()
+			httpServer.hs.Handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.status, rec.Code)
+			if tt.status == http.StatusUnauthorized {
+				assert.Equal(t, "Bearer", rec.Header().Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	"github.com/khulnasoft-lab/tracker/pkg/logger"
+	"github.com/khulnasoft-lab/tracker/pkg/server/auth"
 )
 
 // Server represents a http server
@@ -17,6 +18,7 @@ type Server struct {
 	hs             *http.Server
 	mux            *http.ServeMux // just an exposed copy of hs.Handler
 	metricsEnabled bool
+	apiEnabled     bool
 	pyroProfiler   *pyroscope.Profiler
 }
 
@@ -46,6 +48,28 @@ func (s *Server) EnableHealthzEndpoint() {
 	})
 }
 
+// EnableAPIEndpoint enables the API endpoint, served once its handler is set
+func (s *Server) EnableAPIEndpoint() {
+	s.apiEnabled = true
+}
+
+// APIEndpointEnabled returns true if the API endpoint is enabled
+func (s *Server) APIEndpointEnabled() bool {
+	return s.apiEnabled
+}
+
+// SetAPIHandler serves the API endpoint with the given handler under the given path
+func (s *Server) SetAPIHandler(path string, handler http.Handler) {
+	s.mux.Handle(path, handler)
+}
+
+// SetAuthenticator secures the server connections and authorizes its requests with the
+// given authenticator
+func (s *Server) SetAuthenticator(a *auth.Authenticator) {
+	s.hs.Handler = authorize(a, s.mux)
+	s.hs.TLSConfig = a.TLSConfig()
+}
+
 // Start starts the http server on the listen address
 func (s *Server) Start(ctx context.Context) {
 	srvCtx, srvCancel := context.WithCancel(ctx)
@@ -55,7 +79,13 @@ func (s *Server) Start(ctx context.Context) {
 		logger.Debugw("Starting serving metrics endpoint goroutine", "address", s.hs.Addr)
 		defer logger.Debugw("Stopped serving metrics endpoint goroutine")
 
-		if err := s.hs.ListenAndServe(); err != http.ErrServerClosed {
+		var err error
+		if s.hs.TLSConfig != nil {
+			err = s.hs.ListenAndServeTLS("", "") // the certificate is in the tls config
+		} else {
+			err = s.hs.ListenAndServe()
+		}
+		if err != http.ErrServerClosed {
 			logger.Errorw("Serving metrics endpoint", "error", err)
 		}
 
@@ -17,6 +17,12 @@ func TestServer(t *testing.T) {
 	httpServer.EnableMetricsEndpoint()
 	httpServer.EnableHealthzEndpoint()
 	httpServer.EnablePProfEndpoint()
+	httpServer.EnableAPIEndpoint()
+	httpServer.SetAPIHandler("/api/v1/", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprintf(w, "API")
+	}))
+
+	assert.True(t, httpServer.APIEndpointEnabled())
 
 	server := httptest.NewServer(httpServer.mux)
 	defer server.Close()
@@ -29,6 +35,7 @@ func TestServer(t *testing.T) {
 		{name: "TestHealthzEndpoint", endpoint: "/healthz", status: 200},
 		{name: "TestMetricsEndpoint", endpoint: "/metrics", status: 200},
 		{name: "TestPProfEndpoint", endpoint: "/debug/pprof", status: 200},
+		{name: "TestAPIEndpoint", endpoint: "/api/v1/version", status: 200},
 		{name: "TestIndexEndpoint", endpoint: "", status: 404},
 	}
 
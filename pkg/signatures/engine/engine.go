@@ -2,13 +2,17 @@ package engine
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/khulnasoft-lab/tracker/pkg/logger"
+	"github.com/khulnasoft-lab/tracker/pkg/redact"
 	"github.com/khulnasoft-lab/tracker/pkg/signatures/metrics"
 	"github.com/khulnasoft-lab/tracker/types/detect"
 	"github.com/khulnasoft-lab/tracker/types/protocol"
+	"github.com/khulnasoft-lab/tracker/types/trace"
 )
 
 const ALL_EVENT_ORIGINS = "*"
@@ -29,6 +33,10 @@ type Config struct {
 	// this solution should be abandoned in favor of using it alongside the engine.
 	ShouldDispatchEvent func(eventIdInt32 int32) bool
 
+	// Redactor masks sensitive data in the events dispatched to the signatures opting out
+	// of the raw arguments (with the "redact" metadata property). Nil disables redaction.
+	Redactor *redact.Redactor
+
 	// General engine configuration
 	SignatureBufferSize uint
 	Signatures          []detect.Signature
@@ -39,6 +47,8 @@ type Config struct {
 type Engine struct {
 	signatures       map[detect.Signature]chan protocol.Event
 	signaturesIndex  map[detect.SignatureEventSelector][]detect.Signature
+	redacted         map[detect.Signature]bool // signatures opting out of the raw arguments
+	signaturesStats  map[detect.Signature]*metrics.SignatureStats
 	signaturesMutex  sync.RWMutex
 	inputs           EventSources
 	output           chan *detect.Finding
@@ -75,6 +85,8 @@ func NewEngine(config Config, sources EventSources, output chan *detect.Finding)
 	engine.signaturesMutex.Lock()
 	engine.signatures = make(map[detect.Signature]chan protocol.Event)
 	engine.signaturesIndex = make(map[detect.SignatureEventSelector][]detect.Signature)
+	engine.redacted = make(map[detect.Signature]bool)
+	engine.signaturesStats = make(map[detect.Signature]*metrics.SignatureStats)
 	engine.signaturesMutex.Unlock()
 
 	engine.dataSourcesMutex.Lock()
@@ -145,6 +157,7 @@ func (engine *Engine) unloadAllSignatures() {
 // matchHandler is a function that runs when a signature is matched
 func (engine *Engine) matchHandler(res *detect.Finding) {
 	_ = engine.stats.Detections.Increment()
+	_ = engine.stats.Signature(res.SigMetadata.ID).Findings.Increment()
 	engine.output <- res
 }
 
@@ -170,11 +183,13 @@ func (engine *Engine) processEvent(event protocol.Event) {
 	}
 	_ = engine.stats.Events.Increment()
 
+	dispatched := &dispatchedEvent{event: event, redactor: engine.config.Redactor}
+
 	// Check the selector for every case and partial case
 
 	// Match full selector
 	for _, s := range engine.signaturesIndex[signatureSelector] {
-		engine.dispatchEvent(s, event)
+		engine.dispatchEvent(s, dispatched)
 	}
 
 	// Match partial selector, select for all origins
@@ -184,7 +199,7 @@ func (engine *Engine) processEvent(event protocol.Event) {
 		Origin: ALL_EVENT_ORIGINS,
 	}
 	for _, s := range engine.signaturesIndex[partialSigEvtSelector] {
-		engine.dispatchEvent(s, event)
+		engine.dispatchEvent(s, dispatched)
 	}
 
 	// Match partial selector, select for event names
@@ -194,7 +209,7 @@ func (engine *Engine) processEvent(event protocol.Event) {
 		Origin: signatureSelector.Origin,
 	}
 	for _, s := range engine.signaturesIndex[partialSigEvtSelector] {
-		engine.dispatchEvent(s, event)
+		engine.dispatchEvent(s, dispatched)
 	}
 
 	// Match partial selector, select for all origins and event names
@@ -204,7 +219,7 @@ func (engine *Engine) processEvent(event protocol.Event) {
 		Origin: ALL_EVENT_ORIGINS,
 	}
 	for _, s := range engine.signaturesIndex[partialSigEvtSelector] {
-		engine.dispatchEvent(s, event)
+		engine.dispatchEvent(s, dispatched)
 	}
 }
 
@@ -258,15 +273,44 @@ drain:
 	}
 }
 
-func (engine *Engine) dispatchEvent(s detect.Signature, event protocol.Event) {
+func (engine *Engine) dispatchEvent(s detect.Signature, dispatched *dispatchedEvent) {
 	if engine.config.Enabled {
 		// Do this test only if engine runs as part of the event pipeline
-		if ok := engine.filterDispatchInPipeline(s, event); !ok {
+		if ok := engine.filterDispatchInPipeline(s, dispatched.event); !ok {
 			return
 		}
 	}
 
-	engine.signatures[s] <- event
+	_ = engine.signaturesStats[s].Events.Increment()
+
+	if engine.redacted[s] {
+		engine.signatures[s] <- dispatched.redactedEvent()
+		return
+	}
+
+	engine.signatures[s] <- dispatched.event
+}
+
+// dispatchedEvent is an event dispatched to the signatures, redacted once for all the
+// signatures opting out of the raw arguments
+type dispatchedEvent struct {
+	event    protocol.Event
+	redactor *redact.Redactor
+	redacted *protocol.Event
+}
+
+func (d *dispatchedEvent) redactedEvent() protocol.Event {
+	if d.redacted != nil {
+		return *d.redacted
+	}
+
+	redacted := d.event
+	if payload, ok := redacted.Payload.(trace.Event); ok && d.redactor.Redact(&payload) {
+		redacted.Payload = payload
+	}
+	d.redacted = &redacted
+
+	return redacted
 }
 
 func (engine *Engine) filterDispatchInPipeline(s detect.Signature, event protocol.Event) bool {
@@ -334,6 +378,10 @@ func (engine *Engine) loadSignature(signature detect.Signature) (string, error)
 	c := make(chan protocol.Event, engine.config.SignatureBufferSize)
 	engine.signaturesMutex.Lock()
 	engine.signatures[signature] = c
+	engine.signaturesStats[signature] = engine.stats.Signature(metadata.ID)
+	if optOut, ok := metadata.Properties["redact"].(bool); ok && optOut {
+		engine.redacted[signature] = true
+	}
 	engine.signaturesMutex.Unlock()
 
 	// insert in engine.signaturesIndex map
@@ -372,6 +420,57 @@ func (engine *Engine) UnloadSignature(signatureId string) error {
 	if signature == nil {
 		return fmt.Errorf("could not find signature with ID: %v", signatureId)
 	}
+
+	return engine.unloadSignature(signature)
+}
+
+// ReplaceSignatures replaces the loaded signatures with the given ones, matching them by ID.
+// New versions are loaded before the old ones are unloaded, so events are not missed while
+// signatures are replaced. If a signature fails to load, its old version is kept loaded.
+func (engine *Engine) ReplaceSignatures(signatures []detect.Signature) error {
+	engine.signaturesMutex.RLock()
+	current := make(map[string]detect.Signature, len(engine.signatures))
+	for sig := range engine.signatures {
+		metadata, _ := sig.GetMetadata()
+		current[metadata.ID] = sig
+	}
+	engine.signaturesMutex.RUnlock()
+
+	var errs []error
+	for _, sig := range signatures {
+		metadata, _ := sig.GetMetadata()
+		old, ok := current[metadata.ID]
+		if ok && old == sig {
+			// same signature instance (e.g. golang plugins are only opened once)
+			delete(current, metadata.ID)
+			continue
+		}
+		if _, err := engine.LoadSignature(sig); err != nil {
+			errs = append(errs, err)
+			delete(current, metadata.ID) // keep the old version (if any)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		delete(current, metadata.ID)
+		if err := engine.unloadSignature(old); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// unload signatures which are gone
+	for _, sig := range current {
+		if err := engine.unloadSignature(sig); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// unloadSignature removes the given signature from Engine data structures
+func (engine *Engine) unloadSignature(signature detect.Signature) error {
 	selectedEvents, err := signature.GetSelectedEvents()
 	if err != nil {
 		return fmt.Errorf("failed to unload signature: %w", err)
@@ -382,6 +481,8 @@ func (engine *Engine) UnloadSignature(signatureId string) error {
 	c, ok := engine.signatures[signature]
 	if ok {
 		delete(engine.signatures, signature)
+		delete(engine.redacted, signature)
+		delete(engine.signaturesStats, signature)
 		defer func() {
 			_ = engine.stats.Signatures.Decrement()
 		}()
@@ -390,10 +491,16 @@ func (engine *Engine) UnloadSignature(signatureId string) error {
 	}
 	// remove from engine.signaturesIndex map
 	for _, selectedEvent := range selectedEvents {
+		// selectors are stored with the same defaults given by loadSignature
+		if selectedEvent.Name == "" {
+			selectedEvent.Name = ALL_EVENT_TYPES
+		}
+		if selectedEvent.Origin == "" {
+			selectedEvent.Origin = ALL_EVENT_ORIGINS
+		}
 		signatures := engine.signaturesIndex[selectedEvent]
 		for i, sig := range signatures {
-			metadata, _ := sig.GetMetadata()
-			if metadata.ID == signatureId {
+			if sig == signature {
 				// signature found, remove it
 				signatures = append(signatures[:i], signatures[i+1:]...)
 				engine.signaturesIndex[selectedEvent] = signatures
@@ -445,3 +552,24 @@ func (engine *Engine) GetDataSource(namespace string, id string) (detect.DataSou
 
 	return cache, ok
 }
+
+// DataSources returns the registered data sources, ordered by namespace and ID
+func (engine *Engine) DataSources() []detect.DataSource {
+	engine.dataSourcesMutex.RLock()
+	defer engine.dataSourcesMutex.RUnlock()
+
+	dataSources := []detect.DataSource{}
+	for _, namespaceCaches := range engine.dataSources {
+		for _, dataSource := range namespaceCaches {
+			dataSources = append(dataSources, dataSource)
+		}
+	}
+	sort.Slice(dataSources, func(i, j int) bool {
+		if dataSources[i].Namespace() != dataSources[j].Namespace() {
+			return dataSources[i].Namespace() < dataSources[j].Namespace()
+		}
+		return dataSources[i].ID() < dataSources[j].ID()
+	})
+
+	return dataSources
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/khulnasoft-lab/tracker/pkg/logger"
+	"github.com/khulnasoft-lab/tracker/pkg/redact"
 	"github.com/khulnasoft-lab/tracker/pkg/signatures/signature"
 	"github.com/khulnasoft-lab/tracker/types/detect"
 	"github.com/khulnasoft-lab/tracker/types/protocol"
@@ -495,3 +496,180 @@ func TestEngine_LoadSignature(t *testing.T) {
 		})
 	}
 }
+
+func TestEngine_ReplaceSignatures(t *testing.T) {
+	t.Parallel()
+
+	fakeSig := func(id string, initErr error) *signature.FakeSignature {
+		return &signature.FakeSignature{
+			FakeGetMetadata: func() (detect.SignatureMetadata, error) {
+				return detect.SignatureMetadata{ID: id, Name: id}, nil
+			},
+			FakeGetSelectedEvents: func() ([]detect.SignatureEventSelector, error) {
+				return []detect.SignatureEventSelector{{Name: "test_event", Source: "tracker"}}, nil
+			},
+			FakeInit: func(detect.SignatureContext) error {
+				return initErr
+			},
+		}
+	}
+
+	kept := fakeSig("TRC-1", nil)
+	updated := fakeSig("TRC-2", nil)
+	removed := fakeSig("TRC-3", nil)
+	broken := fakeSig("TRC-4", nil)
+
+	input := make(chan protocol.Event)
+	engine, err := NewEngine(Config{}, EventSources{Tracker: input}, make(chan *detect.Finding))
+	require.NoError(t, err)
+	defer close(input)
+
+	for _, sig := range []detect.Signature{kept, updated, removed, broken} {
+		_, err := engine.LoadSignature(sig)
+		require.NoError(t, err)
+	}
+
+	newUpdated := fakeSig("TRC-2", nil)
+	added := fakeSig("TRC-5", nil)
+	newBroken := fakeSig("TRC-4", errors.New("bad rego"))
+
+	err = engine.ReplaceSignatures([]detect.Signature{kept, newUpdated, newBroken, added})
+	assert.ErrorContains(t, err, "bad rego")
+
+	engine.signaturesMutex.RLock()
+	defer engine.signaturesMutex.RUnlock()
+
+	loaded := []detect.Signature{}
+	for sig := range engine.signatures {
+		loaded = append(loaded, sig)
+	}
+	// the broken signature keeps its old version
+	assert.ElementsMatch(t, []detect.Signature{kept, newUpdated, broken, added}, loaded)
+	assert.Len(t, engine.signaturesIndex[detect.SignatureEventSelector{Name: "test_event", Source: "tracker", Origin: ALL_EVENT_ORIGINS}], 4)
+	assert.Equal(t, 4, int(engine.Stats().Signatures.Get()))
+}
+
+func TestEngine_RedactedSignatures(t *testing.T) {
+	t.Parallel()
+
+	redactor, err := redact.New([]redact.Rule{{Args: []string{"pathname"}}})
+	require.NoError(t, err)
+
+	received := make(chan string, 2)
+	fakeSig := func(id string, optOut bool) *signature.FakeSignature {
+		return &signature.FakeSignature{
+			FakeGetMetadata: func() (detect.SignatureMetadata, error) {
+				return detect.SignatureMetadata{ID: id, Name: id, Properties: map[string]interface{}{"redact": optOut}}, nil
+			},
+			FakeGetSelectedEvents: func() ([]detect.SignatureEventSelector, error) {
+				return []detect.SignatureEventSelector{{Name: "openat", Source: "tracker"}}, nil
+			},
+			FakeOnEvent: func(event protocol.Event) error {
+				received <- id + ":" + event.Payload.(trace.Event).Args[0].Value.(string)
+				return nil
+			},
+		}
+	}
+
+	input := make(chan protocol.Event)
+	engine, err := NewEngine(
+		Config{Signatures: []detect.Signature{fakeSig("raw", false), fakeSig("redacted", true)}, Redactor: redactor},
+		EventSources{Tracker: input},
+		make(chan *detect.Finding),
+	)
+	require.NoError(t, err)
+	require.NoError(t, engine.Init())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go engine.Start(ctx)
+
+	input <- trace.Event{
+		EventName: "openat",
+		Args:      []trace.Argument{{ArgMeta: trace.ArgMeta{Name: "pathname"}, Value: "/etc/shadow"}},
+	}.ToProtocol()
+
+	assert.ElementsMatch(t, []string{"raw:/etc/shadow", "redacted:[REDACTED]"}, []string{<-received, <-received})
+}
+
+func TestEngine_SignatureStats(t *testing.T) {
+	t.Parallel()
+
+	output := make(chan *detect.Finding, 1)
+	fakeSig := func(id, eventName string, reports bool) *signature.FakeSignature {
+		var callback detect.SignatureHandler
+		return &signature.FakeSignature{
+			FakeGetMetadata: func() (detect.SignatureMetadata, error) {
+				return detect.SignatureMetadata{ID: id, Name: id}, nil
+			},
+			FakeGetSelectedEvents: func() ([]detect.SignatureEventSelector, error) {
+				return []detect.SignatureEventSelector{{Name: eventName, Source: "tracker"}}, nil
+			},
+			FakeInit: func(ctx detect.SignatureContext) error {
+				callback = ctx.Callback
+				return nil
+			},
+			FakeOnEvent: func(event protocol.Event) error {
+				if reports {
+					callback(&detect.Finding{SigMetadata: detect.SignatureMetadata{ID: id}, Event: event})
+				}
+				return nil
+			},
+		}
+	}
+
+	input := make(chan protocol.Event)
+	engine, err := NewEngine(
+		Config{Signatures: []detect.Signature{fakeSig("TRC-2", "openat", true), fakeSig("TRC-1", "execve", false)}},
+		EventSources{Tracker: input},
+		output,
+	)
+	require.NoError(t, err)
+	require.NoError(t, engine.Init())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go engine.Start(ctx)
+
+	input <- trace.Event{EventName: "openat"}.ToProtocol()
+	<-output
+
+	perSignature := engine.Stats().PerSignature()
+	require.Len(t, perSignature, 2)
+	assert.Equal(t, "TRC-1", perSignature[0].ID)
+	assert.Equal(t, uint64(0), perSignature[0].Events.Get())
+	assert.Equal(t, "TRC-2", perSignature[1].ID)
+	assert.Equal(t, uint64(1), perSignature[1].Events.Get())
+	assert.Equal(t, uint64(1), perSignature[1].Findings.Get())
+}
+
+// namedDataSource is a data source without data, only identified by its namespace and ID
+type namedDataSource struct {
+	detect.DataSource
+	namespace, id string
+}
+
+func (ds namedDataSource) Namespace() string { return ds.namespace }
+func (ds namedDataSource) ID() string        { return ds.id }
+
+func TestEngine_DataSources(t *testing.T) {
+	t.Parallel()
+
+	config := Config{
+		DataSources: []detect.DataSource{
+			namedDataSource{namespace: "tracker", id: "process_tree"},
+			namedDataSource{namespace: "intel", id: "domains"},
+			namedDataSource{namespace: "tracker", id: "dns"},
+			namedDataSource{namespace: "tracker", id: "dns"}, // duplicates are not registered
+		},
+	}
+	e, err := NewEngine(config, EventSources{Tracker: make(chan protocol.Event)}, make(chan *detect.Finding))
+	require.NoError(t, err, "constructing engine")
+	require.NoError(t, e.Init(), "initializing engine")
+
+	var names []string
+	for _, ds := range e.DataSources() {
+		names = append(names, ds.Namespace()+"/"+ds.ID())
+	}
+	assert.Equal(t, []string{"intel/domains", "tracker/dns", "tracker/process_tree"}, names)
+}
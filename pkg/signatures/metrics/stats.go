@@ -1,6 +1,9 @@
 package metrics
 
 import (
+	"sort"
+	"sync"
+
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/khulnasoft-lab/tracker/pkg/counter"
@@ -11,6 +14,68 @@ type Stats struct {
 	Events     counter.Counter
 	Signatures counter.Counter
 	Detections counter.Counter
+	signatures sync.Map // signature id -> *SignatureStats
+}
+
+// SignatureStats counts the events dispatched to a signature and the findings it reported
+type SignatureStats struct {
+	ID       string
+	Events   counter.Counter
+	Findings counter.Counter
+}
+
+// Signature returns the metrics of the given signature, creating them on first use. The
+// metrics are kept when the signature is unloaded, so they survive signatures reloads.
+func (stats *Stats) Signature(id string) *SignatureStats {
+	if sigStats, ok := stats.signatures.Load(id); ok {
+		return sigStats.(*SignatureStats)
+	}
+	sigStats, _ := stats.signatures.LoadOrStore(id, &SignatureStats{ID: id})
+	return sigStats.(*SignatureStats)
+}
+
+// PerSignature returns the metrics of the signatures loaded so far, ordered by ID
+func (stats *Stats) PerSignature() []*SignatureStats {
+	perSignature := []*SignatureStats{}
+	stats.signatures.Range(func(_, sigStats any) bool {
+		perSignature = append(perSignature, sigStats.(*SignatureStats))
+		return true
+	})
+	sort.Slice(perSignature, func(i, j int) bool { return perSignature[i].ID < perSignature[j].ID })
+
+	return perSignature
+}
+
+var (
+	signatureEventsDesc = prometheus.NewDesc(
+		"tracker_rules_signature_events_total",
+		"events dispatched to a signature",
+		[]string{"signature"}, nil,
+	)
+	signatureFindingsDesc = prometheus.NewDesc(
+		"tracker_rules_signature_findings_total",
+		"findings reported by a signature",
+		[]string{"signature"}, nil,
+	)
+)
+
+// signaturesCollector exports the metrics of each signature
+type signaturesCollector struct {
+	stats *Stats
+}
+
+// Describe implements prometheus.Collector
+func (c signaturesCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- signatureEventsDesc
+	ch <- signatureFindingsDesc
+}
+
+// Collect implements prometheus.Collector
+func (c signaturesCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, sigStats := range c.stats.PerSignature() {
+		ch <- prometheus.MustNewConstMetric(signatureEventsDesc, prometheus.CounterValue, float64(sigStats.Events.Get()), sigStats.ID)
+		ch <- prometheus.MustNewConstMetric(signatureFindingsDesc, prometheus.CounterValue, float64(sigStats.Findings.Get()), sigStats.ID)
+	}
 }
 
 // Register Stats to prometheus metrics exporter
@@ -45,5 +110,5 @@ func (stats *Stats) RegisterPrometheus() error {
 		return err
 	}
 
-	return nil
+	return prometheus.Register(signaturesCollector{stats: stats})
 }
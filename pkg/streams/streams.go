@@ -2,105 +2,399 @@ package streams
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
 	"sync"
 
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/khulnasoft-lab/tracker/pkg/counter"
 	"github.com/khulnasoft-lab/tracker/types/trace"
 )
 
+// Backpressure is the policy applied when a stream is not able to keep up
+// with the rate of published events.
+type Backpressure int
+
+const (
+	// Block blocks the publisher until the stream has room for the event.
+	Block Backpressure = iota
+	// DropNewest drops the event being published if the stream is full.
+	DropNewest
+	// DropOldest drops the oldest buffered event to make room for the new one.
+	DropOldest
+	// Disconnect drops events like DropNewest, closing the stream once
+	// MaxDrops events were dropped.
+	Disconnect
+)
+
+func (b Backpressure) String() string {
+	switch b {
+	case Block:
+		return "block"
+	case DropNewest:
+		return "drop-newest"
+	case DropOldest:
+		return "drop-oldest"
+	case Disconnect:
+		return "disconnect"
+	}
+	return "unknown"
+}
+
+// Config is the configuration of a stream
+type Config struct {
+	// Backpressure is the policy applied when the stream buffer is full
+	Backpressure Backpressure
+	// BufferSize is the size of the stream buffer
+	BufferSize int
+	// MaxDrops is the number of dropped events after which a stream
+	// with the Disconnect policy is closed
+	MaxDrops uint64
+	// ResumeFrom is the sequence number of the last event received by a
+	// previous subscription. Buffered events published after it are replayed
+	// before new events. Zero disables replaying. A sequence number ahead of
+	// the last published event is rejected with ErrResumeAhead.
+	ResumeFrom uint64
+}
+
+// ErrResumeAhead is returned when resuming from a sequence number that wasn't published yet,
+// as it happens when resuming a subscription of a previous run of tracker
+var ErrResumeAhead = errors.New("resuming from an event not published yet")
+
+// Event is an event delivered by a stream along with its sequence number
+type Event struct {
+	trace.Event
+	// Sequence is the monotonically increasing position of the event among
+	// all the events published by the manager
+	Sequence uint64
+}
+
+// Gap is a range of sequence numbers (inclusive) requested by a resumed
+// stream which were already evicted from the replay buffer
+type Gap struct {
+	From uint64
+	To   uint64
+}
+
 // Stream is a stream of events
 type Stream struct {
+	// id identifies the stream in metrics
+	id uint64
 	// policy mask is a bitmap of policies that this stream is interested in
 	policyMask uint64
+	// config holds the stream backpressure configuration
+	config Config
 	// events is a channel that is used to receive events from the stream
-	events chan trace.Event
+	events chan Event
+	// gap holds the events missed by a resumed stream, if any
+	gap *Gap
+	// delivered counts events sent to the stream
+	delivered counter.Counter
+	// dropped counts events dropped due to backpressure
+	dropped counter.Counter
+	// disconnected is set when the stream was closed due to backpressure
+	disconnected bool
+	// done is closed when the stream is closed, unblocking a publisher
+	done      chan struct{}
+	closeOnce sync.Once
+	// sendMutex serializes sending events with closing the events channel,
+	// since events are sent without holding the manager lock
+	sendMutex sync.Mutex
+	closed    bool
 }
 
 // ReceiveEvents returns a read-only channel for receiving events from the stream
-func (s *Stream) ReceiveEvents() <-chan trace.Event {
+func (s *Stream) ReceiveEvents() <-chan Event {
 	return s.events
 }
 
-// Publish publishes an event to the stream,
-// but first check if this stream is interested in this event,
-// by checking the event's policy mask against the stream's policy mask.
-func (s *Stream) publish(ctx context.Context, event trace.Event) {
-	if s.shouldIgnorePolicy(event) {
-		return
+// Gap returns the range of events that could not be replayed to a resumed
+// stream, or nil if all the requested events were replayed.
+func (s *Stream) Gap() *Gap {
+	return s.gap
+}
+
+// ID returns the number identifying the stream in metrics
+func (s *Stream) ID() uint64 {
+	return s.id
+}
+
+// Config returns the backpressure configuration of the stream
+func (s *Stream) Config() Config {
+	return s.config
+}
+
+// Lag returns the number of events buffered in the stream, waiting for the
+// subscriber to receive them
+func (s *Stream) Lag() int {
+	return len(s.events)
+}
+
+// Delivered returns the number of events delivered to the stream
+func (s *Stream) Delivered() uint64 {
+	return s.delivered.Get()
+}
+
+// Dropped returns the number of events dropped by the stream
+func (s *Stream) Dropped() uint64 {
+	return s.dropped.Get()
+}
+
+// Disconnected reports whether the stream was closed by the manager because
+// the subscriber did not keep up with the events. It is only meaningful after
+// the events channel was closed.
+func (s *Stream) Disconnected() bool {
+	return s.disconnected
+}
+
+// publish publishes an event to the stream, the stream being interested in it.
+// It returns false if the stream should be disconnected.
+func (s *Stream) publish(ctx context.Context, event Event) bool {
+	s.sendMutex.Lock()
+	defer s.sendMutex.Unlock()
+
+	if s.closed {
+		return true
 	}
 
-	// Currently, the behavior is to block when the channel is full.
-	// However, there is a consideration to modify this behavior to drop events instead.
-	// This change is based on the notion that with multiple streams, one stream's events
-	// should not impede another stream's event reception if they aren't processed rapidly.
-	// It is worth noting that there are scenarios where blocking may be preferred.
-	// To accommodate both scenarios, the plan is to introduce configurability for this behavior in the future.
-	// TODO: allow this to be configurable (drop/block) (josedonizetti)
-	select {
-	case s.events <- event:
-	case <-ctx.Done():
-		return
+	switch s.config.Backpressure {
+	case DropNewest, Disconnect:
+		select {
+		case s.events <- event:
+			_ = s.delivered.Increment()
+		default:
+			_ = s.dropped.Increment()
+		}
+	case DropOldest:
+		select {
+		case s.events <- event:
+			_ = s.delivered.Increment()
+			return true
+		default:
+		}
+		// Make room for the new event. The consumer might have drained the
+		// channel in the meantime, so do not block on either operation.
+		select {
+		case <-s.events:
+			_ = s.dropped.Increment()
+		default:
+		}
+		select {
+		case s.events <- event:
+			_ = s.delivered.Increment()
+		default:
+			_ = s.dropped.Increment()
+		}
+	default:
+		select {
+		case s.events <- event:
+			_ = s.delivered.Increment()
+		case <-ctx.Done():
+			return true
+		case <-s.done:
+			return true
+		}
+	}
+
+	if s.config.Backpressure == Disconnect && s.dropped.Get() >= s.config.MaxDrops {
+		return false
 	}
+
+	return true
 }
 
 // shouldIgnorePolicy checks if the stream should ignore the event
-func (s *Stream) shouldIgnorePolicy(event trace.Event) bool {
+func (s *Stream) shouldIgnorePolicy(event Event) bool {
 	return s.policyMask&event.MatchedPoliciesUser == 0
 }
 
-// close closes the stream
+// close closes the stream, first unblocking the publisher sending to it, if any
 func (s *Stream) close() {
-	close(s.events)
+	s.closeOnce.Do(func() { close(s.done) })
+
+	s.sendMutex.Lock()
+	defer s.sendMutex.Unlock()
+
+	if !s.closed {
+		s.closed = true
+		close(s.events)
+	}
 }
 
 // StreamManager manages streams
 type StreamsManager struct {
 	mutex       sync.Mutex
 	subscribers map[*Stream]struct{}
+	nextID      uint64
+	// sequence is the sequence number of the last published event
+	sequence uint64
+	// replay is a ring buffer holding the last published events
+	replay     []Event
+	replayHead int
+	replayLen  int
 }
 
-// NewStreamManager creates a new stream manager
-func NewStreamsManager() *StreamsManager {
+// NewStreamManager creates a new stream manager, keeping the last replaySize
+// published events to be replayed to resumed streams
+func NewStreamsManager(replaySize int) *StreamsManager {
 	return &StreamsManager{
 		mutex:       sync.Mutex{},
 		subscribers: make(map[*Stream]struct{}),
+		replay:      make([]Event, replaySize),
 	}
 }
 
 // Subscribe adds a stream to the manager
-func (sm *StreamsManager) Subscribe(policyMask uint64, chanSize int) *Stream {
+func (sm *StreamsManager) Subscribe(policyMask uint64, config Config) (*Stream, error) {
 	sm.mutex.Lock()
 	defer sm.mutex.Unlock()
 
+	if config.ResumeFrom > sm.sequence {
+		return nil, fmt.Errorf("%w: sequence %d, last published %d", ErrResumeAhead, config.ResumeFrom, sm.sequence)
+	}
+
+	sm.nextID++
 	stream := &Stream{
+		id:         sm.nextID,
 		policyMask: policyMask,
-		events:     make(chan trace.Event, chanSize),
+		config:     config,
+		done:       make(chan struct{}),
+	}
+
+	var replayed []Event
+	if config.ResumeFrom > 0 {
+		replayed, stream.gap = sm.replaySince(stream, config.ResumeFrom)
+	}
+
+	// Replayed events are queued ahead of the stream buffer, so subscribing
+	// never blocks, and no event is missed or duplicated between replayed
+	// and newly published events (both happen under the manager lock).
+	stream.events = make(chan Event, config.BufferSize+len(replayed))
+	for _, e := range replayed {
+		stream.events <- e
+		_ = stream.delivered.Increment()
 	}
 
 	sm.subscribers[stream] = struct{}{}
 
-	return stream
+	return stream, nil
+}
+
+// replaySince returns the buffered events the stream is interested in that
+// were published after the given sequence number, and the range of events
+// no longer buffered
+func (sm *StreamsManager) replaySince(stream *Stream, sequence uint64) ([]Event, *Gap) {
+	oldest := sm.sequence + 1 - uint64(sm.replayLen)
+
+	var gap *Gap
+	if sequence+1 < oldest {
+		gap = &Gap{From: sequence + 1, To: oldest - 1}
+	}
+
+	replayed := []Event{}
+	for i := 0; i < sm.replayLen; i++ {
+		e := sm.replay[(sm.replayHead+i)%len(sm.replay)]
+		if e.Sequence <= sequence || stream.shouldIgnorePolicy(e) {
+			continue
+		}
+		replayed = append(replayed, e)
+	}
+
+	return replayed, gap
+}
+
+// record stores an event in the replay buffer, evicting the oldest one when full
+func (sm *StreamsManager) record(event Event) {
+	if len(sm.replay) == 0 {
+		return
+	}
+
+	if sm.replayLen < len(sm.replay) {
+		sm.replay[(sm.replayHead+sm.replayLen)%len(sm.replay)] = event
+		sm.replayLen++
+		return
+	}
+
+	sm.replay[sm.replayHead] = event
+	sm.replayHead = (sm.replayHead + 1) % len(sm.replay)
 }
 
 // Unsubscribe removes a stream from the manager
 func (sm *StreamsManager) Unsubscribe(stream *Stream) {
 	sm.mutex.Lock()
-	defer sm.mutex.Unlock()
+	_, ok := sm.subscribers[stream] // check if stream is subscribed
+	delete(sm.subscribers, stream)
+	sm.mutex.Unlock()
 
-	// check if stream is subscribed
-	if _, ok := sm.subscribers[stream]; ok {
-		delete(sm.subscribers, stream)
+	if ok {
 		stream.close()
 	}
 }
 
-// Publish publishes an event to all streams
+// Publish publishes an event to all streams interested in it. Events are sent
+// without holding the manager lock, so a subscriber not keeping up with the
+// events (with the Block policy) doesn't block the other streams operations.
 func (sm *StreamsManager) Publish(ctx context.Context, event trace.Event) {
+	sm.mutex.Lock()
+	sm.sequence++
+	e := Event{Event: event, Sequence: sm.sequence}
+	sm.record(e)
+
+	streams := make([]*Stream, 0, len(sm.subscribers))
+	for stream := range sm.subscribers {
+		if !stream.shouldIgnorePolicy(e) {
+			streams = append(streams, stream)
+		}
+	}
+	sm.mutex.Unlock()
+
+	for _, stream := range streams {
+		if stream.publish(ctx, e) {
+			continue
+		}
+
+		// the stream exceeded its drop budget: disconnect it
+		sm.mutex.Lock()
+		_, ok := sm.subscribers[stream]
+		delete(sm.subscribers, stream)
+		sm.mutex.Unlock()
+
+		if ok {
+			stream.disconnected = true
+			stream.close()
+		}
+	}
+}
+
+// RemovePolicies stops delivering the events of the given policies (bitmap of IDs) to
+// the streams subscribed to them, since their IDs can be given to new policies.
+// Streams subscribed to all policies are not changed.
+func (sm *StreamsManager) RemovePolicies(policyMask uint64) {
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+
+	for stream := range sm.subscribers {
+		if stream.policyMask != ^uint64(0) {
+			stream.policyMask &^= policyMask
+		}
+	}
+}
+
+// Streams returns the active streams, ordered by ID
+func (sm *StreamsManager) Streams() []*Stream {
 	sm.mutex.Lock()
 	defer sm.mutex.Unlock()
 
+	streams := make([]*Stream, 0, len(sm.subscribers))
 	for stream := range sm.subscribers {
-		stream.publish(ctx, event)
+		streams = append(streams, stream)
 	}
+	sort.Slice(streams, func(i, j int) bool { return streams[i].id < streams[j].id })
+
+	return streams
 }
 
 // Close closes all streams
@@ -112,3 +406,53 @@ func (sm *StreamsManager) Close() {
 		stream.close()
 	}
 }
+
+var (
+	deliveredDesc = prometheus.NewDesc(
+		"tracker_streams_events_delivered_total",
+		"events delivered to a stream subscriber",
+		[]string{"stream", "backpressure"}, nil,
+	)
+	droppedDesc = prometheus.NewDesc(
+		"tracker_streams_events_dropped_total",
+		"events dropped by a stream subscriber due to backpressure",
+		[]string{"stream", "backpressure"}, nil,
+	)
+	lagDesc = prometheus.NewDesc(
+		"tracker_streams_lag",
+		"events buffered in a stream, waiting for the subscriber to receive them",
+		[]string{"stream", "backpressure"}, nil,
+	)
+)
+
+// Describe implements prometheus.Collector
+func (sm *StreamsManager) Describe(ch chan<- *prometheus.Desc) {
+	ch <- deliveredDesc
+	ch <- droppedDesc
+	ch <- lagDesc
+}
+
+// Collect implements prometheus.Collector, only reporting active streams
+func (sm *StreamsManager) Collect(ch chan<- prometheus.Metric) {
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+
+	for stream := range sm.subscribers {
+		id := strconv.FormatUint(stream.id, 10)
+		backpressure := stream.config.Backpressure.String()
+		ch <- prometheus.MustNewConstMetric(
+			deliveredDesc, prometheus.CounterValue, float64(stream.Delivered()), id, backpressure,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			droppedDesc, prometheus.CounterValue, float64(stream.Dropped()), id, backpressure,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			lagDesc, prometheus.GaugeValue, float64(stream.Lag()), id, backpressure,
+		)
+	}
+}
+
+// RegisterPrometheus registers the streams metrics to the prometheus metrics exporter
+func (sm *StreamsManager) RegisterPrometheus() error {
+	return prometheus.Register(sm)
+}
@@ -2,6 +2,7 @@ package streams
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"testing"
 
@@ -23,6 +24,16 @@ var (
 	policy1And2Event = trace.Event{MatchedPoliciesUser: 0b11}
 )
 
+// subscribe subscribes a stream to the manager, failing the test on error
+func subscribe(t *testing.T, sm *StreamsManager, policyMask uint64, config Config) *Stream {
+	t.Helper()
+
+	stream, err := sm.Subscribe(policyMask, config)
+	assert.NilError(t, err)
+
+	return stream
+}
+
 func TestStreamManager(t *testing.T) {
 	t.Parallel()
 
@@ -34,16 +45,16 @@ func TestStreamManager(t *testing.T) {
 
 	ctx := context.Background()
 
-	sm := NewStreamsManager()
+	sm := NewStreamsManager(0)
 
 	// stream for policy1
-	stream1 := sm.Subscribe(policy1Mask, 0)
+	stream1 := subscribe(t, sm, policy1Mask, Config{})
 
 	// stream for policy1 and policy2
-	stream2 := sm.Subscribe(policy1And2Mask, 0)
+	stream2 := subscribe(t, sm, policy1And2Mask, Config{})
 
 	// stream for all policies
-	stream3 := sm.Subscribe(allPoliciesMask, 0)
+	stream3 := subscribe(t, sm, allPoliciesMask, Config{})
 
 	// consumers
 	consumersWG := &sync.WaitGroup{}
@@ -107,7 +118,7 @@ func TestStreamManager(t *testing.T) {
 func Test_shouldIgnorePolicy(t *testing.T) {
 	t.Parallel()
 
-	sm := NewStreamsManager()
+	sm := NewStreamsManager(0)
 
 	tests := []struct {
 		name       string
@@ -159,8 +170,237 @@ func Test_shouldIgnorePolicy(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			stream := sm.Subscribe(tt.policyMask, 0)
-			assert.Equal(t, tt.expected, stream.shouldIgnorePolicy(tt.event))
+			stream := subscribe(t, sm, tt.policyMask, Config{})
+			assert.Equal(t, tt.expected, stream.shouldIgnorePolicy(Event{Event: tt.event}))
+		})
+	}
+}
+
+func TestStreamsManager_RemovePolicies(t *testing.T) {
+	t.Parallel()
+
+	sm := NewStreamsManager(0)
+	stream1 := subscribe(t, sm, policy1Mask, Config{})
+	stream1And2 := subscribe(t, sm, policy1And2Mask, Config{})
+	streamAll := subscribe(t, sm, allPoliciesMask, Config{})
+
+	sm.RemovePolicies(policy1Mask)
+
+	assert.Assert(t, stream1.shouldIgnorePolicy(Event{Event: policy1Event}))
+	assert.Assert(t, stream1And2.shouldIgnorePolicy(Event{Event: policy1Event}))
+	assert.Assert(t, !stream1And2.shouldIgnorePolicy(Event{Event: policy2Event}))
+	assert.Assert(t, !streamAll.shouldIgnorePolicy(Event{Event: policy1Event}))
+}
+
+func TestStreamBackpressure(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name              string
+		config            Config
+		published         int
+		expectedDelivered uint64
+		expectedDropped   uint64
+		expectedBuffered  []int
+		expectedClosed    bool
+	}{
+		{
+			name:              "drop newest keeps the first events",
+			config:            Config{Backpressure: DropNewest, BufferSize: 2},
+			published:         5,
+			expectedDelivered: 2,
+			expectedDropped:   3,
+			expectedBuffered:  []int{0, 1},
+		},
+		{
+			name:              "drop oldest keeps the last events",
+			config:            Config{Backpressure: DropOldest, BufferSize: 2},
+			published:         5,
+			expectedDelivered: 5,
+			expectedDropped:   3,
+			expectedBuffered:  []int{3, 4},
+		},
+		{
+			name:              "disconnect after max drops",
+			config:            Config{Backpressure: Disconnect, BufferSize: 2, MaxDrops: 2},
+			published:         5,
+			expectedDelivered: 2,
+			expectedDropped:   2,
+			expectedBuffered:  []int{0, 1},
+			expectedClosed:    true,
+		},
+		{
+			name:              "disconnect under max drops",
+			config:            Config{Backpressure: Disconnect, BufferSize: 2, MaxDrops: 10},
+			published:         5,
+			expectedDelivered: 2,
+			expectedDropped:   3,
+			expectedBuffered:  []int{0, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sm := NewStreamsManager(0)
+			stream := subscribe(t, sm, allPoliciesMask, tt.config)
+
+			for i := 0; i < tt.published; i++ {
+				sm.Publish(ctx, trace.Event{MatchedPoliciesUser: 0b1, EventID: i})
+			}
+
+			assert.Equal(t, tt.expectedDelivered, stream.Delivered())
+			assert.Equal(t, tt.expectedDropped, stream.Dropped())
+			assert.Equal(t, len(tt.expectedBuffered), stream.Lag())
+
+			if !tt.expectedClosed {
+				sm.Unsubscribe(stream)
+			}
+
+			buffered := []int{}
+			for e := range stream.ReceiveEvents() {
+				buffered = append(buffered, e.EventID)
+			}
+
+			assert.DeepEqual(t, tt.expectedBuffered, buffered)
+			assert.Equal(t, tt.expectedClosed, stream.Disconnected())
+		})
+	}
+}
+
+func TestStreamBlockedSubscriber(t *testing.T) {
+	t.Parallel()
+
+	sm := NewStreamsManager(0)
+	blocked := subscribe(t, sm, allPoliciesMask, Config{Backpressure: Block})
+
+	published := make(chan struct{})
+	go func() {
+		sm.Publish(context.Background(), policy1Event)
+		close(published)
+	}()
+
+	// the manager is usable while the publisher waits for the blocked subscriber
+	stream := subscribe(t, sm, policy1Mask, Config{BufferSize: 1})
+	assert.Equal(t, 2, len(sm.Streams()))
+	sm.Unsubscribe(stream)
+
+	// removing the blocked subscriber unblocks the publisher
+	sm.Unsubscribe(blocked)
+	<-published
+
+	_, ok := <-blocked.ReceiveEvents()
+	assert.Assert(t, !ok)
+}
+
+func TestStreamResume(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name              string
+		replaySize        int
+		policyMask        uint64
+		resumeFrom        uint64
+		expectedSequences []uint64
+		expectedGap       *Gap
+		expectedError     error
+	}{
+		{
+			name:              "no resume",
+			replaySize:        5,
+			policyMask:        allPoliciesMask,
+			resumeFrom:        0,
+			expectedSequences: []uint64{9, 10},
+		},
+		{
+			name:              "resume within replay buffer",
+			replaySize:        5,
+			policyMask:        allPoliciesMask,
+			resumeFrom:        6,
+			expectedSequences: []uint64{7, 8, 9, 10},
+		},
+		{
+			name:              "resume beyond replay buffer",
+			replaySize:        5,
+			policyMask:        allPoliciesMask,
+			resumeFrom:        2,
+			expectedSequences: []uint64{4, 5, 6, 7, 8, 9, 10},
+			expectedGap:       &Gap{From: 3, To: 3},
+		},
+		{
+			name:              "resume with replay buffer disabled",
+			replaySize:        0,
+			policyMask:        allPoliciesMask,
+			resumeFrom:        4,
+			expectedSequences: []uint64{9, 10},
+			expectedGap:       &Gap{From: 5, To: 8},
+		},
+		{
+			name:              "resume replays only matching policies",
+			replaySize:        5,
+			policyMask:        policy1Mask,
+			resumeFrom:        4,
+			expectedSequences: []uint64{5, 7, 9},
+		},
+		{
+			name:              "resume from last event",
+			replaySize:        5,
+			policyMask:        allPoliciesMask,
+			resumeFrom:        8,
+			expectedSequences: []uint64{9, 10},
+		},
+		{
+			name:          "resume ahead of last event",
+			replaySize:    5,
+			policyMask:    allPoliciesMask,
+			resumeFrom:    9,
+			expectedError: ErrResumeAhead,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sm := NewStreamsManager(tt.replaySize)
+
+			// odd events match policy 1, even events match policy 2
+			publish := func(count int) {
+				for i := 0; i < count; i++ {
+					if i%2 == 0 {
+						sm.Publish(ctx, policy1Event)
+					} else {
+						sm.Publish(ctx, policy2Event)
+					}
+				}
+			}
+
+			publish(8)
+			stream, err := sm.Subscribe(tt.policyMask, Config{BufferSize: 10, ResumeFrom: tt.resumeFrom})
+			if tt.expectedError != nil {
+				assert.Assert(t, errors.Is(err, tt.expectedError), err)
+				return
+			}
+			assert.NilError(t, err)
+			publish(2)
+			sm.Unsubscribe(stream)
+
+			sequences := []uint64{}
+			for e := range stream.ReceiveEvents() {
+				sequences = append(sequences, e.Sequence)
+			}
+
+			assert.DeepEqual(t, tt.expectedSequences, sequences)
+			assert.DeepEqual(t, tt.expectedGap, stream.Gap())
 		})
 	}
 }
@@ -0,0 +1,82 @@
+package environment
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	nodeNameEnv      = "NODE_NAME"    // set from the downward API (spec.nodeName) in kubernetes
+	hostRootDir      = "/proc/1/root" // the host root, as seen by tracker in the host pid namespace
+	hostnameFile     = "/etc/hostname"
+	machineIDFile    = "/etc/machine-id"
+	instanceDataFile = "/run/cloud-init/instance-data.json" // written by cloud-init
+)
+
+// NodeLabels returns labels identifying the node tracker runs on: its name, machine id
+// and, if cloud-init ran on it, its cloud provider, instance, region and zone. Labels
+// which can't be found are left out.
+//
+// As tracker usually runs in a container, the node name is taken from the NODE_NAME
+// environment variable and the node files are read under the host root, falling back to
+// the tracker root and hostname when they're not available.
+func NodeLabels() map[string]string {
+	return nodeLabels(os.Getenv(nodeNameEnv), []string{hostRootDir, "/"})
+}
+
+func nodeLabels(nodeName string, roots []string) map[string]string {
+	labels := map[string]string{}
+
+	add := func(key, value string) {
+		value = strings.TrimSpace(value)
+		if value != "" {
+			labels[key] = value
+		}
+	}
+
+	// readFile reads a node file under the first root it's found in
+	readFile := func(path string) ([]byte, error) {
+		var err error
+		for _, root := range roots {
+			var b []byte
+			if b, err = os.ReadFile(filepath.Join(root, path)); err == nil {
+				return b, nil
+			}
+		}
+		return nil, err
+	}
+
+	if nodeName == "" {
+		if hostname, err := readFile(hostnameFile); err == nil {
+			nodeName = string(hostname)
+		} else if hostname, err := os.Hostname(); err == nil {
+			nodeName = hostname
+		}
+	}
+	add("node.hostname", nodeName)
+
+	if machineID, err := readFile(machineIDFile); err == nil {
+		add("node.machine-id", string(machineID))
+	}
+
+	if b, err := readFile(instanceDataFile); err == nil {
+		var instanceData struct {
+			V1 struct {
+				CloudName        string `json:"cloud_name"`
+				InstanceID       string `json:"instance_id"`
+				Region           string `json:"region"`
+				AvailabilityZone string `json:"availability_zone"`
+			} `json:"v1"`
+		}
+		if err := json.Unmarshal(b, &instanceData); err == nil {
+			add("cloud.provider", instanceData.V1.CloudName)
+			add("cloud.instance-id", instanceData.V1.InstanceID)
+			add("cloud.region", instanceData.V1.Region)
+			add("cloud.zone", instanceData.V1.AvailabilityZone)
+		}
+	}
+
+	return labels
+}
@@ -0,0 +1,74 @@
+package environment
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeLabels(t *testing.T) {
+	t.Parallel()
+
+	hostname, err := os.Hostname()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		testName       string
+		nodeName       string
+		roots          []string
+		expectedLabels map[string]string
+	}{
+		{
+			testName: "node name and cloud instance",
+			nodeName: "k8s-node-1",
+			roots:    []string{"testdata/host"},
+			expectedLabels: map[string]string{
+				"node.hostname":     "k8s-node-1",
+				"node.machine-id":   "0123456789abcdef0123456789abcdef",
+				"cloud.provider":    "aws",
+				"cloud.instance-id": "i-0123456789abcdef0",
+				"cloud.region":      "eu-west-1",
+				"cloud.zone":        "eu-west-1a",
+			},
+		},
+		{
+			testName: "hostname under the host root",
+			roots:    []string{"testdata/nonexistent", "testdata/host"},
+			expectedLabels: map[string]string{
+				"node.hostname":     "node-1",
+				"node.machine-id":   "0123456789abcdef0123456789abcdef",
+				"cloud.provider":    "aws",
+				"cloud.instance-id": "i-0123456789abcdef0",
+				"cloud.region":      "eu-west-1",
+				"cloud.zone":        "eu-west-1a",
+			},
+		},
+		{
+			testName: "no files",
+			roots:    []string{"testdata/nonexistent"},
+			expectedLabels: map[string]string{
+				"node.hostname": hostname,
+			},
+		},
+		{
+			testName: "invalid instance data",
+			nodeName: "k8s-node-1",
+			roots:    []string{"testdata/invalid-host"},
+			expectedLabels: map[string]string{
+				"node.hostname": "k8s-node-1",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.testName, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expectedLabels, nodeLabels(tc.nodeName, tc.roots))
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/khulnasoft-lab/tracker/pkg/events"
 	"github.com/khulnasoft-lab/tracker/pkg/events/dependencies"
 	"github.com/khulnasoft-lab/tracker/pkg/logger"
+	"github.com/khulnasoft-lab/tracker/pkg/streams"
 	"github.com/khulnasoft-lab/tracker/tests/testutils"
 	"github.com/khulnasoft-lab/tracker/types/trace"
 )
@@ -151,7 +152,11 @@ func Test_EventsDependencies(t *testing.T) {
 					t.Logf("  --- reset dependencies ---")
 				}()
 
-				stream := trc.SubscribeAll()
+				stream, err := trc.SubscribeAll(streams.Config{})
+				if err != nil {
+					cancel()
+					t.Fatal(err)
+				}
 				defer trc.Unsubscribe(stream)
 
 				// start a goroutine to read events from the channel into the buffer
@@ -162,7 +167,7 @@ func Test_EventsDependencies(t *testing.T) {
 						case <-ctx.Done():
 							return
 						case evt := <-stream.ReceiveEvents():
-							buf.addEvent(evt)
+							buf.addEvent(evt.Event)
 						}
 					}
 				}(ctx, buf)
@@ -17,6 +17,7 @@ import (
 	"github.com/khulnasoft-lab/tracker/pkg/events/dependencies"
 	k8s "github.com/khulnasoft-lab/tracker/pkg/k8s/apis/tracker.khulnasoft.com/v1beta1"
 	"github.com/khulnasoft-lab/tracker/pkg/policy/v1beta1"
+	"github.com/khulnasoft-lab/tracker/pkg/streams"
 	"github.com/khulnasoft-lab/tracker/pkg/utils"
 	"github.com/khulnasoft-lab/tracker/signatures/helpers"
 	"github.com/khulnasoft-lab/tracker/tests/testutils"
@@ -1737,7 +1738,11 @@ func Test_EventFilters(t *testing.T) {
 				t.Logf("  --- reset dependencies ---")
 			}()
 
-			stream := trc.SubscribeAll()
+			stream, err := trc.SubscribeAll(streams.Config{})
+			if err != nil {
+				cancel()
+				t.Fatal(err)
+			}
 			defer trc.Unsubscribe(stream)
 
 			// start a goroutine to read events from the channel into the buffer
@@ -1748,7 +1753,7 @@ func Test_EventFilters(t *testing.T) {
 					case <-ctx.Done():
 						return
 					case evt := <-stream.ReceiveEvents():
-						buf.addEvent(evt)
+						buf.addEvent(evt.Event)
 					}
 				}
 			}(ctx, buf)
@@ -49,6 +49,8 @@ type Event struct {
 	ParentEntityId        uint32       `json:"parentEntityId"`  // parent process unique identifier (*)
 	Args                  []Argument   `json:"args"`            // args are ordered according their appearance in the original event
 	Metadata              *Metadata    `json:"metadata,omitempty"`
+
+	Labels map[string]string `json:"labels,omitempty"` // static labels of the node, e.g. its cluster
 }
 
 // (*) For an OS task to be uniquely identified, tracker builds a hash consisting of: